@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -199,7 +200,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	// call the hooks with the BeginBlock messages
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+			app.handleListenerError("BeginBlock listening hook failed", err, "height", req.Header.Height)
 		}
 	}
 
@@ -226,7 +227,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	// call the streaming service hooks with the EndBlock messages
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
-			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+			app.handleListenerError("EndBlock listening hook failed", err, "height", req.Height)
 		}
 	}
 
@@ -281,7 +282,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	defer func() {
 		for _, streamingListener := range app.abciListeners {
 			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
-				app.logger.Error("DeliverTx listening hook failed", "err", err)
+				app.handleListenerError("DeliverTx listening hook failed", err)
 			}
 		}
 	}()
@@ -314,6 +315,14 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	// call the streaming service hooks before committing, so that a listener
+	// can hold the commit until the block is delivered
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenCommit(app.deliverState.ctx); err != nil {
+			app.handleListenerError("Commit listening hook failed", err, "height", header.Height)
+		}
+	}
+
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
@@ -358,6 +367,18 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 }
 
+// handleListenerError logs an error returned by an ABCIListener hook, or panics
+// if the BaseApp is configured to halt on listener errors. Panicking stops the
+// node before the current block is committed, so that no state change is lost
+// by the listener. The block is replayed when the node restarts.
+func (app *BaseApp) handleListenerError(msg string, err error, keyvals ...interface{}) {
+	if app.haltOnListenerError {
+		panic(fmt.Errorf("%s: %w", msg, err))
+	}
+
+	app.logger.Error(msg, append(keyvals, "err", err)...)
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
@@ -522,6 +543,7 @@ func (app *BaseApp) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOf
 	err = app.snapshotManager.Restore(snapshot)
 	switch {
 	case err == nil:
+		app.snapshotAppHash = req.AppHash
 		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}
 
 	case errors.Is(err, snapshottypes.ErrUnknownFormat):
@@ -557,9 +579,13 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
 	}
 
-	_, err := app.snapshotManager.RestoreChunk(req.Chunk)
+	done, err := app.snapshotManager.RestoreChunk(req.Chunk)
 	switch {
 	case err == nil:
+		if done && !app.verifySnapshotAppHash() {
+			// The restored state cannot be reset, so all snapshot restoration is aborted.
+			return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+		}
 		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
 
 	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
@@ -581,6 +607,28 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 	}
 }
 
+// verifySnapshotAppHash checks that the app hash of the restored state matches
+// the app hash of the snapshot, as offered by Tendermint.
+func (app *BaseApp) verifySnapshotAppHash() bool {
+	expected := app.snapshotAppHash
+	app.snapshotAppHash = nil
+	if len(expected) == 0 {
+		return true
+	}
+
+	restored := app.cms.LastCommitID()
+	if !bytes.Equal(restored.Hash, expected) {
+		app.logger.Error(
+			"restored app hash does not match the snapshot",
+			"height", restored.Version,
+			"expected", fmt.Sprintf("%X", expected),
+			"restored", fmt.Sprintf("%X", restored.Hash),
+		)
+		return false
+	}
+	return true
+}
+
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
 	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
@@ -2,7 +2,6 @@ package baseapp
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -13,7 +12,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
-	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -66,6 +64,7 @@ type BaseApp struct { // nolint: maligned
 	snapshotManager    *snapshots.Manager
 	snapshotInterval   uint64 // block interval between state sync snapshots
 	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotAppHash    []byte // app hash of the snapshot being restored
 
 	// volatile states:
 	//
@@ -129,6 +128,11 @@ type BaseApp struct { // nolint: maligned
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// haltOnListenerError makes the node panic, and thus stop before committing
+	// the current block, when an ABCIListener hook returns an error. Otherwise
+	// the errors are only logged.
+	haltOnListenerError bool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -278,6 +282,12 @@ func (app *BaseApp) LastCommitID() storetypes.CommitID {
 	return app.cms.LastCommitID()
 }
 
+// CommitMultiStore returns the root multistore of the app, which holds the
+// state of the last committed block.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
 // LastBlockHeight returns the last committed block height.
 func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
@@ -294,11 +304,7 @@ func (app *BaseApp) init() error {
 
 	// make sure the snapshot interval is a multiple of the pruning KeepEvery interval
 	if app.snapshotManager != nil && app.snapshotInterval > 0 {
-		rms, ok := app.cms.(*rootmulti.Store)
-		if !ok {
-			return errors.New("state sync snapshots require a rootmulti store")
-		}
-		pruningOpts := rms.GetPruning()
+		pruningOpts := app.cms.GetPruning()
 		if pruningOpts.KeepEvery > 0 && app.snapshotInterval%pruningOpts.KeepEvery != 0 {
 			return fmt.Errorf(
 				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
@@ -317,6 +323,10 @@ func (app *BaseApp) setHaltHeight(haltHeight uint64) {
 	app.haltHeight = haltHeight
 }
 
+func (app *BaseApp) setHaltOnListenerError(halt bool) {
+	app.haltOnListenerError = halt
+}
+
 func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
@@ -23,10 +23,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/multi"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -701,6 +703,77 @@ func TestBeginBlock_WithInitialHeight(t *testing.T) {
 	require.Equal(t, int64(3), app.LastBlockHeight())
 }
 
+// mockStreamingService is a StreamingService recording the heights it was
+// notified of, and failing the hooks when err is set.
+type mockStreamingService struct {
+	err       error
+	committed []int64
+}
+
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return m.err
+}
+
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return m.err
+}
+
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return m.err
+}
+
+func (m *mockStreamingService) ListenCommit(ctx sdk.Context) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.committed = append(m.committed, ctx.BlockHeight())
+	return nil
+}
+
+func (m *mockStreamingService) Stream(*sync.WaitGroup) error { return nil }
+func (m *mockStreamingService) Close() error                 { return nil }
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+func TestListenerErrors(t *testing.T) {
+	listener := &mockStreamingService{}
+	app := setupBaseApp(t, func(bapp *baseapp.BaseApp) {
+		bapp.SetStreamingService(listener)
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	// the listener is notified of each commit
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+	require.Equal(t, []int64{1}, listener.committed)
+
+	// by default, listener errors are only logged
+	listener.err = fmt.Errorf("listener failure")
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+	require.Equal(t, int64(2), app.LastBlockHeight())
+
+	// in halt mode, a failed listener stops the node before the block is committed
+	app = setupBaseApp(t, func(bapp *baseapp.BaseApp) {
+		bapp.SetStreamingService(listener)
+	}, baseapp.SetHaltOnListenerError(true))
+	app.InitChain(abci.RequestInitChain{})
+
+	listener.err = nil
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	listener.err = fmt.Errorf("listener failure")
+	require.Panics(t, func() { app.Commit() })
+	require.Equal(t, int64(0), app.LastBlockHeight())
+
+	require.Panics(t, func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	})
+}
+
 // Simple tx with a list of Msgs.
 type txTest struct {
 	Msgs       []sdk.Msg
@@ -1949,6 +2022,80 @@ func TestApplySnapshotChunk(t *testing.T) {
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
 }
 
+func TestApplySnapshotChunkV2Store(t *testing.T) {
+	v2Store := func(bapp *baseapp.BaseApp) { bapp.SetCMS(multi.NewStore(memdb.NewDB())) }
+	source, teardown := setupBaseAppWithSnapshots(t, 4, 10, v2Store)
+	defer teardown()
+
+	target, teardown := setupBaseAppWithSnapshots(t, 0, 0, v2Store)
+	defer teardown()
+
+	respList := source.ListSnapshots(abci.RequestListSnapshots{})
+	require.NotEmpty(t, respList.Snapshots)
+	snapshot := respList.Snapshots[0]
+
+	respOffer := target.OfferSnapshot(abci.RequestOfferSnapshot{
+		Snapshot: snapshot,
+		AppHash:  source.LastCommitID().Hash,
+	})
+	require.Equal(t, abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, respOffer)
+
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		respChunk := source.LoadSnapshotChunk(abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		require.NotNil(t, respChunk.Chunk)
+		respApply := target.ApplySnapshotChunk(abci.RequestApplySnapshotChunk{
+			Index: index,
+			Chunk: respChunk.Chunk,
+		})
+		require.Equal(t, abci.ResponseApplySnapshotChunk{
+			Result: abci.ResponseApplySnapshotChunk_ACCEPT,
+		}, respApply)
+	}
+
+	// The target should now have the same hash and state as the source
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	require.Equal(t, source.CMS().GetKVStore(capKey2).Get([]byte("0")), target.CMS().GetKVStore(capKey2).Get([]byte("0")))
+}
+
+func TestApplySnapshotChunkAppHashMismatch(t *testing.T) {
+	source, teardown := setupBaseAppWithSnapshots(t, 2, 1)
+	defer teardown()
+
+	target, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	respList := source.ListSnapshots(abci.RequestListSnapshots{})
+	require.NotEmpty(t, respList.Snapshots)
+	snapshot := respList.Snapshots[0]
+
+	respOffer := target.OfferSnapshot(abci.RequestOfferSnapshot{
+		Snapshot: snapshot,
+		AppHash:  []byte("invalid app hash"),
+	})
+	require.Equal(t, abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, respOffer)
+
+	// The restoration is aborted once the last chunk is applied
+	var respApply abci.ResponseApplySnapshotChunk
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		respChunk := source.LoadSnapshotChunk(abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		respApply = target.ApplySnapshotChunk(abci.RequestApplySnapshotChunk{
+			Index: index,
+			Chunk: respChunk.Chunk,
+		})
+	}
+	require.Equal(t, abci.ResponseApplySnapshotChunk{
+		Result: abci.ResponseApplySnapshotChunk_ABORT,
+	}, respApply)
+}
+
 // NOTE: represents a new custom router for testing purposes of WithRouter()
 type testCustomRouter struct {
 	routes sync.Map
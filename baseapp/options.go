@@ -41,6 +41,12 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
+// SetHaltOnListenerError returns a BaseApp option function that makes the node
+// halt, instead of logging the error, when an ABCIListener hook fails.
+func SetHaltOnListenerError(halt bool) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltOnListenerError(halt) }
+}
+
 // SetMinRetainBlocks returns a BaseApp option function that sets the minimum
 // block retention height value when determining which heights to prune during
 // ABCI Commit.
@@ -240,3 +246,13 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
 }
+
+// SetHaltOnListenerError sets whether the node halts, instead of logging the
+// error, when an ABCIListener hook fails. Combined with the ListenCommit hook,
+// it guarantees that a block is not committed before it is streamed.
+func (app *BaseApp) SetHaltOnListenerError(halt bool) {
+	if app.sealed {
+		panic("SetHaltOnListenerError() on sealed BaseApp")
+	}
+	app.setHaltOnListenerError(halt)
+}
@@ -18,6 +18,10 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit is called before the state of the block at ctx.BlockHeight() is
+	// committed. The block is not committed until it returns, so a listener can
+	// use it to make sure the block was delivered before the node moves on.
+	ListenCommit(ctx types.Context) error
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
@@ -47,6 +47,7 @@ type Context struct {
 	AccountRetriever  AccountRetriever
 	NodeURI           string
 	FeeGranter        sdk.AccAddress
+	IsAux             bool
 	Viper             *viper.Viper
 
 	// TODO: Deprecated (remove).
@@ -156,6 +157,12 @@ func (ctx Context) WithGenerateOnly(generateOnly bool) Context {
 	return ctx
 }
 
+// WithAux returns a copy of the context with an updated IsAux value.
+func (ctx Context) WithAux(isAux bool) Context {
+	ctx.IsAux = isAux
+	return ctx
+}
+
 // WithSimulation returns a copy of the context with updated Simulate value
 func (ctx Context) WithSimulation(simulate bool) Context {
 	ctx.Simulate = simulate
@@ -0,0 +1,195 @@
+package tx
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// AuxTxBuilder is a client-side builder for creating an AuxSignerData, i.e.
+// the data an auxiliary signer (e.g. a tipper) signs in SIGN_MODE_DIRECT_AUX
+// and sends to the fee payer, who finishes and broadcasts the tx.
+type AuxTxBuilder struct {
+	// body is the TxBody that will be built. We also cache the body bytes
+	// so that they are computed only once.
+	body   *tx.TxBody
+	bodyBz []byte
+
+	auxSignerData *tx.AuxSignerData
+}
+
+// NewAuxTxBuilder creates a new client-side builder for constructing an
+// AuxSignerData.
+func NewAuxTxBuilder() AuxTxBuilder {
+	return AuxTxBuilder{}
+}
+
+// SetAddress sets the aux signer's bech32 address.
+func (b *AuxTxBuilder) SetAddress(addr string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Address = addr
+}
+
+// SetMemo sets a memo in the tx.
+func (b *AuxTxBuilder) SetMemo(memo string) {
+	b.checkEmptyFields()
+
+	b.body.Memo = memo
+	b.bodyBz = nil
+}
+
+// SetTimeoutHeight sets a timeout height in the tx.
+func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
+	b.checkEmptyFields()
+
+	b.body.TimeoutHeight = height
+	b.bodyBz = nil
+}
+
+// SetMsgs sets an array of Msgs in the tx.
+func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
+	anys, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	b.checkEmptyFields()
+
+	b.body.Messages = anys
+	b.bodyBz = nil
+
+	return nil
+}
+
+// SetAccountNumber sets the aux signer's account number in the AuxSignerData.
+func (b *AuxTxBuilder) SetAccountNumber(accNum uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.AccountNumber = accNum
+}
+
+// SetChainID sets the chain id in the AuxSignerData.
+func (b *AuxTxBuilder) SetChainID(chainID string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.ChainId = chainID
+}
+
+// SetSequence sets the aux signer's sequence in the AuxSignerData.
+func (b *AuxTxBuilder) SetSequence(accSeq uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.Sequence = accSeq
+}
+
+// SetPubKey sets the aux signer's pubkey in the AuxSignerData.
+func (b *AuxTxBuilder) SetPubKey(pk cryptotypes.PubKey) error {
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return err
+	}
+
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.PublicKey = any
+
+	return nil
+}
+
+// SetTip sets an optional tip in the AuxSignerData.
+func (b *AuxTxBuilder) SetTip(tip *tx.Tip) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.Tip = tip
+}
+
+// SetSignMode sets the aux signer's sign mode. Only SIGN_MODE_DIRECT_AUX is
+// supported for now.
+func (b *AuxTxBuilder) SetSignMode(mode signing.SignMode) error {
+	if mode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "AuxTxBuilder can only sign with %s, got %s", signing.SignMode_SIGN_MODE_DIRECT_AUX, mode)
+	}
+
+	b.checkEmptyFields()
+
+	b.auxSignerData.Mode = mode
+	return nil
+}
+
+// SetSignature sets the aux signer's signature in the AuxSignerData.
+func (b *AuxTxBuilder) SetSignature(sig []byte) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Sig = sig
+}
+
+// GetSignBytes returns the builder's sign bytes, i.e. the SignDocDirectAux
+// the aux signer signs over.
+func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
+	signDoc, err := b.getSignDoc()
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(signDoc)
+}
+
+// GetAuxSignerData returns the builder's AuxSignerData, which the aux signer
+// sends to the fee payer.
+func (b *AuxTxBuilder) GetAuxSignerData() (tx.AuxSignerData, error) {
+	if _, err := b.getSignDoc(); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	if err := b.auxSignerData.ValidateBasic(); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	return *b.auxSignerData, nil
+}
+
+// getSignDoc fills the body bytes of the sign doc and validates it.
+func (b *AuxTxBuilder) getSignDoc() (*tx.SignDocDirectAux, error) {
+	if b.body == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "tx body is nil, call setters on AuxTxBuilder first")
+	}
+
+	if len(b.bodyBz) == 0 {
+		var err error
+		b.bodyBz, err = proto.Marshal(b.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signDoc := b.auxSignerData.SignDoc
+	signDoc.BodyBytes = b.bodyBz
+	if err := signDoc.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return signDoc, nil
+}
+
+// checkEmptyFields initializes the builder's fields on first use.
+func (b *AuxTxBuilder) checkEmptyFields() {
+	if b.body == nil {
+		b.body = &tx.TxBody{}
+	}
+
+	if b.auxSignerData == nil {
+		b.auxSignerData = &tx.AuxSignerData{
+			Mode: signing.SignMode_SIGN_MODE_DIRECT_AUX,
+		}
+	}
+
+	if b.auxSignerData.SignDoc == nil {
+		b.auxSignerData.SignDoc = &tx.SignDocDirectAux{}
+	}
+}
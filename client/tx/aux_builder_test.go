@@ -0,0 +1,110 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestAuxTxBuilder(t *testing.T) {
+	_, pub, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+	tip := &txtypes.Tip{Tipper: addr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))}
+
+	testcases := []struct {
+		name      string
+		malleate  func(b *tx.AuxTxBuilder) error
+		expErr    bool
+		expSignBz bool
+	}{
+		{
+			"cannot get sign bytes without setting fields",
+			func(b *tx.AuxTxBuilder) error { return nil },
+			true, false,
+		},
+		{
+			"cannot sign in another mode than DIRECT_AUX",
+			func(b *tx.AuxTxBuilder) error {
+				return b.SetSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+			},
+			true, false,
+		},
+		{
+			"pubkey is required",
+			func(b *tx.AuxTxBuilder) error {
+				b.SetAddress(addr.String())
+				return b.SetMsgs(msg)
+			},
+			true, false,
+		},
+		{
+			"signature is required",
+			func(b *tx.AuxTxBuilder) error {
+				b.SetAddress(addr.String())
+				b.SetChainID("test-chain")
+				b.SetTip(tip)
+				if err := b.SetMsgs(msg); err != nil {
+					return err
+				}
+				return b.SetPubKey(pub)
+			},
+			true, true,
+		},
+		{
+			"happy case",
+			func(b *tx.AuxTxBuilder) error {
+				b.SetAddress(addr.String())
+				b.SetChainID("test-chain")
+				b.SetAccountNumber(1)
+				b.SetSequence(2)
+				b.SetMemo("memo")
+				b.SetTimeoutHeight(3)
+				b.SetTip(tip)
+				if err := b.SetMsgs(msg); err != nil {
+					return err
+				}
+				if err := b.SetPubKey(pub); err != nil {
+					return err
+				}
+				b.SetSignature([]byte("sig"))
+				return nil
+			},
+			false, true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			b := tx.NewAuxTxBuilder()
+			err := tc.malleate(&b)
+
+			if err == nil {
+				_, err = b.GetSignBytes()
+				require.Equal(t, tc.expSignBz, err == nil)
+
+				var data txtypes.AuxSignerData
+				data, err = b.GetAuxSignerData()
+				if err == nil {
+					require.Equal(t, addr.String(), data.Address)
+					require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, data.Mode)
+					require.Equal(t, tip, data.SignDoc.Tip)
+					require.Equal(t, "test-chain", data.SignDoc.ChainId)
+					require.NotEmpty(t, data.SignDoc.BodyBytes)
+				}
+			}
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -32,6 +33,7 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	tip                *tx.Tip
 }
 
 // NewFactoryCLI creates a new Factory.
@@ -44,6 +46,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case flags.SignModeLegacyAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeDirectAux:
+		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -76,6 +80,11 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	tipsStr, _ := flagSet.GetString(flags.FlagTip)
+	// Add tips to factory. The tipper is necessarily the Msg signer, i.e.
+	// the from address.
+	f = f.WithTips(tipsStr, clientCtx.FromAddress.String())
+
 	return f
 }
 
@@ -90,6 +99,7 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) Tip() *tx.Tip                              { return f.tip }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -141,6 +151,26 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithTips returns a copy of the Factory with an updated tip. An empty tip
+// string removes the tip.
+func (f Factory) WithTips(tip string, tipper string) Factory {
+	if tip == "" {
+		f.tip = nil
+		return f
+	}
+
+	parsedTips, err := sdk.ParseCoinsNormalized(tip)
+	if err != nil {
+		panic(err)
+	}
+
+	f.tip = &tx.Tip{
+		Tipper: tipper,
+		Amount: parsedTips,
+	}
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -202,6 +232,70 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	fees, err := f.computeFees()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := f.txConfig.NewTxBuilder()
+
+	if err := tx.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+
+	tx.SetMemo(f.memo)
+	tx.SetFeeAmount(fees)
+	tx.SetGasLimit(f.gas)
+	tx.SetTimeoutHeight(f.TimeoutHeight())
+	if f.tip != nil {
+		tx.SetTip(f.tip)
+	}
+
+	return tx, nil
+}
+
+// BuildTxWithAuxSignerData builds a transaction on behalf of the fee payer
+// from the AuxSignerData of one or more auxiliary signers (e.g. a tipper). The
+// msgs, memo, timeout height and tip are taken from the AuxSignerData, while
+// the fee and gas limit are set from the Factory. The fee payer then signs the
+// returned TxBuilder with Sign, without overwriting the aux signatures.
+func (f Factory) BuildTxWithAuxSignerData(feePayer sdk.AccAddress, auxSignerData ...tx.AuxSignerData) (client.TxBuilder, error) {
+	if f.chainID == "" {
+		return nil, fmt.Errorf("chain ID required but not specified")
+	}
+
+	if len(auxSignerData) == 0 {
+		return nil, errors.New("at least one AuxSignerData is required")
+	}
+
+	fees, err := f.computeFees()
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder := f.txConfig.NewTxBuilder()
+	for _, data := range auxSignerData {
+		if data.SignDoc != nil && data.SignDoc.ChainId != f.chainID {
+			return nil, fmt.Errorf("AuxSignerData of %s has chain ID %s, expected %s", data.Address, data.SignDoc.ChainId, f.chainID)
+		}
+
+		if err := txBuilder.AddAuxSignerData(data); err != nil {
+			return nil, err
+		}
+	}
+
+	// The fee payer must be set after the AuxSignerData, so that its signer
+	// info comes after the aux signers' ones.
+	txBuilder.SetFeePayer(feePayer)
+	txBuilder.SetFeeAmount(fees)
+	txBuilder.SetGasLimit(f.gas)
+
+	return txBuilder, nil
+}
+
+// computeFees returns the fees set in the Factory, or derives them from the
+// gas prices, where fee = ceil(gasPrice * gasLimit).
+func (f Factory) computeFees() (sdk.Coins, error) {
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -211,8 +305,6 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 
 		glDec := sdk.NewDec(int64(f.gas))
 
-		// Derive the fees based on the provided gas prices, where
-		// fee = ceil(gasPrice * gasLimit).
 		fees = make(sdk.Coins, len(f.gasPrices))
 
 		for i, gp := range f.gasPrices {
@@ -221,18 +313,7 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		}
 	}
 
-	tx := f.txConfig.NewTxBuilder()
-
-	if err := tx.SetMsgs(msgs...); err != nil {
-		return nil, err
-	}
-
-	tx.SetMemo(f.memo)
-	tx.SetFeeAmount(fees)
-	tx.SetGasLimit(f.gas)
-	tx.SetTimeoutHeight(f.TimeoutHeight())
-
-	return tx, nil
+	return fees, nil
 }
 
 // PrintUnsignedTx will generate an unsigned transaction and print it to the writer
@@ -40,6 +40,15 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 		}
 	}
 
+	if clientCtx.IsAux {
+		auxSignerData, err := MakeAuxSignerData(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(&auxSignerData)
+	}
+
 	if clientCtx.GenerateOnly {
 		return txf.PrintUnsignedTx(clientCtx, msgs...)
 	}
@@ -169,11 +178,36 @@ func SignWithPrivKey(
 	return sigV2, nil
 }
 
-func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
-	if mode == signing.SignMode_SIGN_MODE_DIRECT &&
-		len(tx.GetSigners()) > 1 {
-		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
+// checkMultipleSigners checks that a tx with multiple signers is not signed in
+// DIRECT mode, unless all the other signers are auxiliary signers (e.g.
+// tippers) who already signed in DIRECT_AUX mode: SIGN_MODE_DIRECT signs over
+// the signer infos of all the signers.
+func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx, overwriteSig bool) error {
+	if mode != signing.SignMode_SIGN_MODE_DIRECT || len(tx.GetSigners()) <= 1 {
+		return nil
+	}
+
+	errMultipleSigners := sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
+	if overwriteSig {
+		return errMultipleSigners
+	}
+
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	if len(sigs) != len(tx.GetSigners())-1 {
+		return errMultipleSigners
+	}
+
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+			return errMultipleSigners
+		}
 	}
+
 	return nil
 }
 
@@ -193,7 +227,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		// use the SignModeHandler's default mode if unspecified
 		signMode = txf.txConfig.SignModeHandler().DefaultMode()
 	}
-	if err := checkMultipleSigners(signMode, txBuilder.GetTx()); err != nil {
+	if err := checkMultipleSigners(signMode, txBuilder.GetTx(), overwriteSig); err != nil {
 		return err
 	}
 
@@ -252,7 +286,9 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 			return err
 		}
 	}
-	if err := txBuilder.SetSignatures(sig); err != nil {
+	// Overwrite or append the signer infos: SIGN_MODE_DIRECT signs over the
+	// signer infos of the previous signers too.
+	if err := txBuilder.SetSignatures(append(prevSignatures, sig)...); err != nil {
 		return err
 	}
 
@@ -286,6 +322,73 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	return txBuilder.SetSignatures(prevSignatures...)
 }
 
+// MakeAuxSignerData generates an AuxSignerData from the client inputs, signed
+// in SIGN_MODE_DIRECT_AUX by the from key. The AuxSignerData is meant to be
+// sent to the fee payer, who adds the fee and broadcasts the final tx.
+func MakeAuxSignerData(clientCtx client.Context, f Factory, msgs ...sdk.Msg) (tx.AuxSignerData, error) {
+	b := NewAuxTxBuilder()
+	fromAddress, name := clientCtx.GetFromAddress(), clientCtx.GetFromName()
+
+	b.SetAddress(fromAddress.String())
+	if clientCtx.Offline {
+		b.SetAccountNumber(f.accountNumber)
+		b.SetSequence(f.sequence)
+	} else {
+		accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, fromAddress)
+		if err != nil {
+			return tx.AuxSignerData{}, err
+		}
+		b.SetAccountNumber(accNum)
+		b.SetSequence(seq)
+	}
+
+	if err := b.SetMsgs(msgs...); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	if f.tip != nil {
+		if _, err := sdk.AccAddressFromBech32(f.tip.Tipper); err != nil {
+			return tx.AuxSignerData{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "tipper must be a bech32 address")
+		}
+		b.SetTip(f.tip)
+	}
+
+	if err := b.SetSignMode(f.SignMode()); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	key, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	pub, err := key.GetPubKey()
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	if err := b.SetPubKey(pub); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	b.SetChainID(clientCtx.ChainID)
+	b.SetMemo(f.memo)
+	b.SetTimeoutHeight(f.timeoutHeight)
+
+	signBz, err := b.GetSignBytes()
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	sig, _, err := clientCtx.Keyring.Sign(name, signBz)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	b.SetSignature(sig)
+
+	return b.GetAuxSignerData()
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
@@ -246,3 +246,85 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+func TestSignWithAuxSignerData(t *testing.T) {
+	requireT := require.New(t)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	encCfg := simapp.MakeTestEncodingConfig()
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, encCfg.Codec)
+	requireT.NoError(err)
+
+	tipperKey, _, err := kb.NewMnemonic("tipper", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	feePayerKey, _, err := kb.NewMnemonic("feepayer", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	tipperAddr, err := tipperKey.GetAddress()
+	requireT.NoError(err)
+	feePayerAddr, err := feePayerKey.GetAddress()
+	requireT.NoError(err)
+
+	clientCtx := client.Context{}.
+		WithKeyring(kb).
+		WithOffline(true).
+		WithChainID("test-chain").
+		WithFromName("tipper").
+		WithFromAddress(tipperAddr)
+	txConfig := NewTestTxConfig()
+	msg := banktypes.NewMsgSend(tipperAddr, sdk.AccAddress("to"), nil)
+
+	// The tipper generates the AuxSignerData.
+	tipperTxf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithAccountNumber(1).
+		WithSequence(2).
+		WithMemo("memo").
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX).
+		WithTips("10stake", tipperAddr.String())
+	auxSignerData, err := tx.MakeAuxSignerData(clientCtx, tipperTxf, msg)
+	requireT.NoError(err)
+	requireT.Equal(tipperAddr.String(), auxSignerData.Address)
+	requireT.Equal(tipperTxf.Tip(), auxSignerData.SignDoc.Tip)
+
+	// The tipper cannot sign in another mode.
+	_, err = tx.MakeAuxSignerData(clientCtx, tipperTxf.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT), msg)
+	requireT.Error(err)
+
+	// The fee payer adds the fee and signs the tx.
+	feePayerTxf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kb).
+		WithAccountNumber(3).
+		WithSequence(4).
+		WithFees("50stake").
+		WithGas(200000).
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+	txb, err := feePayerTxf.BuildTxWithAuxSignerData(feePayerAddr, auxSignerData)
+	requireT.NoError(err)
+	requireT.NoError(tx.Sign(feePayerTxf, "feepayer", txb, false))
+
+	sigTx := txb.GetTx()
+	requireT.Equal([]sdk.AccAddress{tipperAddr, feePayerAddr}, sigTx.GetSigners())
+	requireT.Equal(feePayerAddr, sigTx.FeePayer())
+	requireT.Equal("memo", sigTx.GetMemo())
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), sigTx.GetFee())
+
+	sigs, err := sigTx.GetSignaturesV2()
+	requireT.NoError(err)
+	requireT.Len(sigs, 2)
+
+	signerData := []signing.SignerData{
+		{Address: tipperAddr.String(), ChainID: "test-chain", AccountNumber: 1, Sequence: 2, SignerIndex: 0},
+		{Address: feePayerAddr.String(), ChainID: "test-chain", AccountNumber: 3, Sequence: 4, SignerIndex: 1},
+	}
+	for i, mode := range []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingtypes.SignMode_SIGN_MODE_DIRECT} {
+		data, ok := sigs[i].Data.(*signingtypes.SingleSignatureData)
+		requireT.True(ok)
+		requireT.Equal(mode, data.SignMode)
+		requireT.NoError(signing.VerifySignature(sigs[i].PubKey, signerData[i], sigs[i].Data, txConfig.SignModeHandler(), sigTx))
+	}
+
+	// Overwriting the aux signature is not possible in DIRECT mode.
+	requireT.Error(tx.Sign(feePayerTxf, "feepayer", txb, true))
+}
@@ -45,5 +45,7 @@ type (
 		SetTip(tip *tx.Tip)
 		SetTimeoutHeight(height uint64)
 		SetFeeGranter(feeGranter sdk.AccAddress)
+		SetFeePayer(feePayer sdk.AccAddress)
+		AddAuxSignerData(tx.AuxSignerData) error
 	}
 )
@@ -29,6 +29,10 @@ type BaseConfig struct {
 	// specified in this config (e.g. 0.25token1;0.0001token2).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
 
+	// GasPriceOracleFile is the path to a JSON file of exchange rates, used to
+	// value the fees paid in several denoms against the minimum gas prices.
+	GasPriceOracleFile string `mapstructure:"gas-price-oracle-file"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
@@ -258,16 +262,17 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			MinGasPrices:       v.GetString("minimum-gas-prices"),
+			GasPriceOracleFile: v.GetString("gas-price-oracle-file"),
+			InterBlockCache:    v.GetBool("inter-block-cache"),
+			Pruning:            v.GetString("pruning"),
+			PruningKeepRecent:  v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:   v.GetString("pruning-keep-every"),
+			PruningInterval:    v.GetString("pruning-interval"),
+			HaltHeight:         v.GetUint64("halt-height"),
+			HaltTime:           v.GetUint64("halt-time"),
+			IndexEvents:        v.GetStringSlice("index-events"),
+			MinRetainBlocks:    v.GetUint64("min-retain-blocks"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -58,6 +58,20 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestGasPriceOracleFileWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.GasPriceOracleFile = "/path/to/oracle.json"
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, "/path/to/oracle.json", cfg.GasPriceOracleFile)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
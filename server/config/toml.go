@@ -20,6 +20,13 @@ const DefaultConfigTemplate = `# This is a TOML config file.
 # specified in this config (e.g. 0.25token1;0.0001token2).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 
+# The path to a JSON file of exchange rates, used to value the fees paid in
+# several denominations in a common base denomination when checking them
+# against the minimum gas prices, e.g.
+# {"base_denom": "stake", "exchange_rates": {"atom": "2.5", "photon": "0.1"}}
+# If empty, each fee denomination is compared to its own minimum gas price.
+gas-price-oracle-file = "{{ .BaseConfig.GasPriceOracleFile }}"
+
 # default: the last 100 states are kept in addition to every 500th state; pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current state; pruning at 10 block intervals
@@ -37,6 +37,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankhistory "github.com/cosmos/cosmos-sdk/x/bank/history"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
@@ -55,6 +56,9 @@ import (
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	epochingkeeper "github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -66,6 +70,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -121,6 +128,8 @@ var (
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
+		groupmodule.AppModuleBasic{},
+		epoching.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -132,6 +141,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
+		epochingtypes.ModuleName:       {authtypes.Staking},
 	}
 )
 
@@ -174,6 +184,8 @@ type SimApp struct {
 	EvidenceKeeper   evidencekeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	NFTKeeper        nftkeeper.Keeper
+	GroupKeeper      groupkeeper.Keeper
+	EpochingKeeper   epochingkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -215,7 +227,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, nftkeeper.StoreKey,
+		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey,
+		epochingtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
@@ -258,6 +271,19 @@ func NewSimApp(
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
 	)
+	if cast.ToBool(appOpts.Get(bank.FlagBalanceHistory)) {
+		historyDB, err := sdk.NewLevelDB("balance_history", filepath.Join(homePath, "data"))
+		if err != nil {
+			tmos.Exit(err.Error())
+		}
+
+		index := bankhistory.NewIndex(
+			historyDB, appCodec, encodingConfig.TxConfig.TxDecoder(), keys[banktypes.StoreKey],
+			bApp.CommitMultiStore(), app.BankKeeper,
+		)
+		bApp.SetStreamingService(index)
+		app.BankKeeper.SetBalanceHistory(index)
+	}
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -279,14 +305,6 @@ func NewSimApp(
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
-	)
-
-	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.msgSvcRouter)
-
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
@@ -295,7 +313,22 @@ func NewSimApp(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, app.DistrKeeper, govRouter, app.msgSvcRouter,
+	)
+
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper = *stakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), govKeeper.StakingHooks()),
+	)
+
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.msgSvcRouter)
+
+	app.GroupKeeper = groupkeeper.NewKeeper(keys[group.StoreKey], appCodec, app.msgSvcRouter, app.AccountKeeper)
+
+	app.EpochingKeeper = epochingkeeper.NewKeeper(
+		appCodec, keys[epochingtypes.StoreKey], app.GetSubspace(epochingtypes.ModuleName), app.StakingKeeper,
+		app.BankKeeper, app.msgSvcRouter, cast.ToDuration(appOpts.Get("consensus.timeout_commit")),
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
@@ -317,6 +350,11 @@ func NewSimApp(
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
 	// we prefer to be more strict in what arguments the modules expect.
 	var skipGenesisInvariants = cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
+	app.CrisisKeeper.SetLogBrokenInvariants(cast.ToBool(appOpts.Get(crisis.FlagLogBrokenInvariants)))
+	app.CrisisKeeper.SetInvariantsQuery(
+		cast.ToBool(appOpts.Get(crisis.FlagInvariantsQuery)),
+		cast.ToUint64(appOpts.Get(crisis.FlagInvariantsQueryGas)),
+	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -341,6 +379,8 @@ func NewSimApp(
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		epoching.NewAppModule(appCodec, app.EpochingKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -350,9 +390,11 @@ func NewSimApp(
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName,
+		evidencetypes.ModuleName, stakingtypes.ModuleName, authz.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	// NOTE: epoching module's endblocker must come before staking so that the
+	// validator set changes of the queued staking msgs are applied at the end of the epoch.
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, epochingtypes.ModuleName, stakingtypes.ModuleName, feegrant.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -363,7 +405,7 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName, nft.ModuleName,
+		feegrant.ModuleName, nft.ModuleName, group.ModuleName, epochingtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -371,6 +413,9 @@ func NewSimApp(
 	app.configurator = module.NewConfigurator(app.appCodec, app.msgSvcRouter, app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
+	// queue the staking msgs which change the validator set until the end of the epoch
+	app.EpochingKeeper.RouteStakingMsgs()
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
@@ -404,7 +449,10 @@ func NewSimApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.setTxHandler(encodingConfig.TxConfig, cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents)))
+	app.setTxHandler(
+		encodingConfig.TxConfig, cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents)),
+		cast.ToString(appOpts.Get(server.FlagGasPriceOracleFile)),
+	)
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -415,11 +463,21 @@ func NewSimApp(
 	return app
 }
 
-func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []string) {
+func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []string, gasPriceOracleFile string) {
 	indexEvents := map[string]struct{}{}
 	for _, e := range indexEventsStr {
 		indexEvents[e] = struct{}{}
 	}
+
+	var gasPriceOracle authmiddleware.GasPriceOracle
+	if gasPriceOracleFile != "" {
+		var err error
+		gasPriceOracle, err = authmiddleware.LoadGasPriceOracleFromFile(gasPriceOracleFile)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	txHandler, err := authmiddleware.NewDefaultTxHandler(authmiddleware.TxHandlerOptions{
 		Debug:            app.Trace(),
 		IndexEvents:      indexEvents,
@@ -430,6 +488,7 @@ func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []strin
 		FeegrantKeeper:   app.FeeGrantKeeper,
 		SignModeHandler:  txConfig.SignModeHandler(),
 		SigGasConsumer:   authmiddleware.DefaultSigVerificationGasConsumer,
+		GasPriceOracle:   gasPriceOracle,
 	})
 	if err != nil {
 		panic(err)
@@ -497,6 +556,13 @@ func (app *SimApp) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// MsgServiceRouter returns SimApp's MsgServiceRouter.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) MsgServiceRouter() *authmiddleware.MsgServiceRouter {
+	return app.msgSvcRouter
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -592,6 +658,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
+	paramsKeeper.Subspace(epochingtypes.ModuleName)
 
 	return paramsKeeper
 }
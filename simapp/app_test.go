@@ -13,6 +13,8 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/v2/multi"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -25,10 +27,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -65,6 +69,42 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppWithV2MultiStore(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	db := memdb.NewDB()
+	newApp := func() (*SimApp, *multi.Store) {
+		cms := multi.NewStore(db)
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{},
+			func(bapp *baseapp.BaseApp) { bapp.SetCMS(cms) })
+		return app, cms
+	}
+
+	app, cms := newApp()
+	stateBytes, err := json.MarshalIndent(GenesisStateWithSingleValidator(t, app), "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	var res abci.ResponseCommit
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		res = app.Commit()
+	}
+	require.NotEmpty(t, res.Data)
+	require.NoError(t, cms.Close())
+
+	// the restarted app loads the state from the same DB
+	app, _ = newApp()
+	require.Equal(t, int64(3), app.LastBlockHeight())
+	require.Equal(t, res.Data, app.LastCommitID().Hash)
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: 3})
+	require.True(t, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).IsPositive())
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -127,8 +167,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -186,6 +231,8 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"group":        groupmodule.AppModule{}.ConsensusVersion(),
+					"epoching":     epoching.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -238,6 +285,8 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"crisis":       crisis.AppModule{}.ConsensusVersion(),
 			"genutil":      genutil.AppModule{}.ConsensusVersion(),
 			"capability":   capability.AppModule{}.ConsensusVersion(),
+			"group":        groupmodule.AppModule{}.ConsensusVersion(),
+			"epoching":     epoching.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -1,6 +1,7 @@
 package streaming
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,6 +10,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	streaminggrpc "github.com/cosmos/cosmos-sdk/store/streaming/grpc"
+	"github.com/cosmos/cosmos-sdk/store/streaming/kafka"
+	"github.com/cosmos/cosmos-sdk/store/streaming/outbox"
+	"github.com/cosmos/cosmos-sdk/store/streaming/sink"
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +29,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
+	Kafka
 	// add more in the future
 )
 
@@ -31,6 +39,10 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "grpc":
+		return GRPC
+	case "kafka":
+		return Kafka
 	default:
 		return Unknown
 	}
@@ -41,6 +53,10 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case GRPC:
+		return "grpc"
+	case Kafka:
+		return "kafka"
 	default:
 		return "unknown"
 	}
@@ -48,7 +64,9 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:  NewFileStreamingService,
+	GRPC:  NewGRPCStreamingService,
+	Kafka: NewKafkaStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -70,11 +88,66 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a StreamingService
+// serving the state changes to the subscribers of a gRPC server-streaming service
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get("streamers.grpc.address"))
+	if address == "" {
+		return nil, errors.New("streamers.grpc.address must be set")
+	}
+	bufferSize := cast.ToInt(opts.Get("streamers.grpc.buffer_size"))
+	return newSinkStreamingService(opts, GRPC, streaminggrpc.NewServer(address, bufferSize), keys)
+}
+
+// NewKafkaStreamingService is the streaming.ServiceConstructor function for creating a StreamingService
+// producing the state changes to a Kafka topic partition
+func NewKafkaStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	requiredAcks := kafka.DefaultRequiredAcks
+	if acks := opts.Get("streamers.kafka.required_acks"); acks != nil {
+		requiredAcks = cast.ToInt16(acks)
+	}
+
+	writer, err := kafka.NewWriter(kafka.Config{
+		Address:      cast.ToString(opts.Get("streamers.kafka.address")),
+		Topic:        cast.ToString(opts.Get("streamers.kafka.topic")),
+		Partition:    cast.ToInt32(opts.Get("streamers.kafka.partition")),
+		ClientID:     cast.ToString(opts.Get("streamers.kafka.client_id")),
+		RequiredAcks: requiredAcks,
+		Timeout:      cast.ToDuration(opts.Get("streamers.kafka.timeout")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newSinkStreamingService(opts, Kafka, writer, keys)
+}
+
+// newSinkStreamingService creates a StreamingService writing to s. If
+// streamers.<name>.outbox.dir is set, the messages are persisted in a durable
+// outbox before being delivered to s.
+func newSinkStreamingService(opts serverTypes.AppOptions, ssType ServiceType, s streamingtypes.Sink, keys []types.StoreKey) (baseapp.StreamingService, error) {
+	prefix := fmt.Sprintf("streamers.%s.outbox", ssType)
+	if dir := cast.ToString(opts.Get(prefix + ".dir")); dir != "" {
+		var err error
+		s, err = outbox.NewOutbox(outbox.Config{
+			Dir:              dir,
+			MaxPendingBlocks: cast.ToInt64(opts.Get(prefix + ".max_pending_blocks")),
+			CommitTimeout:    cast.ToDuration(opts.Get(prefix + ".commit_timeout")),
+			RetryInterval:    cast.ToDuration(opts.Get(prefix + ".retry_interval")),
+		}, s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sink.NewStreamingService(s, keys)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
 	// waitgroup and quit channel for optional shutdown coordination of the streaming service(s)
 	wg := new(sync.WaitGroup)
+	// halt the node, instead of logging the error, when a streaming service fails
+	bApp.SetHaltOnListenerError(cast.ToBool(appOpts.Get("store.halt_on_listener_error")))
 	// configure state listening capabilities using AppOptions
 	streamers := cast.ToStringSlice(appOpts.Get("store.streamers"))
 	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/sink"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -41,3 +42,53 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+type mapOptions map[string]interface{}
+
+func (m mapOptions) Get(key string) interface{} { return m[key] }
+
+func TestSinkStreamingServiceConstructors(t *testing.T) {
+	for _, name := range []string{"grpc", "kafka"} {
+		constructor, err := NewServiceConstructor(name)
+		require.NoError(t, err)
+
+		// the sinks require some configuration
+		_, err = constructor(mockOptions, mockKeys, testMarshaller)
+		require.Error(t, err)
+	}
+
+	opts := mapOptions{
+		"streamers.grpc.address":  "localhost:0",
+		"streamers.kafka.address": "localhost:9092",
+		"streamers.kafka.topic":   "state",
+	}
+	for _, name := range []string{"grpc", "kafka"} {
+		constructor, err := NewServiceConstructor(name)
+		require.NoError(t, err)
+
+		serv, err := constructor(opts, mockKeys, testMarshaller)
+		require.NoError(t, err)
+		require.IsType(t, &sink.StreamingService{}, serv)
+		listeners := serv.Listeners()
+		for _, key := range mockKeys {
+			_, ok := listeners[key]
+			require.True(t, ok)
+		}
+	}
+}
+
+func TestSinkStreamingServiceOutbox(t *testing.T) {
+	opts := mapOptions{
+		"streamers.grpc.address":                   "localhost:0",
+		"streamers.grpc.outbox.dir":                t.TempDir(),
+		"streamers.grpc.outbox.max_pending_blocks": -1,
+	}
+	_, err := NewGRPCStreamingService(opts, mockKeys, testMarshaller)
+	require.Error(t, err)
+
+	opts["streamers.grpc.outbox.max_pending_blocks"] = 10
+	opts["streamers.grpc.outbox.commit_timeout"] = "30s"
+	serv, err := NewGRPCStreamingService(opts, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.IsType(t, &sink.StreamingService{}, serv)
+}
@@ -23,6 +23,7 @@ var _ baseapp.StreamingService = &StreamingService{}
 type StreamingService struct {
 	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
 	srcChan            <-chan []byte                            // the channel that all the WriteListeners write their data out to
+	drainChan          chan chan struct{}                       // the channel used to wait for the data received from srcChan to be cached
 	filePrefix         string                                   // optional prefix for each of the generated files
 	writeDir           string                                   // directory to write files into
 	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
@@ -30,6 +31,7 @@ type StreamingService struct {
 	stateCacheLock     *sync.Mutex                              // mutex for the state cache
 	currentBlockNumber int64                                    // the current block number
 	currentTxIndex     int64                                    // the index of the current tx
+	blockFiles         []string                                 // the files written for the current block, synced to disk on commit
 	quitChan           chan struct{}                            // channel to synchronize closure
 }
 
@@ -70,6 +72,7 @@ func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey
 	return &StreamingService{
 		listeners:      listeners,
 		srcChan:        listenChan,
+		drainChan:      make(chan chan struct{}),
 		filePrefix:     filePrefix,
 		writeDir:       writeDir,
 		codec:          c,
@@ -103,6 +106,7 @@ func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestB
 		return err
 	}
 	// write all state changes cached for this stage to file
+	fss.drain()
 	fss.stateCacheLock.Lock()
 	for _, stateChange := range fss.stateCache {
 		if _, err = dstFile.Write(stateChange); err != nil {
@@ -133,7 +137,7 @@ func (fss *StreamingService) openBeginBlockFile(req abci.RequestBeginBlock) (*os
 	if fss.filePrefix != "" {
 		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
+	return fss.openFile(fileName)
 }
 
 // ListenDeliverTx satisfies the baseapp.ABCIListener interface
@@ -154,6 +158,7 @@ func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDe
 		return err
 	}
 	// write all state changes cached for this stage to file
+	fss.drain()
 	fss.stateCacheLock.Lock()
 	for _, stateChange := range fss.stateCache {
 		if _, err = dstFile.Write(stateChange); err != nil {
@@ -183,7 +188,7 @@ func (fss *StreamingService) openDeliverTxFile() (*os.File, error) {
 		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
 	}
 	fss.currentTxIndex++
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
+	return fss.openFile(fileName)
 }
 
 // ListenEndBlock satisfies the baseapp.ABCIListener interface
@@ -204,6 +209,7 @@ func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEnd
 		return err
 	}
 	// write all state changes cached for this stage to file
+	fss.drain()
 	fss.stateCacheLock.Lock()
 	for _, stateChange := range fss.stateCache {
 		if _, err = dstFile.Write(stateChange); err != nil {
@@ -227,12 +233,59 @@ func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEnd
 	return dstFile.Close()
 }
 
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It waits for the state changes sent by the WriteListeners to be received,
+// and syncs the files written for the block to disk before the block is
+// committed
+func (fss *StreamingService) ListenCommit(ctx sdk.Context) error {
+	fss.drain()
+
+	files := fss.blockFiles
+	fss.blockFiles = nil
+	for _, fileName := range files {
+		if err := syncFile(fileName); err != nil {
+			return err
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	// sync the directory too, so that the new files are not lost
+	return syncFile(fss.writeDir)
+}
+
+// openFile creates the file fileName in the write directory, and records it
+// as a file of the current block.
+func (fss *StreamingService) openFile(fileName string) (*os.File, error) {
+	filePath := filepath.Join(fss.writeDir, fileName)
+	fss.blockFiles = append(fss.blockFiles, filePath)
+	return os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY, 0600)
+}
+
+// drain waits until the Stream goroutine has cached all the data sent to it
+// by the IntermediateWriter. The writes are unbuffered, so a completed write
+// was received, and is cached before the goroutine handles the drain request.
+func (fss *StreamingService) drain() {
+	quitChan := fss.quitChan
+	if quitChan == nil {
+		return
+	}
+
+	done := make(chan struct{})
+	select {
+	case fss.drainChan <- done:
+		<-done
+	case <-quitChan:
+	}
+}
+
 func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
 	fileName := fmt.Sprintf("block-%d-end", fss.currentBlockNumber)
 	if fss.filePrefix != "" {
 		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
+	return fss.openFile(fileName)
 }
 
 // Stream satisfies the baseapp.StreamingService interface
@@ -256,6 +309,8 @@ func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 				fss.stateCacheLock.Lock()
 				fss.stateCache = append(fss.stateCache, by)
 				fss.stateCacheLock.Unlock()
+			case done := <-fss.drainChan:
+				close(done)
 			}
 		}
 	}()
@@ -268,6 +323,17 @@ func (fss *StreamingService) Close() error {
 	return nil
 }
 
+// syncFile syncs the file, or directory, named name to disk.
+func syncFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return f.Sync()
+}
+
 // isDirWriteable checks if dir is writable by writing and removing a file
 // to dir. It returns nil if dir is writable.
 func isDirWriteable(dir string) error {
@@ -147,10 +147,18 @@ func TestFileStreamingService(t *testing.T) {
 	testListenDeliverTx1(t)
 	testListenDeliverTx2(t)
 	testListenEndBlock(t)
+	testListenCommit(t)
 	testStreamingService.Close()
 	wg.Wait()
 }
 
+func testListenCommit(t *testing.T) {
+	require.Len(t, testStreamingService.blockFiles, 4)
+	err := testStreamingService.ListenCommit(emptyContext)
+	require.Nil(t, err)
+	require.Empty(t, testStreamingService.blockFiles)
+}
+
 func testListenBeginBlock(t *testing.T) {
 	expectedBeginBlockReqBytes, err := testMarshaller.Marshal(&testBeginBlockReq)
 	require.Nil(t, err)
@@ -0,0 +1,200 @@
+package grpc
+
+import (
+	"errors"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
+)
+
+// DefaultBufferSize is the default number of StreamMessages buffered for each
+// subscriber before it is considered too slow and dropped.
+const DefaultBufferSize = 1000
+
+var (
+	_ streamingtypes.Sink                   = &Server{}
+	_ streamingtypes.StreamingServiceServer = &Server{}
+)
+
+// Server is a Sink serving the StreamMessages to the subscribers of the
+// StreamingService gRPC service. Each subscriber receives the messages
+// matching its filters. A subscriber which does not keep up with the chain is
+// dropped, so that it never blocks the state machine.
+type Server struct {
+	address    string
+	bufferSize int
+
+	grpcServer *grpc.Server
+	listener   net.Listener
+
+	mtx         sync.Mutex
+	subscribers map[uint64]*subscriber
+	nextID      uint64
+}
+
+// subscriber is a client of the Subscribe RPC method.
+type subscriber struct {
+	req  *streamingtypes.SubscribeRequest
+	msgs chan *streamingtypes.StreamMessage
+	// dropped is set before msgs is closed if the subscriber was too slow.
+	dropped bool
+}
+
+// NewServer creates a new Server listening on address once started. A
+// bufferSize of zero uses DefaultBufferSize.
+func NewServer(address string, bufferSize int) *Server {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	s := &Server{
+		address:     address,
+		bufferSize:  bufferSize,
+		grpcServer:  grpc.NewServer(),
+		subscribers: make(map[uint64]*subscriber),
+	}
+	streamingtypes.RegisterStreamingServiceServer(s.grpcServer, s)
+
+	return s
+}
+
+// Addr returns the address the server listens on, or nil if it is not started.
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+
+	return s.listener.Addr()
+}
+
+// Start implements the Sink interface. It starts serving the gRPC clients in
+// the background.
+func (s *Server) Start(wg *sync.WaitGroup) error {
+	if s.listener != nil {
+		return errors.New("gRPC streaming server already started")
+	}
+
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Serve returns once the server is stopped in Close.
+		_ = s.grpcServer.Serve(listener)
+	}()
+
+	return nil
+}
+
+// Write implements the Sink interface. It sends the message to the matching
+// subscribers without blocking.
+func (s *Server) Write(msg *streamingtypes.StreamMessage) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for id, sub := range s.subscribers {
+		if sub.req.Done(msg.BlockHeight) {
+			s.removeSubscriber(id, false)
+			continue
+		}
+
+		filtered := sub.req.Filter(msg)
+		if filtered == nil {
+			continue
+		}
+
+		select {
+		case sub.msgs <- filtered:
+		default:
+			s.removeSubscriber(id, true)
+		}
+	}
+
+	return nil
+}
+
+// Close implements the Sink interface. It stops the gRPC server and ends all
+// the subscriptions.
+func (s *Server) Close() error {
+	s.mtx.Lock()
+	for id := range s.subscribers {
+		s.removeSubscriber(id, false)
+	}
+	s.mtx.Unlock()
+
+	s.grpcServer.Stop()
+	s.listener = nil
+
+	return nil
+}
+
+// Subscribe implements the StreamingServiceServer interface.
+func (s *Server) Subscribe(req *streamingtypes.SubscribeRequest, stream streamingtypes.StreamingService_SubscribeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub := &subscriber{
+		req:  req,
+		msgs: make(chan *streamingtypes.StreamMessage, s.bufferSize),
+	}
+
+	s.mtx.Lock()
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = sub
+	s.mtx.Unlock()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			s.mtx.Lock()
+			if _, ok := s.subscribers[id]; ok {
+				s.removeSubscriber(id, false)
+			}
+			s.mtx.Unlock()
+
+			return status.FromContextError(stream.Context().Err()).Err()
+
+		case msg, ok := <-sub.msgs:
+			if !ok {
+				if sub.dropped {
+					return status.Error(codes.ResourceExhausted, "subscriber is too slow, the stream buffer is full")
+				}
+
+				return nil
+			}
+
+			if err := stream.Send(msg); err != nil {
+				s.mtx.Lock()
+				if _, ok := s.subscribers[id]; ok {
+					s.removeSubscriber(id, false)
+				}
+				s.mtx.Unlock()
+
+				return err
+			}
+		}
+	}
+}
+
+// removeSubscriber ends a subscription. The caller must hold s.mtx.
+func (s *Server) removeSubscriber(id uint64, dropped bool) {
+	sub := s.subscribers[id]
+	sub.dropped = dropped
+	close(sub.msgs)
+	delete(s.subscribers, id)
+}
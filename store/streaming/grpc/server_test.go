@@ -0,0 +1,206 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/sink"
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+	msgSendURL    = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+// mkTx returns a protobuf encoded tx containing msgs of the given type URLs.
+func mkTx(t *testing.T, typeURLs ...string) []byte {
+	body := tx.TxBody{}
+	for _, typeURL := range typeURLs {
+		body.Messages = append(body.Messages, &codectypes.Any{TypeUrl: typeURL})
+	}
+	bodyBz, err := body.Marshal()
+	require.NoError(t, err)
+	txBz, err := (&tx.TxRaw{BodyBytes: bodyBz}).Marshal()
+	require.NoError(t, err)
+	return txBz
+}
+
+// setup starts a gRPC streaming server behind a sink.StreamingService, and
+// returns a client connected to it.
+func setup(t *testing.T, bufferSize int) (*Server, *sink.StreamingService, streamingtypes.StreamingServiceClient) {
+	server := NewServer("127.0.0.1:0", bufferSize)
+	ss, err := sink.NewStreamingService(server, []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, ss.Stream(wg))
+	t.Cleanup(func() {
+		require.NoError(t, ss.Close())
+		wg.Wait()
+	})
+
+	conn, err := grpc.Dial(server.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return server, ss, streamingtypes.NewStreamingServiceClient(conn)
+}
+
+// subscribe subscribes to the server and waits for the subscription to be
+// registered.
+func subscribe(t *testing.T, server *Server, client streamingtypes.StreamingServiceClient, req *streamingtypes.SubscribeRequest) streamingtypes.StreamingService_SubscribeClient {
+	server.mtx.Lock()
+	n := len(server.subscribers)
+	server.mtx.Unlock()
+
+	stream, err := client.Subscribe(context.Background(), req)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		server.mtx.Lock()
+		defer server.mtx.Unlock()
+		return len(server.subscribers) == n+1
+	}, time.Second, 10*time.Millisecond)
+
+	return stream
+}
+
+// processBlock simulates the BaseApp processing a block with the given txs,
+// each tx writing to both stores.
+func processBlock(t *testing.T, ss *sink.StreamingService, height int64, txs ...[]byte) {
+	ctx := sdk.Context{}
+	listeners := ss.Listeners()
+
+	require.NoError(t, listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte("begin"), []byte("1"), false))
+	require.NoError(t, ss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+
+	for _, txBz := range txs {
+		require.NoError(t, listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte("tx1"), []byte("1"), false))
+		require.NoError(t, listeners[mockStoreKey2][0].OnWrite(mockStoreKey2, []byte("tx2"), nil, true))
+		require.NoError(t, ss.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: txBz}, abci.ResponseDeliverTx{}))
+	}
+
+	require.NoError(t, ss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	require.NoError(t, ss.ListenCommit(ctx))
+}
+
+func TestSubscribe(t *testing.T) {
+	server, ss, client := setup(t, 0)
+
+	all := subscribe(t, server, client, &streamingtypes.SubscribeRequest{})
+	filtered := subscribe(t, server, client, &streamingtypes.SubscribeRequest{
+		StoreKeys:   []string{mockStoreKey2.Name()},
+		StartHeight: 2,
+		EndHeight:   2,
+		MsgTypeUrls: []string{msgSendURL},
+	})
+
+	processBlock(t, ss, 1, mkTx(t, msgSendURL))
+	processBlock(t, ss, 2, mkTx(t, "/cosmos.staking.v1beta1.MsgDelegate"), mkTx(t, msgSendURL))
+	processBlock(t, ss, 3, mkTx(t, msgSendURL))
+
+	// The unfiltered subscriber receives everything, sequenced by their index
+	// in the block.
+	for height := int64(1); height <= 3; height++ {
+		msg, err := all.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, msg.BlockHeight)
+		require.Equal(t, uint64(0), msg.Index)
+		require.NotNil(t, msg.GetBeginBlock())
+		require.Len(t, msg.StateChanges, 1)
+
+		numTxs := 1
+		if height == 2 {
+			numTxs = 2
+		}
+		for i := 0; i < numTxs; i++ {
+			msg, err = all.Recv()
+			require.NoError(t, err)
+			require.Equal(t, int64(i), msg.GetDeliverTx().TxIndex)
+			require.Equal(t, uint64(i+1), msg.Index)
+			require.Len(t, msg.StateChanges, 2)
+		}
+
+		msg, err = all.Recv()
+		require.NoError(t, err)
+		require.NotNil(t, msg.GetEndBlock())
+		require.Equal(t, uint64(numTxs+1), msg.Index)
+		require.Empty(t, msg.StateChanges)
+
+		msg, err = all.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, msg.BlockHeight)
+		require.NotNil(t, msg.GetCommit())
+		require.Equal(t, uint64(numTxs+2), msg.Index)
+	}
+
+	// The filtered subscriber only receives block 2, the MsgSend tx and the
+	// state changes of the second store, and its stream then ends.
+	msg, err := filtered.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), msg.BlockHeight)
+	require.NotNil(t, msg.GetBeginBlock())
+	require.Empty(t, msg.StateChanges)
+
+	msg, err = filtered.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), msg.GetDeliverTx().TxIndex)
+	require.Equal(t, uint64(2), msg.Index)
+	require.Len(t, msg.StateChanges, 1)
+	require.Equal(t, mockStoreKey2.Name(), msg.StateChanges[0].StoreKey)
+	require.True(t, msg.StateChanges[0].Delete)
+
+	msg, err = filtered.Recv()
+	require.NoError(t, err)
+	require.NotNil(t, msg.GetEndBlock())
+
+	msg, err = filtered.Recv()
+	require.NoError(t, err)
+	require.NotNil(t, msg.GetCommit())
+
+	_, err = filtered.Recv()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestSubscribeInvalidRequest(t *testing.T) {
+	_, _, client := setup(t, 0)
+
+	stream, err := client.Subscribe(context.Background(), &streamingtypes.SubscribeRequest{StartHeight: 5, EndHeight: 4})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSlowSubscriberIsDropped(t *testing.T) {
+	server := NewServer("127.0.0.1:0", 2)
+	sub := &subscriber{
+		req:  &streamingtypes.SubscribeRequest{},
+		msgs: make(chan *streamingtypes.StreamMessage, server.bufferSize),
+	}
+	server.subscribers[0] = sub
+
+	// Nobody consumes the subscriber's messages, so the third one overflows
+	// its buffer.
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, server.Write(&streamingtypes.StreamMessage{BlockHeight: height}))
+	}
+
+	require.Empty(t, server.subscribers)
+	require.True(t, sub.dropped)
+	require.Len(t, sub.msgs, 2)
+}
@@ -0,0 +1,402 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// This file implements the subset of the Kafka wire protocol needed to
+// produce messages, i.e. the Produce request and response, version 3, with
+// messages in the v2 RecordBatch format. Produce v3 is the oldest version
+// still supported by Kafka 4.0, and is understood by the brokers since Kafka
+// 0.11.
+//
+// Ref: https://kafka.apache.org/protocol
+// Ref: https://kafka.apache.org/documentation/#recordbatch
+
+const (
+	apiKeyProduce     int16 = 0
+	apiVersionProduce int16 = 3
+	recordBatchMagic  int8  = 2
+
+	// recordBatchOverhead is the size of the RecordBatch fields following the
+	// batch length, up to the records.
+	recordBatchOverhead = 4 + 1 + 4 + 2 + 4 + 8 + 8 + 8 + 2 + 4 + 4
+)
+
+// castagnoli is the CRC-32C table used by the v2 RecordBatch checksum.
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Message is a key/value pair produced to a topic partition.
+type Message struct {
+	Key   []byte
+	Value []byte
+}
+
+// ProduceRequest is a Produce request to a single topic partition.
+type ProduceRequest struct {
+	CorrelationID int32
+	ClientID      string
+	RequiredAcks  int16
+	TimeoutMs     int32
+	Topic         string
+	Partition     int32
+	// TimestampMs is the creation time of the messages, in milliseconds since
+	// the Unix epoch.
+	TimestampMs int64
+	Messages    []Message
+}
+
+// ProduceResponse is the response to a Produce request to a single topic
+// partition.
+type ProduceResponse struct {
+	CorrelationID int32
+	Topic         string
+	Partition     int32
+	ErrorCode     int16
+	BaseOffset    int64
+}
+
+// encoder writes the Kafka protocol primitive types in big-endian order.
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) int8(v int8)   { e.buf.WriteByte(byte(v)) }
+func (e *encoder) int16(v int16) { _ = binary.Write(&e.buf, binary.BigEndian, v) }
+func (e *encoder) int32(v int32) { _ = binary.Write(&e.buf, binary.BigEndian, v) }
+func (e *encoder) int64(v int64) { _ = binary.Write(&e.buf, binary.BigEndian, v) }
+
+// varint writes a zigzag encoded variable length integer, as used by the
+// records of a RecordBatch.
+func (e *encoder) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	e.buf.Write(b[:binary.PutVarint(b[:], v)])
+}
+
+func (e *encoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.buf.WriteString(s)
+}
+
+func (e *encoder) nullableString(s *string) {
+	if s == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*s)
+}
+
+func (e *encoder) bytes(b []byte) {
+	if b == nil {
+		e.int32(-1)
+		return
+	}
+	e.int32(int32(len(b)))
+	e.buf.Write(b)
+}
+
+// varintBytes writes a byte slice prefixed by its varint length, -1 for nil.
+func (e *encoder) varintBytes(b []byte) {
+	if b == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(b)))
+	e.buf.Write(b)
+}
+
+// decoder reads the Kafka protocol primitive types in big-endian order.
+type decoder struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (d *decoder) read(v interface{}) {
+	if d.err == nil {
+		d.err = binary.Read(d.r, binary.BigEndian, v)
+	}
+}
+
+func (d *decoder) int8() (v int8)   { d.read(&v); return }
+func (d *decoder) int16() (v int16) { d.read(&v); return }
+func (d *decoder) int32() (v int32) { d.read(&v); return }
+func (d *decoder) int64() (v int64) { d.read(&v); return }
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, err := binary.ReadVarint(d.r)
+	d.err = err
+	return v
+}
+
+func (d *decoder) string() string {
+	n := d.int16()
+	if d.err != nil || n < 0 {
+		return ""
+	}
+	b := make([]byte, n)
+	d.read(b)
+	return string(b)
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.int32()
+	if d.err != nil || n < 0 {
+		return nil
+	}
+	return d.raw(int(n))
+}
+
+func (d *decoder) varintBytes() []byte {
+	n := d.varint()
+	if d.err != nil || n < 0 {
+		return nil
+	}
+	return d.raw(int(n))
+}
+
+// raw reads n bytes, failing if fewer are left.
+func (d *decoder) raw(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > d.r.Len() {
+		d.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	b := make([]byte, n)
+	d.read(b)
+	return b
+}
+
+// encodeRecordBatch encodes messages in a single v2 RecordBatch, without
+// compression, created at timestampMs.
+func encodeRecordBatch(msgs []Message, timestampMs int64) []byte {
+	var records encoder
+	for i, msg := range msgs {
+		var r encoder
+		r.int8(0)          // attributes, unused
+		r.varint(0)        // timestamp delta
+		r.varint(int64(i)) // offset delta
+		r.varintBytes(msg.Key)
+		r.varintBytes(msg.Value)
+		r.varint(0) // no headers
+
+		records.varint(int64(r.buf.Len()))
+		records.buf.Write(r.buf.Bytes())
+	}
+
+	// the fields covered by the CRC
+	var crced encoder
+	crced.int16(0)                    // attributes: no compression, CreateTime, not transactional
+	crced.int32(int32(len(msgs) - 1)) // last offset delta
+	crced.int64(timestampMs)          // first timestamp
+	crced.int64(timestampMs)          // max timestamp
+	crced.int64(-1)                   // producer id, not idempotent
+	crced.int16(-1)                   // producer epoch
+	crced.int32(-1)                   // base sequence
+	crced.int32(int32(len(msgs)))
+	crced.buf.Write(records.buf.Bytes())
+
+	var batch encoder
+	batch.int64(0) // base offset, assigned by the broker
+	batch.int32(int32(4 + 1 + 4 + crced.buf.Len()))
+	batch.int32(-1) // partition leader epoch, assigned by the broker
+	batch.int8(recordBatchMagic)
+	batch.int32(int32(crc32.Checksum(crced.buf.Bytes(), castagnoli)))
+	batch.buf.Write(crced.buf.Bytes())
+
+	return batch.buf.Bytes()
+}
+
+// decodeRecordBatch decodes a single uncompressed v2 RecordBatch, checking its
+// CRC, and returns its messages and first timestamp.
+func decodeRecordBatch(batch []byte) ([]Message, int64, error) {
+	d := &decoder{r: bytes.NewReader(batch)}
+	d.int64() // base offset
+	length := d.int32()
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	if int(length) != d.r.Len() || length < recordBatchOverhead {
+		return nil, 0, fmt.Errorf("invalid record batch length %d", length)
+	}
+
+	d.int32() // partition leader epoch
+	if magic := d.int8(); d.err == nil && magic != recordBatchMagic {
+		return nil, 0, fmt.Errorf("unsupported record batch magic byte %d", magic)
+	}
+	crc := uint32(d.int32())
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	if crc32.Checksum(batch[len(batch)-d.r.Len():], castagnoli) != crc {
+		return nil, 0, fmt.Errorf("invalid record batch CRC")
+	}
+
+	if attributes := d.int16(); d.err == nil && attributes&0x7 != 0 {
+		return nil, 0, fmt.Errorf("unsupported record batch compression %d", attributes&0x7)
+	}
+	d.int32() // last offset delta
+	timestampMs := d.int64()
+	d.int64() // max timestamp
+	d.int64() // producer id
+	d.int16() // producer epoch
+	d.int32() // base sequence
+	n := d.int32()
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	if n < 0 {
+		return nil, 0, fmt.Errorf("invalid record count %d", n)
+	}
+
+	var msgs []Message
+	for i := int32(0); i < n; i++ {
+		rd := &decoder{r: bytes.NewReader(d.raw(int(d.varint())))}
+		if d.err != nil {
+			return nil, 0, d.err
+		}
+
+		rd.int8()   // attributes
+		rd.varint() // timestamp delta
+		rd.varint() // offset delta
+		msg := Message{Key: rd.varintBytes(), Value: rd.varintBytes()}
+		if headers := rd.varint(); rd.err == nil && headers != 0 {
+			return nil, 0, fmt.Errorf("unsupported record headers")
+		}
+		if rd.err != nil {
+			return nil, 0, rd.err
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, timestampMs, nil
+}
+
+// readFrame reads a size-prefixed request or response from r and returns a
+// decoder over its content.
+func readFrame(r io.Reader) (*decoder, error) {
+	var size int32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		return nil, fmt.Errorf("invalid frame size %d", size)
+	}
+
+	frame := make([]byte, size)
+	if _, err := io.ReadFull(r, frame); err != nil {
+		return nil, err
+	}
+
+	return &decoder{r: bytes.NewReader(frame)}, nil
+}
+
+// WriteTo writes the size-prefixed request to w.
+func (req ProduceRequest) WriteTo(w io.Writer) (int64, error) {
+	var e encoder
+	e.int16(apiKeyProduce)
+	e.int16(apiVersionProduce)
+	e.int32(req.CorrelationID)
+	e.nullableString(&req.ClientID)
+	e.nullableString(nil) // transactional id
+	e.int16(req.RequiredAcks)
+	e.int32(req.TimeoutMs)
+	e.int32(1) // one topic
+	e.string(req.Topic)
+	e.int32(1) // one partition
+	e.int32(req.Partition)
+	e.bytes(encodeRecordBatch(req.Messages, req.TimestampMs))
+
+	var sized encoder
+	sized.int32(int32(e.buf.Len()))
+	sized.buf.Write(e.buf.Bytes())
+
+	return sized.buf.WriteTo(w)
+}
+
+// ReadProduceRequest reads a size-prefixed Produce request from r.
+func ReadProduceRequest(r io.Reader) (ProduceRequest, error) {
+	var req ProduceRequest
+	d, err := readFrame(r)
+	if err != nil {
+		return req, err
+	}
+
+	if apiKey, version := d.int16(), d.int16(); d.err == nil && (apiKey != apiKeyProduce || version != apiVersionProduce) {
+		return req, fmt.Errorf("unsupported request: api key %d, version %d", apiKey, version)
+	}
+	req.CorrelationID = d.int32()
+	req.ClientID = d.string()
+	d.string() // transactional id
+	req.RequiredAcks = d.int16()
+	req.TimeoutMs = d.int32()
+	if n := d.int32(); d.err == nil && n != 1 {
+		return req, fmt.Errorf("expected 1 topic, got %d", n)
+	}
+	req.Topic = d.string()
+	if n := d.int32(); d.err == nil && n != 1 {
+		return req, fmt.Errorf("expected 1 partition, got %d", n)
+	}
+	req.Partition = d.int32()
+	batch := d.bytes()
+	if d.err != nil {
+		return req, d.err
+	}
+
+	req.Messages, req.TimestampMs, err = decodeRecordBatch(batch)
+	return req, err
+}
+
+// WriteTo writes the size-prefixed response to w.
+func (res ProduceResponse) WriteTo(w io.Writer) (int64, error) {
+	var e encoder
+	e.int32(res.CorrelationID)
+	e.int32(1) // one topic
+	e.string(res.Topic)
+	e.int32(1) // one partition
+	e.int32(res.Partition)
+	e.int16(res.ErrorCode)
+	e.int64(res.BaseOffset)
+	e.int64(-1) // log append time, unused with CreateTime
+	e.int32(0)  // throttle time
+
+	var sized encoder
+	sized.int32(int32(e.buf.Len()))
+	sized.buf.Write(e.buf.Bytes())
+
+	return sized.buf.WriteTo(w)
+}
+
+// ReadProduceResponse reads a size-prefixed Produce response from r.
+func ReadProduceResponse(r io.Reader) (ProduceResponse, error) {
+	var res ProduceResponse
+	d, err := readFrame(r)
+	if err != nil {
+		return res, err
+	}
+
+	res.CorrelationID = d.int32()
+	if n := d.int32(); d.err == nil && n != 1 {
+		return res, fmt.Errorf("expected 1 topic, got %d", n)
+	}
+	res.Topic = d.string()
+	if n := d.int32(); d.err == nil && n != 1 {
+		return res, fmt.Errorf("expected 1 partition, got %d", n)
+	}
+	res.Partition = d.int32()
+	res.ErrorCode = d.int16()
+	res.BaseOffset = d.int64()
+	d.int64() // log append time
+	d.int32() // throttle time
+
+	return res, d.err
+}
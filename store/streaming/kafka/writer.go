@@ -0,0 +1,206 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
+)
+
+const (
+	// DefaultClientID is the default client id sent to the broker.
+	DefaultClientID = "cosmos-sdk"
+	// DefaultRequiredAcks is the default number of acknowledgements the broker
+	// must receive before responding, i.e. the leader's one. It is used when
+	// the required_acks option is not set.
+	DefaultRequiredAcks int16 = 1
+	// DefaultTimeout is the default timeout of a produce request.
+	DefaultTimeout = 10 * time.Second
+)
+
+var _ streamingtypes.Sink = &Writer{}
+
+// Config is the configuration of a Writer.
+type Config struct {
+	// Address is the address of the broker, i.e. the leader of the partition.
+	Address string
+	// Topic and Partition are the topic partition the messages are produced to.
+	Topic     string
+	Partition int32
+	// ClientID is the client id sent to the broker.
+	ClientID string
+	// RequiredAcks is the number of acknowledgements the broker must receive
+	// before responding: 1 for the leader, -1 for all the in-sync replicas, or
+	// 0 for no response at all, in which case a message lost by the broker is
+	// not reported.
+	RequiredAcks int16
+	// Timeout is the timeout of a produce request, including the network
+	// round trip.
+	Timeout time.Duration
+}
+
+// Writer is a Sink producing each StreamMessage as a message to a Kafka topic
+// partition, using the Kafka wire protocol. The message key identifies the
+// ABCI message, e.g. "block-10-begin", "block-10-tx-0" or "block-10-end", and
+// the message value is the protobuf encoded StreamMessage.
+type Writer struct {
+	cfg Config
+
+	mtx           sync.Mutex
+	conn          net.Conn
+	correlationID int32
+}
+
+// NewWriter creates a new Writer from cfg, filling in the default client id
+// and timeout.
+func NewWriter(cfg Config) (*Writer, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("kafka broker address cannot be empty")
+	}
+	if cfg.Topic == "" {
+		return nil, errors.New("kafka topic cannot be empty")
+	}
+	if cfg.ClientID == "" {
+		cfg.ClientID = DefaultClientID
+	}
+	if cfg.RequiredAcks < -1 || cfg.RequiredAcks > 1 {
+		return nil, fmt.Errorf("kafka required acks must be -1, 0 or 1, got %d", cfg.RequiredAcks)
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	return &Writer{cfg: cfg}, nil
+}
+
+// Start implements the Sink interface. It connects to the broker.
+func (w *Writer) Start(_ *sync.WaitGroup) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.connect()
+}
+
+// Write implements the Sink interface. It produces msg to the topic partition
+// and waits for the broker's acknowledgement, if any is required. A failed
+// connection is re-established on the next write.
+func (w *Writer) Write(msg *streamingtypes.StreamMessage) error {
+	value, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return w.Produce(Message{Key: []byte(MessageKey(msg)), Value: value})
+}
+
+// Produce produces messages to the topic partition.
+func (w *Writer) Produce(msgs ...Message) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.conn == nil {
+		if err := w.connect(); err != nil {
+			return err
+		}
+	}
+
+	if err := w.produce(msgs); err != nil {
+		// The connection may be in an inconsistent state, reconnect on the
+		// next write.
+		w.conn.Close()
+		w.conn = nil
+		return err
+	}
+
+	return nil
+}
+
+func (w *Writer) produce(msgs []Message) error {
+	w.correlationID++
+	req := ProduceRequest{
+		CorrelationID: w.correlationID,
+		ClientID:      w.cfg.ClientID,
+		RequiredAcks:  w.cfg.RequiredAcks,
+		TimeoutMs:     int32(w.cfg.Timeout / time.Millisecond),
+		Topic:         w.cfg.Topic,
+		Partition:     w.cfg.Partition,
+		TimestampMs:   time.Now().UnixNano() / int64(time.Millisecond),
+		Messages:      msgs,
+	}
+
+	if err := w.conn.SetDeadline(time.Now().Add(w.cfg.Timeout)); err != nil {
+		return err
+	}
+
+	if _, err := req.WriteTo(w.conn); err != nil {
+		return err
+	}
+
+	// the broker does not respond to a request without required acks
+	if req.RequiredAcks == 0 {
+		return nil
+	}
+
+	res, err := ReadProduceResponse(w.conn)
+	if err != nil {
+		return err
+	}
+
+	if res.CorrelationID != req.CorrelationID {
+		return fmt.Errorf("kafka response correlation id %d does not match request %d", res.CorrelationID, req.CorrelationID)
+	}
+
+	if res.ErrorCode != 0 {
+		return fmt.Errorf("kafka broker returned error code %d for %s/%d", res.ErrorCode, res.Topic, res.Partition)
+	}
+
+	return nil
+}
+
+// Close implements the Sink interface. It closes the connection to the broker.
+func (w *Writer) Close() error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.conn == nil {
+		return nil
+	}
+
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
+
+func (w *Writer) connect() error {
+	if w.conn != nil {
+		return nil
+	}
+
+	conn, err := net.DialTimeout("tcp", w.cfg.Address, w.cfg.Timeout)
+	if err != nil {
+		return err
+	}
+	w.conn = conn
+
+	return nil
+}
+
+// MessageKey returns the key of the Kafka message for msg, which identifies
+// the ABCI message it contains.
+func MessageKey(msg *streamingtypes.StreamMessage) string {
+	switch abci := msg.Abci.(type) {
+	case *streamingtypes.StreamMessage_BeginBlock:
+		return fmt.Sprintf("block-%d-begin", msg.BlockHeight)
+	case *streamingtypes.StreamMessage_DeliverTx:
+		return fmt.Sprintf("block-%d-tx-%d", msg.BlockHeight, abci.DeliverTx.TxIndex)
+	case *streamingtypes.StreamMessage_EndBlock:
+		return fmt.Sprintf("block-%d-end", msg.BlockHeight)
+	case *streamingtypes.StreamMessage_Commit:
+		return fmt.Sprintf("block-%d-commit", msg.BlockHeight)
+	default:
+		return fmt.Sprintf("block-%d", msg.BlockHeight)
+	}
+}
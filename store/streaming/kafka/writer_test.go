@@ -0,0 +1,224 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// brokerStub is an in-process Kafka broker, which understands the Produce
+// requests sent by the Writer and stores the produced messages.
+type brokerStub struct {
+	t        *testing.T
+	listener net.Listener
+
+	mtx       sync.Mutex
+	messages  []Message
+	errorCode int16
+}
+
+func newBrokerStub(t *testing.T) *brokerStub {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	b := &brokerStub{t: t, listener: listener}
+	go b.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return b
+}
+
+func (b *brokerStub) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close()
+			for {
+				req, err := ReadProduceRequest(conn)
+				if err != nil {
+					return
+				}
+
+				b.mtx.Lock()
+				if req.RequiredAcks == 0 {
+					b.messages = append(b.messages, req.Messages...)
+					b.mtx.Unlock()
+					continue
+				}
+				res := ProduceResponse{
+					CorrelationID: req.CorrelationID,
+					Topic:         req.Topic,
+					Partition:     req.Partition,
+					ErrorCode:     b.errorCode,
+					BaseOffset:    int64(len(b.messages)),
+				}
+				if b.errorCode == 0 {
+					b.messages = append(b.messages, req.Messages...)
+				}
+				b.mtx.Unlock()
+
+				if _, err := res.WriteTo(conn); err != nil {
+					return
+				}
+			}
+		}()
+	}
+}
+
+func (b *brokerStub) setErrorCode(code int16) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.errorCode = code
+}
+
+func (b *brokerStub) produced() []Message {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return append([]Message(nil), b.messages...)
+}
+
+func TestProduceRequestRoundTrip(t *testing.T) {
+	req := ProduceRequest{
+		CorrelationID: 7,
+		ClientID:      "client",
+		RequiredAcks:  -1,
+		TimeoutMs:     1000,
+		Topic:         "topic",
+		Partition:     3,
+		TimestampMs:   1700000000000,
+		Messages:      []Message{{Key: []byte("key"), Value: []byte("value")}, {Value: []byte("no key")}},
+	}
+
+	var buf bytes.Buffer
+	_, err := req.WriteTo(&buf)
+	require.NoError(t, err)
+	decoded, err := ReadProduceRequest(&buf)
+	require.NoError(t, err)
+	require.Equal(t, req, decoded)
+	require.Zero(t, buf.Len())
+
+	// a corrupted message is rejected
+	buf.Reset()
+	_, err = req.WriteTo(&buf)
+	require.NoError(t, err)
+	bz := buf.Bytes()
+	bz[len(bz)-1] ^= 0xff
+	_, err = ReadProduceRequest(bytes.NewReader(bz))
+	require.Error(t, err)
+}
+
+func TestWriter(t *testing.T) {
+	broker := newBrokerStub(t)
+
+	_, err := NewWriter(Config{Topic: "state"})
+	require.Error(t, err)
+	_, err = NewWriter(Config{Address: broker.listener.Addr().String()})
+	require.Error(t, err)
+
+	w, err := NewWriter(Config{Address: broker.listener.Addr().String(), Topic: "state", RequiredAcks: DefaultRequiredAcks, Timeout: time.Second})
+	require.NoError(t, err)
+	require.NoError(t, w.Start(new(sync.WaitGroup)))
+	defer w.Close()
+
+	msgs := []*streamingtypes.StreamMessage{
+		{
+			BlockHeight:  1,
+			Abci:         &streamingtypes.StreamMessage_BeginBlock{BeginBlock: &streamingtypes.BeginBlock{}},
+			StateChanges: []*types.StoreKVPair{{StoreKey: "bank", Key: []byte("key"), Value: []byte("value")}},
+		},
+		{
+			BlockHeight: 1,
+			Abci: &streamingtypes.StreamMessage_DeliverTx{DeliverTx: &streamingtypes.DeliverTx{
+				TxIndex:  0,
+				Request:  abci.RequestDeliverTx{Tx: []byte("tx")},
+				Response: abci.ResponseDeliverTx{Code: 1},
+			}},
+		},
+		{
+			BlockHeight: 1,
+			Abci:        &streamingtypes.StreamMessage_EndBlock{EndBlock: &streamingtypes.EndBlock{}},
+		},
+	}
+	for _, msg := range msgs {
+		require.NoError(t, w.Write(msg))
+	}
+
+	produced := broker.produced()
+	require.Len(t, produced, 3)
+	for i, key := range []string{"block-1-begin", "block-1-tx-0", "block-1-end"} {
+		require.Equal(t, key, string(produced[i].Key))
+
+		var msg streamingtypes.StreamMessage
+		require.NoError(t, msg.Unmarshal(produced[i].Value))
+		require.Equal(t, msgs[i].String(), msg.String())
+	}
+
+	// A broker error is returned to the caller.
+	broker.setErrorCode(6) // NOT_LEADER_FOR_PARTITION
+	require.Error(t, w.Write(msgs[0]))
+	require.Len(t, broker.produced(), 3)
+
+	// The writer reconnects on the next write.
+	broker.setErrorCode(0)
+	require.NoError(t, w.Write(msgs[0]))
+	require.Len(t, broker.produced(), 4)
+}
+
+func TestWriterRequiredAcks(t *testing.T) {
+	broker := newBrokerStub(t)
+	cfg := Config{Address: broker.listener.Addr().String(), Topic: "state", Timeout: time.Second}
+
+	for _, acks := range []int16{-2, 2} {
+		cfg.RequiredAcks = acks
+		_, err := NewWriter(cfg)
+		require.Error(t, err)
+	}
+
+	// Without required acks the broker does not respond, and the writer does
+	// not wait for a response.
+	cfg.RequiredAcks = 0
+	w, err := NewWriter(cfg)
+	require.NoError(t, err)
+	require.Equal(t, int16(0), w.cfg.RequiredAcks)
+	defer w.Close()
+
+	require.NoError(t, w.Produce(Message{Key: []byte("a")}))
+	require.NoError(t, w.Produce(Message{Key: []byte("b")}))
+	require.Eventually(t, func() bool { return len(broker.produced()) == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestRecordBatch(t *testing.T) {
+	msgs := []Message{{Key: []byte("key"), Value: []byte("value")}, {Value: []byte{}}, {Key: []byte("no value")}}
+	batch := encodeRecordBatch(msgs, 42)
+
+	// the batch header, as specified by the Kafka protocol
+	require.Equal(t, int64(0), int64(binary.BigEndian.Uint64(batch[0:8])))
+	require.Equal(t, len(batch)-12, int(binary.BigEndian.Uint32(batch[8:12])))
+	require.Equal(t, byte(recordBatchMagic), batch[16])
+	require.Equal(t, crc32.Checksum(batch[21:], crc32.MakeTable(crc32.Castagnoli)), binary.BigEndian.Uint32(batch[17:21]))
+
+	decoded, timestampMs, err := decodeRecordBatch(batch)
+	require.NoError(t, err)
+	require.Equal(t, msgs, decoded)
+	require.Equal(t, int64(42), timestampMs)
+
+	// truncated batches are rejected
+	for i := range batch {
+		_, _, err := decodeRecordBatch(batch[:i])
+		require.Error(t, err)
+	}
+}
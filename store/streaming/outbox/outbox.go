@@ -0,0 +1,456 @@
+package outbox
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
+)
+
+const (
+	// DefaultCommitTimeout is the default time Commit waits for the sink to
+	// acknowledge the pending blocks.
+	DefaultCommitTimeout = time.Minute
+	// DefaultRetryInterval is the default time between two attempts to write a
+	// message to the sink.
+	DefaultRetryInterval = time.Second
+
+	blockFilePrefix = "block-"
+	tmpFileSuffix   = ".tmp"
+	ackedFileName   = "acked"
+)
+
+var _ streamingtypes.CommitSink = &Outbox{}
+
+// Config is the configuration of an Outbox.
+type Config struct {
+	// Dir is the directory where the undelivered blocks and the last
+	// acknowledged height are persisted.
+	Dir string
+	// MaxPendingBlocks is the number of committed blocks which may not be
+	// acknowledged by the sink yet. With the zero value, block N is only
+	// committed once the sink has acknowledged it.
+	MaxPendingBlocks int64
+	// CommitTimeout is the time Commit waits for the sink to catch up before
+	// returning an error.
+	CommitTimeout time.Duration
+	// RetryInterval is the time between two attempts to write a message to the
+	// sink.
+	RetryInterval time.Duration
+}
+
+// Outbox is a CommitSink persisting the StreamMessages of each block to disk
+// before they are delivered to an underlying Sink. A block is acknowledged once
+// all its messages, up to its closing Commit message, were written to the sink
+// successfully; failed writes are retried until they succeed. The last
+// acknowledged height is synced to disk too, so that a restarted node resumes
+// the delivery where it stopped.
+//
+// Each message is sequenced by its (BlockHeight, Index), which is the same
+// when a block is written again to the sink, i.e. when the node stops after
+// delivering the block but before persisting its acknowledgement. A consumer
+// which drops the messages at or before the last (BlockHeight, Index) it
+// processed, and records it along with their effects, sees each message
+// exactly once.
+type Outbox struct {
+	cfg  Config
+	sink streamingtypes.Sink
+
+	mtx     sync.Mutex
+	acked   int64         // the last height acknowledged by the sink
+	pending []int64       // the committed heights waiting to be delivered, in order
+	ackCh   chan struct{} // closed, and replaced, each time a height is acknowledged
+
+	file       *os.File // the file the messages of the current block are written to
+	fileHeight int64    // the height of the current block
+
+	notify chan struct{}
+	quit   chan struct{}
+	done   chan struct{}
+}
+
+// NewOutbox creates a new Outbox delivering the messages to sink, filling in
+// the default values of cfg.
+func NewOutbox(cfg Config, sink streamingtypes.Sink) (*Outbox, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("outbox directory cannot be empty")
+	}
+	if sink == nil {
+		return nil, errors.New("sink cannot be nil")
+	}
+	if cfg.MaxPendingBlocks < 0 {
+		return nil, fmt.Errorf("outbox max pending blocks cannot be negative, got %d", cfg.MaxPendingBlocks)
+	}
+	if cfg.CommitTimeout == 0 {
+		cfg.CommitTimeout = DefaultCommitTimeout
+	}
+	if cfg.RetryInterval == 0 {
+		cfg.RetryInterval = DefaultRetryInterval
+	}
+
+	return &Outbox{
+		cfg:    cfg,
+		sink:   sink,
+		ackCh:  make(chan struct{}),
+		notify: make(chan struct{}, 1),
+	}, nil
+}
+
+// AckedHeight returns the last height acknowledged by the sink.
+func (o *Outbox) AckedHeight() int64 {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	return o.acked
+}
+
+// Start implements the Sink interface. It loads the state of the outbox from
+// disk, starts the underlying sink and starts delivering the pending blocks.
+func (o *Outbox) Start(wg *sync.WaitGroup) error {
+	if o.quit != nil {
+		return errors.New("outbox has already been started")
+	}
+
+	if err := o.load(); err != nil {
+		return err
+	}
+
+	if err := o.sink.Start(wg); err != nil {
+		return err
+	}
+
+	o.quit = make(chan struct{})
+	o.done = make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(o.done)
+		o.deliver()
+	}()
+
+	return nil
+}
+
+// load reads the last acknowledged height and the pending blocks from disk,
+// and removes the files left by the blocks which were not committed.
+func (o *Outbox) load() error {
+	if err := os.MkdirAll(o.cfg.Dir, 0o700); err != nil {
+		return err
+	}
+
+	bz, err := os.ReadFile(filepath.Join(o.cfg.Dir, ackedFileName))
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	default:
+		acked, err := strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid acknowledged height in outbox: %w", err)
+		}
+		o.acked = acked
+	}
+
+	entries, err := os.ReadDir(o.cfg.Dir)
+	if err != nil {
+		return err
+	}
+
+	var pending []int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, blockFilePrefix) {
+			continue
+		}
+
+		path := filepath.Join(o.cfg.Dir, name)
+		height, err := strconv.ParseInt(strings.TrimPrefix(name, blockFilePrefix), 10, 64)
+		if err != nil || height <= o.acked {
+			// temporary files of uncommitted blocks and delivered blocks
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		pending = append(pending, height)
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i] < pending[j] })
+
+	o.mtx.Lock()
+	o.pending = pending
+	o.mtx.Unlock()
+
+	return nil
+}
+
+// Write implements the Sink interface. It appends msg to the file of the
+// current block; the messages are only delivered once the block is committed.
+// Messages of already acknowledged heights, replayed after a restart, are
+// ignored.
+func (o *Outbox) Write(msg *streamingtypes.StreamMessage) error {
+	if msg.BlockHeight <= o.AckedHeight() {
+		return nil
+	}
+
+	if o.file == nil || o.fileHeight != msg.BlockHeight {
+		if err := o.openBlockFile(msg.BlockHeight); err != nil {
+			return err
+		}
+	}
+
+	bz, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(bz))
+	n := binary.PutUvarint(buf, uint64(len(bz)))
+	_, err = o.file.Write(append(buf[:n], bz...))
+	return err
+}
+
+func (o *Outbox) openBlockFile(height int64) error {
+	if o.file != nil {
+		// the previous block was never committed, discard it
+		o.file.Close()
+		os.Remove(o.file.Name())
+	}
+
+	file, err := os.OpenFile(o.tmpFilePath(height), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		o.file = nil
+		return err
+	}
+
+	o.file = file
+	o.fileHeight = height
+	return nil
+}
+
+// Commit implements the CommitSink interface. It persists the block at height
+// and waits until at most MaxPendingBlocks blocks remain to be acknowledged by
+// the sink, or CommitTimeout expires.
+func (o *Outbox) Commit(height int64) error {
+	if err := o.persist(height); err != nil {
+		return err
+	}
+
+	timeout := time.NewTimer(o.cfg.CommitTimeout)
+	defer timeout.Stop()
+
+	for {
+		o.mtx.Lock()
+		acked, ackCh := o.acked, o.ackCh
+		o.mtx.Unlock()
+
+		if acked >= height-o.cfg.MaxPendingBlocks {
+			return nil
+		}
+
+		select {
+		case <-ackCh:
+		case <-o.quit:
+			return errors.New("outbox closed")
+		case <-timeout.C:
+			return fmt.Errorf("timed out waiting for the sink to acknowledge height %d, last acknowledged height is %d", height-o.cfg.MaxPendingBlocks, acked)
+		}
+	}
+}
+
+// persist syncs the file of the block at height to disk and queues the block
+// for delivery.
+func (o *Outbox) persist(height int64) error {
+	if o.file == nil || o.fileHeight != height {
+		// no message was written for this height, it was already acknowledged
+		return nil
+	}
+
+	file := o.file
+	o.file = nil
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	o.mtx.Lock()
+	queued := height <= o.acked
+	for _, h := range o.pending {
+		queued = queued || h == height
+	}
+	o.mtx.Unlock()
+
+	if queued {
+		// the block was persisted before the node restarted, and is replayed
+		return os.Remove(file.Name())
+	}
+
+	if err := os.Rename(file.Name(), o.blockFilePath(height)); err != nil {
+		return err
+	}
+	if err := syncDir(o.cfg.Dir); err != nil {
+		return err
+	}
+
+	o.mtx.Lock()
+	o.pending = append(o.pending, height)
+	o.mtx.Unlock()
+
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// deliver writes the pending blocks to the sink, in order, until the outbox is
+// closed.
+func (o *Outbox) deliver() {
+	for {
+		o.mtx.Lock()
+		var height int64
+		if len(o.pending) > 0 {
+			height = o.pending[0]
+		}
+		o.mtx.Unlock()
+
+		if height == 0 {
+			select {
+			case <-o.quit:
+				return
+			case <-o.notify:
+				continue
+			}
+		}
+
+		msgs, err := o.readBlock(height)
+		if err != nil {
+			// the block file is corrupted, which is not recoverable
+			panic(fmt.Errorf("failed to read block %d from the outbox: %w", height, err))
+		}
+
+		for _, msg := range msgs {
+			for err := o.sink.Write(msg); err != nil; err = o.sink.Write(msg) {
+				select {
+				case <-o.quit:
+					return
+				case <-time.After(o.cfg.RetryInterval):
+				}
+			}
+		}
+
+		if err := o.ack(height); err != nil {
+			panic(fmt.Errorf("failed to acknowledge block %d in the outbox: %w", height, err))
+		}
+	}
+}
+
+func (o *Outbox) readBlock(height int64) ([]*streamingtypes.StreamMessage, error) {
+	bz, err := os.ReadFile(o.blockFilePath(height))
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*streamingtypes.StreamMessage
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, errors.New("invalid message length prefix")
+		}
+		bz = bz[n:]
+
+		msg := new(streamingtypes.StreamMessage)
+		if err := msg.Unmarshal(bz[:size]); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+		bz = bz[size:]
+	}
+
+	return msgs, nil
+}
+
+// ack syncs height to disk as the last acknowledged height, removes its block
+// file and wakes up the pending commits.
+func (o *Outbox) ack(height int64) error {
+	tmpPath := filepath.Join(o.cfg.Dir, ackedFileName+tmpFileSuffix)
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(strconv.FormatInt(height, 10)); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, filepath.Join(o.cfg.Dir, ackedFileName)); err != nil {
+		return err
+	}
+	if err := syncDir(o.cfg.Dir); err != nil {
+		return err
+	}
+
+	o.mtx.Lock()
+	o.acked = height
+	o.pending = o.pending[1:]
+	close(o.ackCh)
+	o.ackCh = make(chan struct{})
+	o.mtx.Unlock()
+
+	return os.Remove(o.blockFilePath(height))
+}
+
+// Close implements the Sink interface. It stops the delivery of the pending
+// blocks, which are resumed on the next start, and closes the sink.
+func (o *Outbox) Close() error {
+	if o.quit != nil {
+		close(o.quit)
+		<-o.done
+		o.quit = nil
+	}
+
+	if o.file != nil {
+		o.file.Close()
+		o.file = nil
+	}
+
+	return o.sink.Close()
+}
+
+// syncDir syncs dir to disk, so that the files renamed in it are durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
+
+func (o *Outbox) blockFilePath(height int64) string {
+	return filepath.Join(o.cfg.Dir, fmt.Sprintf("%s%d", blockFilePrefix, height))
+}
+
+func (o *Outbox) tmpFilePath(height int64) string {
+	return o.blockFilePath(height) + tmpFileSuffix
+}
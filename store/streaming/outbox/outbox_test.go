@@ -0,0 +1,189 @@
+package outbox
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
+)
+
+// mockSink records the messages written to it, and fails the writes while
+// failing is set.
+type mockSink struct {
+	mtx     sync.Mutex
+	msgs    []*streamingtypes.StreamMessage
+	failing bool
+}
+
+func (s *mockSink) Start(_ *sync.WaitGroup) error { return nil }
+func (s *mockSink) Close() error                  { return nil }
+
+func (s *mockSink) Write(msg *streamingtypes.StreamMessage) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.failing {
+		return errors.New("sink unavailable")
+	}
+	s.msgs = append(s.msgs, msg)
+	return nil
+}
+
+func (s *mockSink) setFailing(failing bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.failing = failing
+}
+
+func (s *mockSink) heights() []int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	heights := make([]int64, len(s.msgs))
+	for i, msg := range s.msgs {
+		heights[i] = msg.BlockHeight
+	}
+	return heights
+}
+
+func writeBlock(t *testing.T, o *Outbox, height int64) {
+	require.NoError(t, o.Write(&streamingtypes.StreamMessage{
+		BlockHeight: height,
+		Abci: &streamingtypes.StreamMessage_BeginBlock{
+			BeginBlock: &streamingtypes.BeginBlock{},
+		},
+	}))
+	require.NoError(t, o.Write(&streamingtypes.StreamMessage{
+		BlockHeight: height,
+		Abci: &streamingtypes.StreamMessage_EndBlock{
+			EndBlock: &streamingtypes.EndBlock{Request: abci.RequestEndBlock{Height: height}},
+		},
+	}))
+}
+
+func newOutbox(t *testing.T, cfg Config, sink streamingtypes.Sink) *Outbox {
+	o, err := NewOutbox(cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, o.Start(new(sync.WaitGroup)))
+	return o
+}
+
+func TestNewOutbox(t *testing.T) {
+	_, err := NewOutbox(Config{}, &mockSink{})
+	require.Error(t, err)
+
+	_, err = NewOutbox(Config{Dir: t.TempDir()}, nil)
+	require.Error(t, err)
+
+	_, err = NewOutbox(Config{Dir: t.TempDir(), MaxPendingBlocks: -1}, &mockSink{})
+	require.Error(t, err)
+
+	o, err := NewOutbox(Config{Dir: t.TempDir()}, &mockSink{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultCommitTimeout, o.cfg.CommitTimeout)
+	require.Equal(t, DefaultRetryInterval, o.cfg.RetryInterval)
+}
+
+func TestOutboxCommitWaitsForAck(t *testing.T) {
+	sink := &mockSink{}
+	o := newOutbox(t, Config{Dir: t.TempDir(), RetryInterval: 10 * time.Millisecond}, sink)
+	defer o.Close()
+
+	for height := int64(1); height <= 3; height++ {
+		writeBlock(t, o, height)
+		require.NoError(t, o.Commit(height))
+		require.Equal(t, height, o.AckedHeight())
+	}
+	require.Equal(t, []int64{1, 1, 2, 2, 3, 3}, sink.heights())
+}
+
+func TestOutboxCommitTimeout(t *testing.T) {
+	sink := &mockSink{failing: true}
+	o := newOutbox(t, Config{
+		Dir:           t.TempDir(),
+		CommitTimeout: 50 * time.Millisecond,
+		RetryInterval: 10 * time.Millisecond,
+	}, sink)
+	defer o.Close()
+
+	writeBlock(t, o, 1)
+	require.Error(t, o.Commit(1))
+	require.Equal(t, int64(0), o.AckedHeight())
+
+	// the block is delivered once the sink is back
+	sink.setFailing(false)
+	require.Eventually(t, func() bool { return o.AckedHeight() == 1 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{1, 1}, sink.heights())
+}
+
+func TestOutboxMaxPendingBlocks(t *testing.T) {
+	sink := &mockSink{failing: true}
+	o := newOutbox(t, Config{
+		Dir:              t.TempDir(),
+		MaxPendingBlocks: 2,
+		CommitTimeout:    50 * time.Millisecond,
+		RetryInterval:    10 * time.Millisecond,
+	}, sink)
+	defer o.Close()
+
+	// two blocks may be committed without being acknowledged
+	for height := int64(1); height <= 2; height++ {
+		writeBlock(t, o, height)
+		require.NoError(t, o.Commit(height))
+	}
+
+	writeBlock(t, o, 3)
+	require.Error(t, o.Commit(3))
+
+	sink.setFailing(false)
+	writeBlock(t, o, 4)
+	require.NoError(t, o.Commit(4))
+	require.Eventually(t, func() bool { return o.AckedHeight() == 4 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{1, 1, 2, 2, 3, 3, 4, 4}, sink.heights())
+}
+
+func TestOutboxResume(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Config{
+		Dir:              dir,
+		MaxPendingBlocks: 10,
+		RetryInterval:    10 * time.Millisecond,
+	}
+
+	sink := &mockSink{}
+	o := newOutbox(t, cfg, sink)
+	writeBlock(t, o, 1)
+	require.NoError(t, o.Commit(1))
+	require.Eventually(t, func() bool { return o.AckedHeight() == 1 }, time.Second, 10*time.Millisecond)
+
+	// blocks 2 and 3 are committed while the sink is unavailable, and block 4
+	// is interrupted before being committed
+	sink.setFailing(true)
+	writeBlock(t, o, 2)
+	require.NoError(t, o.Commit(2))
+	writeBlock(t, o, 3)
+	require.NoError(t, o.Commit(3))
+	writeBlock(t, o, 4)
+	require.NoError(t, o.Close())
+	require.Equal(t, int64(1), o.AckedHeight())
+
+	// the restarted outbox resumes from the last acknowledged height
+	sink = &mockSink{}
+	o = newOutbox(t, cfg, sink)
+	defer o.Close()
+	require.Eventually(t, func() bool { return o.AckedHeight() == 3 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{2, 2, 3, 3}, sink.heights())
+
+	// replayed messages of acknowledged blocks are ignored, and the
+	// interrupted block is written again
+	writeBlock(t, o, 3)
+	writeBlock(t, o, 4)
+	require.NoError(t, o.Commit(4))
+	require.Eventually(t, func() bool { return o.AckedHeight() == 4 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{2, 2, 3, 3, 4, 4}, sink.heights())
+}
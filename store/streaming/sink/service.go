@@ -0,0 +1,166 @@
+package sink
+
+import (
+	"errors"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	streamingtypes "github.com/cosmos/cosmos-sdk/store/streaming/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that
+// writes a StreamMessage to a Sink for each ABCI message, along with the state
+// changes it caused.
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	sink               streamingtypes.Sink                      // the destination of the generated StreamMessages
+	stateCache         []*types.StoreKVPair                     // cache the StoreKVPairs in the order they are received
+	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	currentBlockNumber int64                                    // the current block number
+	currentTxIndex     int64                                    // the index of the current tx
+	currentIndex       uint64                                   // the index of the next message in the current block
+	started            bool                                     // whether Stream has been called
+}
+
+// NewStreamingService creates a new StreamingService writing the state
+// changes of the provided storeKeys to sink.
+func NewStreamingService(sink streamingtypes.Sink, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if sink == nil {
+		return nil, errors.New("sink cannot be nil")
+	}
+
+	ss := &StreamingService{
+		sink:           sink,
+		stateCacheLock: new(sync.Mutex),
+	}
+
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		listeners[key] = append(listeners[key], ss)
+	}
+	ss.listeners = listeners
+
+	return ss, nil
+}
+
+// OnWrite satisfies the types.WriteListener interface. It caches the state
+// change until the next ABCI message is written to the sink.
+func (ss *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	ss.stateCacheLock.Lock()
+	defer ss.stateCacheLock.Unlock()
+
+	ss.stateCache = append(ss.stateCache, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte(nil), key...),
+		Value:    append([]byte(nil), value...),
+	})
+
+	return nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (ss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return ss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It writes the received BeginBlock request and response and the resulting
+// state changes to the sink
+func (ss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	ss.currentBlockNumber = req.GetHeader().Height
+	ss.currentTxIndex = 0
+	ss.currentIndex = 0
+
+	return ss.write(&streamingtypes.StreamMessage{
+		Abci: &streamingtypes.StreamMessage_BeginBlock{
+			BeginBlock: &streamingtypes.BeginBlock{Request: req, Response: res},
+		},
+	})
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It writes the received DeliverTx request and response and the resulting
+// state changes to the sink
+func (ss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	txIndex := ss.currentTxIndex
+	ss.currentTxIndex++
+
+	return ss.write(&streamingtypes.StreamMessage{
+		Abci: &streamingtypes.StreamMessage_DeliverTx{
+			DeliverTx: &streamingtypes.DeliverTx{TxIndex: txIndex, Request: req, Response: res},
+		},
+	})
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It writes the received EndBlock request and response and the resulting
+// state changes to the sink
+func (ss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return ss.write(&streamingtypes.StreamMessage{
+		Abci: &streamingtypes.StreamMessage_EndBlock{
+			EndBlock: &streamingtypes.EndBlock{Request: req, Response: res},
+		},
+	})
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes a Commit message closing the block to the sink, and asks the sink
+// to confirm the block before it is committed, if the sink implements
+// CommitSink
+func (ss *StreamingService) ListenCommit(ctx sdk.Context) error {
+	if err := ss.write(&streamingtypes.StreamMessage{
+		Abci: &streamingtypes.StreamMessage_Commit{Commit: &streamingtypes.Commit{}},
+	}); err != nil {
+		return err
+	}
+
+	commitSink, ok := ss.sink.(streamingtypes.CommitSink)
+	if !ok {
+		return nil
+	}
+
+	return commitSink.Commit(ctx.BlockHeight())
+}
+
+// write flushes the state cache into msg and writes msg to the sink, with the
+// next index of the block.
+func (ss *StreamingService) write(msg *streamingtypes.StreamMessage) error {
+	ss.stateCacheLock.Lock()
+	msg.StateChanges = ss.stateCache
+	ss.stateCache = nil
+	ss.stateCacheLock.Unlock()
+
+	msg.BlockHeight = ss.currentBlockNumber
+	msg.Index = ss.currentIndex
+	ss.currentIndex++
+
+	return ss.sink.Write(msg)
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It starts the underlying sink
+// returns an error if it is called twice
+func (ss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if ss.started {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	ss.started = true
+
+	return ss.sink.Start(wg)
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (ss *StreamingService) Close() error {
+	ss.started = false
+	return ss.sink.Close()
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// ValidateBasic performs stateless validation of the subscription filters.
+func (req *SubscribeRequest) ValidateBasic() error {
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return errors.New("block heights cannot be negative")
+	}
+
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return errors.New("end height cannot be lower than start height")
+	}
+
+	return nil
+}
+
+// Done returns true if a message at the given height is past the end height
+// of the subscription.
+func (req *SubscribeRequest) Done(height int64) bool {
+	return req.EndHeight != 0 && height > req.EndHeight
+}
+
+// Filter returns the message as seen by the subscription, i.e. with only the
+// state changes of the requested stores, or nil if the message does not match
+// the requested block range or msg types.
+func (req *SubscribeRequest) Filter(msg *StreamMessage) *StreamMessage {
+	if msg.BlockHeight < req.StartHeight || req.Done(msg.BlockHeight) {
+		return nil
+	}
+
+	if deliverTx := msg.GetDeliverTx(); deliverTx != nil && len(req.MsgTypeUrls) > 0 &&
+		!containsAny(TxMsgTypeURLs(deliverTx.Request.Tx), req.MsgTypeUrls) {
+		return nil
+	}
+
+	if len(req.StoreKeys) == 0 {
+		return msg
+	}
+
+	filtered := *msg
+	filtered.StateChanges = make([]*storetypes.StoreKVPair, 0, len(msg.StateChanges))
+	for _, pair := range msg.StateChanges {
+		if containsAny([]string{pair.StoreKey}, req.StoreKeys) {
+			filtered.StateChanges = append(filtered.StateChanges, pair)
+		}
+	}
+
+	return &filtered
+}
+
+// TxMsgTypeURLs returns the type URLs of the msgs of a protobuf encoded tx. The
+// msgs are not unpacked, so that txs containing msgs unknown to the caller can
+// still be filtered. It returns nil if the tx cannot be decoded.
+func TxMsgTypeURLs(txBytes []byte) []string {
+	var raw tx.TxRaw
+	if err := proto.Unmarshal(txBytes, &raw); err != nil {
+		return nil
+	}
+
+	var body tx.TxBody
+	if err := proto.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil
+	}
+
+	typeURLs := make([]string, len(body.Messages))
+	for i, any := range body.Messages {
+		typeURLs[i] = any.TypeUrl
+	}
+
+	return typeURLs
+}
+
+func containsAny(list, values []string) bool {
+	for _, l := range list {
+		for _, v := range values {
+			if l == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"io"
+	"sync"
+)
+
+// Sink is a destination for the StreamMessages generated by a streaming
+// service, e.g. a message broker or the subscribers of a gRPC stream.
+type Sink interface {
+	// Start starts the sink, e.g. opens its connections or begins serving its
+	// clients. Background goroutines must be registered with wg.
+	Start(wg *sync.WaitGroup) error
+	// Write writes a StreamMessage to the sink. It is called synchronously by
+	// the ABCI listener hooks, in the order the messages are generated.
+	Write(msg *StreamMessage) error
+	// Closer closes the sink and stops its background goroutines.
+	io.Closer
+}
+
+// CommitSink is a Sink which must confirm that a block was delivered before
+// the node commits it.
+type CommitSink interface {
+	Sink
+	// Commit is called before the block at height is committed. It blocks
+	// until the sink can accept the commit and returns an error if it can't,
+	// e.g. because the block could not be persisted.
+	Commit(height int64) error
+}
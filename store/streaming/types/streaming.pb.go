@@ -0,0 +1,2058 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/streaming/v1beta1/streaming.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/store/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the StreamingService/Subscribe RPC
+// method. All the filters are optional.
+type SubscribeRequest struct {
+	// store_keys restricts the state changes to the given stores. If empty, the
+	// state changes of all the exposed stores are streamed.
+	StoreKeys []string `protobuf:"bytes,1,rep,name=store_keys,json=storeKeys,proto3" json:"store_keys,omitempty"`
+	// start_height is the first block height to stream. If zero, the stream
+	// starts at the next block.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height to stream, after which the stream is
+	// closed. If zero, the stream does not end.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// msg_type_urls restricts the DeliverTx messages to the txs containing at
+	// least one of the given msg type URLs. BeginBlock and EndBlock messages are
+	// always streamed.
+	MsgTypeUrls []string `protobuf:"bytes,4,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetStoreKeys() []string {
+	if m != nil {
+		return m.StoreKeys
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// StreamMessage contains an ABCI request and response, along with the state
+// changes they caused.
+type StreamMessage struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Types that are valid to be assigned to Abci:
+	//	*StreamMessage_BeginBlock
+	//	*StreamMessage_DeliverTx
+	//	*StreamMessage_EndBlock
+	//	*StreamMessage_Commit
+	Abci         isStreamMessage_Abci `protobuf_oneof:"abci"`
+	StateChanges []*types.StoreKVPair `protobuf:"bytes,5,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+	// index is the position of the message in its block, starting at 0 with the
+	// BeginBlock message. A message delivered again, e.g. after a restart, has
+	// the same (block_height, index), which lets consumers drop the duplicates.
+	Index uint64 `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *StreamMessage) Reset()         { *m = StreamMessage{} }
+func (m *StreamMessage) String() string { return proto.CompactTextString(m) }
+func (*StreamMessage) ProtoMessage()    {}
+func (*StreamMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{1}
+}
+func (m *StreamMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamMessage.Merge(m, src)
+}
+func (m *StreamMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamMessage proto.InternalMessageInfo
+
+type isStreamMessage_Abci interface {
+	isStreamMessage_Abci()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamMessage_BeginBlock struct {
+	BeginBlock *BeginBlock `protobuf:"bytes,2,opt,name=begin_block,json=beginBlock,proto3,oneof" json:"begin_block,omitempty"`
+}
+type StreamMessage_DeliverTx struct {
+	DeliverTx *DeliverTx `protobuf:"bytes,3,opt,name=deliver_tx,json=deliverTx,proto3,oneof" json:"deliver_tx,omitempty"`
+}
+type StreamMessage_EndBlock struct {
+	EndBlock *EndBlock `protobuf:"bytes,4,opt,name=end_block,json=endBlock,proto3,oneof" json:"end_block,omitempty"`
+}
+type StreamMessage_Commit struct {
+	Commit *Commit `protobuf:"bytes,7,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+
+func (*StreamMessage_BeginBlock) isStreamMessage_Abci() {}
+func (*StreamMessage_DeliverTx) isStreamMessage_Abci()  {}
+func (*StreamMessage_EndBlock) isStreamMessage_Abci()   {}
+func (*StreamMessage_Commit) isStreamMessage_Abci()     {}
+
+func (m *StreamMessage) GetAbci() isStreamMessage_Abci {
+	if m != nil {
+		return m.Abci
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *StreamMessage) GetBeginBlock() *BeginBlock {
+	if x, ok := m.GetAbci().(*StreamMessage_BeginBlock); ok {
+		return x.BeginBlock
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetDeliverTx() *DeliverTx {
+	if x, ok := m.GetAbci().(*StreamMessage_DeliverTx); ok {
+		return x.DeliverTx
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetEndBlock() *EndBlock {
+	if x, ok := m.GetAbci().(*StreamMessage_EndBlock); ok {
+		return x.EndBlock
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetCommit() *Commit {
+	if x, ok := m.GetAbci().(*StreamMessage_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetStateChanges() []*types.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func (m *StreamMessage) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamMessage_BeginBlock)(nil),
+		(*StreamMessage_DeliverTx)(nil),
+		(*StreamMessage_EndBlock)(nil),
+		(*StreamMessage_Commit)(nil),
+	}
+}
+
+// BeginBlock contains a BeginBlock ABCI request and response.
+type BeginBlock struct {
+	Request  types1.RequestBeginBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response types1.ResponseBeginBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+}
+
+func (m *BeginBlock) Reset()         { *m = BeginBlock{} }
+func (m *BeginBlock) String() string { return proto.CompactTextString(m) }
+func (*BeginBlock) ProtoMessage()    {}
+func (*BeginBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{2}
+}
+func (m *BeginBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeginBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginBlock.Merge(m, src)
+}
+func (m *BeginBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginBlock proto.InternalMessageInfo
+
+func (m *BeginBlock) GetRequest() types1.RequestBeginBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types1.RequestBeginBlock{}
+}
+
+func (m *BeginBlock) GetResponse() types1.ResponseBeginBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types1.ResponseBeginBlock{}
+}
+
+// DeliverTx contains a DeliverTx ABCI request and response.
+type DeliverTx struct {
+	// tx_index is the index of the tx in the block.
+	TxIndex  int64                    `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Request  types1.RequestDeliverTx  `protobuf:"bytes,2,opt,name=request,proto3" json:"request"`
+	Response types1.ResponseDeliverTx `protobuf:"bytes,3,opt,name=response,proto3" json:"response"`
+}
+
+func (m *DeliverTx) Reset()         { *m = DeliverTx{} }
+func (m *DeliverTx) String() string { return proto.CompactTextString(m) }
+func (*DeliverTx) ProtoMessage()    {}
+func (*DeliverTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{3}
+}
+func (m *DeliverTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliverTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliverTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliverTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverTx.Merge(m, src)
+}
+func (m *DeliverTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliverTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverTx proto.InternalMessageInfo
+
+func (m *DeliverTx) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *DeliverTx) GetRequest() types1.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return types1.RequestDeliverTx{}
+}
+
+func (m *DeliverTx) GetResponse() types1.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return types1.ResponseDeliverTx{}
+}
+
+// EndBlock contains an EndBlock ABCI request and response.
+type EndBlock struct {
+	Request  types1.RequestEndBlock  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response types1.ResponseEndBlock `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+}
+
+func (m *EndBlock) Reset()         { *m = EndBlock{} }
+func (m *EndBlock) String() string { return proto.CompactTextString(m) }
+func (*EndBlock) ProtoMessage()    {}
+func (*EndBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{4}
+}
+func (m *EndBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlock.Merge(m, src)
+}
+func (m *EndBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndBlock proto.InternalMessageInfo
+
+func (m *EndBlock) GetRequest() types1.RequestEndBlock {
+	if m != nil {
+		return m.Request
+	}
+	return types1.RequestEndBlock{}
+}
+
+func (m *EndBlock) GetResponse() types1.ResponseEndBlock {
+	if m != nil {
+		return m.Response
+	}
+	return types1.ResponseEndBlock{}
+}
+
+// Commit marks the end of a block, and is the last message of the block. It is
+// written once all the other messages of the block were delivered, so that a
+// consumer can acknowledge the block as a whole.
+type Commit struct {
+}
+
+func (m *Commit) Reset()         { *m = Commit{} }
+func (m *Commit) String() string { return proto.CompactTextString(m) }
+func (*Commit) ProtoMessage()    {}
+func (*Commit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{5}
+}
+func (m *Commit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Commit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Commit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Commit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commit.Merge(m, src)
+}
+func (m *Commit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Commit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Commit proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "cosmos.base.streaming.v1beta1.SubscribeRequest")
+	proto.RegisterType((*StreamMessage)(nil), "cosmos.base.streaming.v1beta1.StreamMessage")
+	proto.RegisterType((*BeginBlock)(nil), "cosmos.base.streaming.v1beta1.BeginBlock")
+	proto.RegisterType((*DeliverTx)(nil), "cosmos.base.streaming.v1beta1.DeliverTx")
+	proto.RegisterType((*EndBlock)(nil), "cosmos.base.streaming.v1beta1.EndBlock")
+	proto.RegisterType((*Commit)(nil), "cosmos.base.streaming.v1beta1.Commit")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/streaming/v1beta1/streaming.proto", fileDescriptor_d35c2a410efc27fe)
+}
+
+var fileDescriptor_d35c2a410efc27fe = []byte{
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xb5, 0xbf, 0xa4, 0x69, 0x32, 0x6e, 0xa5, 0x4f, 0xa3, 0x2e, 0x42, 0x51, 0x43, 0x1a, 0x04,
+	0xa4, 0x12, 0xb5, 0x69, 0x78, 0x00, 0x20, 0x6d, 0x51, 0xab, 0x16, 0x09, 0x39, 0x85, 0x05, 0x1b,
+	0xcb, 0x3f, 0x57, 0xce, 0xa8, 0xfe, 0x09, 0x33, 0x93, 0x2a, 0xd9, 0xf1, 0x08, 0xb0, 0xe2, 0x31,
+	0x78, 0x04, 0xb6, 0x5d, 0x76, 0xc9, 0x0a, 0xa1, 0xf6, 0x45, 0xd0, 0xcc, 0xf8, 0xa7, 0xb8, 0x84,
+	0xac, 0xec, 0xb9, 0x73, 0xcf, 0xb9, 0x67, 0x66, 0x8e, 0x0e, 0xda, 0xf5, 0x53, 0x16, 0xa7, 0xcc,
+	0xf2, 0x5c, 0x06, 0x16, 0xe3, 0x14, 0xdc, 0x98, 0x24, 0xa1, 0x75, 0xb1, 0xe7, 0x01, 0x77, 0xf7,
+	0xca, 0x8a, 0x39, 0xa1, 0x29, 0x4f, 0xf1, 0x96, 0x6a, 0x37, 0x45, 0xbb, 0x59, 0x6e, 0x66, 0xed,
+	0x9b, 0x1b, 0x61, 0x1a, 0xa6, 0xb2, 0xd3, 0x12, 0x7f, 0x0a, 0xb4, 0x79, 0x9f, 0x43, 0x12, 0x00,
+	0x8d, 0x49, 0xc2, 0x2d, 0xd7, 0xf3, 0x89, 0xc5, 0xe7, 0x13, 0x60, 0xd9, 0xe6, 0xce, 0x9f, 0x02,
+	0x52, 0x0a, 0xc5, 0xf0, 0x88, 0x30, 0x0e, 0x49, 0x31, 0xbc, 0xf7, 0x55, 0x47, 0xff, 0x8f, 0xa6,
+	0x1e, 0xf3, 0x29, 0xf1, 0xc0, 0x86, 0x8f, 0x53, 0x60, 0x1c, 0x6f, 0x21, 0x24, 0x51, 0xce, 0x39,
+	0xcc, 0x59, 0x5b, 0xef, 0xd6, 0xfa, 0x2d, 0xbb, 0x25, 0x2b, 0x27, 0x30, 0x67, 0x78, 0x1b, 0xad,
+	0x31, 0xee, 0x52, 0xee, 0x8c, 0x81, 0x84, 0x63, 0xde, 0xfe, 0xaf, 0xab, 0xf7, 0x6b, 0xb6, 0x21,
+	0x6b, 0x47, 0xb2, 0x24, 0x18, 0x20, 0x09, 0xf2, 0x86, 0x9a, 0x6c, 0x68, 0x41, 0x12, 0x64, 0xdb,
+	0x3d, 0xb4, 0x1e, 0xb3, 0xd0, 0x11, 0x9a, 0x9d, 0x29, 0x8d, 0x58, 0xbb, 0x2e, 0x67, 0x18, 0x31,
+	0x0b, 0xcf, 0xe6, 0x13, 0x78, 0x47, 0x23, 0xd6, 0xfb, 0x5e, 0x43, 0xeb, 0x23, 0x79, 0x1b, 0x6f,
+	0x80, 0x31, 0x37, 0x04, 0x31, 0xd7, 0x8b, 0x52, 0xff, 0x3c, 0xa7, 0xd5, 0xd5, 0x5c, 0x59, 0xcb,
+	0x88, 0x4f, 0x91, 0xe1, 0x41, 0x48, 0x12, 0x47, 0x16, 0xa5, 0x32, 0x63, 0xb0, 0x63, 0xfe, 0xf3,
+	0x86, 0xcd, 0xa1, 0x40, 0x0c, 0x25, 0x8b, 0x66, 0x23, 0xaf, 0x58, 0xe1, 0x63, 0x84, 0x02, 0x88,
+	0xc8, 0x05, 0x50, 0x87, 0xcf, 0xe4, 0x29, 0x8c, 0x41, 0x7f, 0x09, 0xd9, 0x81, 0x02, 0x9c, 0xcd,
+	0x8e, 0x34, 0xbb, 0x15, 0xe4, 0x0b, 0xfc, 0x1a, 0x89, 0xe3, 0x67, 0xb2, 0xea, 0x92, 0xe9, 0xc9,
+	0x12, 0xa6, 0xc3, 0x24, 0xc8, 0x45, 0x35, 0x21, 0xfb, 0xc7, 0x2f, 0x50, 0xc3, 0x4f, 0xe3, 0x98,
+	0xf0, 0xf6, 0xaa, 0x24, 0x79, 0xb4, 0x84, 0x64, 0x5f, 0x36, 0x1f, 0x69, 0x76, 0x06, 0xc3, 0x27,
+	0x68, 0x9d, 0x71, 0x97, 0x83, 0xe3, 0x8f, 0xdd, 0x24, 0x04, 0xd6, 0x5e, 0xe9, 0xd6, 0xfa, 0xc6,
+	0xe0, 0x71, 0x85, 0x27, 0xa5, 0x50, 0x70, 0x8c, 0xe4, 0xcb, 0xbf, 0x7f, 0xeb, 0x12, 0x6a, 0xaf,
+	0x49, 0xf0, 0xbe, 0xc2, 0xe2, 0x0d, 0xb4, 0x42, 0x92, 0x00, 0x66, 0xed, 0x46, 0x57, 0xef, 0xd7,
+	0x6d, 0xb5, 0x18, 0x36, 0x50, 0x5d, 0x58, 0x52, 0x78, 0x0b, 0x95, 0x77, 0x8b, 0x87, 0x68, 0x95,
+	0x2a, 0x83, 0xc9, 0x97, 0x33, 0x06, 0x3d, 0xb3, 0x34, 0xb1, 0x29, 0x10, 0x66, 0x66, 0xc0, 0x12,
+	0x34, 0xac, 0x5f, 0xfe, 0x7c, 0xa0, 0xd9, 0x39, 0x10, 0x1f, 0xa2, 0x26, 0x05, 0x36, 0x49, 0x13,
+	0x06, 0xd9, 0xe3, 0x3e, 0xfc, 0x0b, 0x89, 0x6a, 0xb8, 0xc3, 0x52, 0x40, 0x7b, 0xdf, 0x74, 0xd4,
+	0x2a, 0x1e, 0x0a, 0xdf, 0x43, 0x4d, 0x3e, 0x73, 0xd4, 0x41, 0x94, 0xa7, 0x56, 0xf9, 0xec, 0x58,
+	0x2c, 0xf1, 0xab, 0x52, 0xb3, 0x1a, 0xb7, 0xbd, 0x48, 0x73, 0x41, 0x57, 0x95, 0x7c, 0x70, 0x4b,
+	0x72, 0x6d, 0xe1, 0xb9, 0x55, 0x43, 0x95, 0xa4, 0x54, 0xfc, 0x45, 0x47, 0xcd, 0xdc, 0x10, 0xf8,
+	0x65, 0xf5, 0x26, 0xbb, 0x8b, 0x54, 0xe5, 0x90, 0xaa, 0xa8, 0xfd, 0x3b, 0xf7, 0xb8, 0xbd, 0x50,
+	0x54, 0x85, 0xa3, 0xd4, 0xd4, 0x44, 0x0d, 0x65, 0xaf, 0xc1, 0x27, 0x91, 0x22, 0xb9, 0xf7, 0x46,
+	0x40, 0x2f, 0x88, 0x0f, 0x38, 0x42, 0xad, 0x22, 0x59, 0xb0, 0xb5, 0xc4, 0xa7, 0xd5, 0x0c, 0xda,
+	0x7c, 0xba, 0x0c, 0x70, 0x3b, 0x1a, 0x9e, 0xe9, 0xc3, 0xd3, 0xcb, 0xeb, 0x8e, 0x7e, 0x75, 0xdd,
+	0xd1, 0x7f, 0x5d, 0x77, 0xf4, 0xcf, 0x37, 0x1d, 0xed, 0xea, 0xa6, 0xa3, 0xfd, 0xb8, 0xe9, 0x68,
+	0x1f, 0x06, 0x21, 0xe1, 0xe3, 0xa9, 0x67, 0xfa, 0x69, 0x6c, 0x65, 0xc1, 0xa8, 0x3e, 0xbb, 0x2c,
+	0x38, 0xcf, 0xe2, 0xb1, 0x4c, 0x69, 0x99, 0xa3, 0x5e, 0x43, 0xa6, 0xe3, 0xf3, 0xdf, 0x03, 0x00,
+	0xbb, 0xb2, 0x17, 0x27, 0xcb, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamingServiceClient is the client API for StreamingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamingServiceClient interface {
+	// Subscribe streams a StreamMessage for each BeginBlock, DeliverTx, EndBlock
+	// and Commit matching the request filters, as the blocks are processed.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StreamingService_SubscribeClient, error)
+}
+
+type streamingServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamingServiceClient(cc grpc1.ClientConn) StreamingServiceClient {
+	return &streamingServiceClient{cc}
+}
+
+func (c *streamingServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StreamingService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreamingService_serviceDesc.Streams[0], "/cosmos.base.streaming.v1beta1.StreamingService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreamingService_SubscribeClient interface {
+	Recv() (*StreamMessage, error)
+	grpc.ClientStream
+}
+
+type streamingServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingServiceSubscribeClient) Recv() (*StreamMessage, error) {
+	m := new(StreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingServiceServer is the server API for StreamingService service.
+type StreamingServiceServer interface {
+	// Subscribe streams a StreamMessage for each BeginBlock, DeliverTx, EndBlock
+	// and Commit matching the request filters, as the blocks are processed.
+	Subscribe(*SubscribeRequest, StreamingService_SubscribeServer) error
+}
+
+// UnimplementedStreamingServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamingServiceServer struct {
+}
+
+func (*UnimplementedStreamingServiceServer) Subscribe(req *SubscribeRequest, srv StreamingService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStreamingServiceServer(s grpc1.Server, srv StreamingServiceServer) {
+	s.RegisterService(&_StreamingService_serviceDesc, srv)
+}
+
+func _StreamingService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingServiceServer).Subscribe(m, &streamingServiceSubscribeServer{stream})
+}
+
+type StreamingService_SubscribeServer interface {
+	Send(*StreamMessage) error
+	grpc.ServerStream
+}
+
+type streamingServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingServiceSubscribeServer) Send(m *StreamMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StreamingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.streaming.v1beta1.StreamingService",
+	HandlerType: (*StreamingServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _StreamingService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/streaming/v1beta1/streaming.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintStreaming(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKeys) > 0 {
+		for iNdEx := len(m.StoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StoreKeys[iNdEx])
+			copy(dAtA[i:], m.StoreKeys[iNdEx])
+			i = encodeVarintStreaming(dAtA, i, uint64(len(m.StoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Abci != nil {
+		{
+			size := m.Abci.Size()
+			i -= size
+			if _, err := m.Abci.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamMessage_BeginBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamMessage_BeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeginBlock != nil {
+		{
+			size, err := m.BeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamMessage_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamMessage_DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeliverTx != nil {
+		{
+			size, err := m.DeliverTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamMessage_EndBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamMessage_EndBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EndBlock != nil {
+		{
+			size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamMessage_Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamMessage_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BeginBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStreaming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStreaming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliverTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStreaming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStreaming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TxIndex != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStreaming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStreaming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Commit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoreKeys) > 0 {
+		for _, s := range m.StoreKeys {
+			l = len(s)
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.EndHeight))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StreamMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.BlockHeight))
+	}
+	if m.Abci != nil {
+		n += m.Abci.Size()
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if m.Index != 0 {
+		n += 1 + sovStreaming(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *StreamMessage_BeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+func (m *StreamMessage_DeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeliverTx != nil {
+		l = m.DeliverTx.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+func (m *StreamMessage_EndBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+func (m *StreamMessage_Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+func (m *BeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovStreaming(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovStreaming(uint64(l))
+	return n
+}
+
+func (m *DeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		n += 1 + sovStreaming(uint64(m.TxIndex))
+	}
+	l = m.Request.Size()
+	n += 1 + l + sovStreaming(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovStreaming(uint64(l))
+	return n
+}
+
+func (m *EndBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovStreaming(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovStreaming(uint64(l))
+	return n
+}
+
+func (m *Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKeys = append(m.StoreKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BeginBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Abci = &StreamMessage_BeginBlock{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DeliverTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Abci = &StreamMessage_DeliverTx{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EndBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Abci = &StreamMessage_EndBlock{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Commit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Abci = &StreamMessage_Commit{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliverTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliverTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
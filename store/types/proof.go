@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+
 	ics23 "github.com/confio/ics23/go"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmmerkle "github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -11,8 +14,32 @@ import (
 const (
 	ProofOpIAVLCommitment         = "ics23:iavl"
 	ProofOpSimpleMerkleCommitment = "ics23:simple"
+	ProofOpSMTCommitment          = "ics23:smt"
 )
 
+// SmtSpec is the ProofSpec of the sparse Merkle trees of the v2 stores. Leaves
+// are stored at the SHA-256 hash of their key, so the neighbors of an absent
+// key are compared by the hashes of their keys, and inner nodes may have empty
+// children.
+var SmtSpec = &ics23.ProofSpec{
+	LeafSpec: &ics23.LeafOp{
+		Hash:         ics23.HashOp_SHA256,
+		PrehashKey:   ics23.HashOp_SHA256,
+		PrehashValue: ics23.HashOp_SHA256,
+		Length:       ics23.LengthOp_NO_PREFIX,
+		Prefix:       []byte{0},
+	},
+	InnerSpec: &ics23.InnerSpec{
+		ChildOrder:      []int32{0, 1},
+		ChildSize:       sha256.Size,
+		MinPrefixLength: 1,
+		MaxPrefixLength: 1,
+		EmptyChild:      make([]byte, sha256.Size),
+		Hash:            ics23.HashOp_SHA256,
+	},
+	MaxDepth: 256,
+}
+
 // CommitmentOp implements merkle.ProofOperator by wrapping an ics23 CommitmentProof
 // It also contains a Key field to determine which key the proof is proving.
 // NOTE: CommitmentProof currently can either be ExistenceProof or NonexistenceProof
@@ -46,6 +73,15 @@ func NewSimpleMerkleCommitmentOp(key []byte, proof *ics23.CommitmentProof) Commi
 	}
 }
 
+func NewSmtCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpSMTCommitment,
+		Spec:  SmtSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
 // CommitmentOpDecoder takes a merkle.ProofOp and attempts to decode it into a CommitmentOp ProofOperator
 // The proofOp.Data is just a marshalled CommitmentProof. The Key of the CommitmentOp is extracted
 // from the unmarshalled proof.
@@ -56,8 +92,10 @@ func CommitmentOpDecoder(pop tmmerkle.ProofOp) (merkle.ProofOperator, error) {
 		spec = ics23.IavlSpec
 	case ProofOpSimpleMerkleCommitment:
 		spec = ics23.TendermintSpec
+	case ProofOpSMTCommitment:
+		spec = SmtSpec
 	default:
-		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment' or 'ProofOpSimpleMerkleCommitment'", pop.Type)
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment', 'ProofOpSimpleMerkleCommitment' or 'ProofOpSMTCommitment'", pop.Type)
 	}
 
 	proof := &ics23.CommitmentProof{}
@@ -98,7 +136,12 @@ func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
 	switch len(args) {
 	case 0:
 		// Args are nil, so we verify the absence of the key.
-		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key)
+		var absent bool
+		if op.Type == ProofOpSMTCommitment {
+			absent = verifySmtNonMembership(op.Spec, root, op.Proof, op.Key)
+		} else {
+			absent = ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key)
+		}
 		if !absent {
 			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify absence of key: %s", string(op.Key))
 		}
@@ -129,3 +172,95 @@ func (op CommitmentOp) ProofOp() tmmerkle.ProofOp {
 		Data: bz,
 	}
 }
+
+// verifySmtNonMembership verifies a NonExistenceProof of a sparse Merkle tree.
+// It differs from ics23.VerifyNonMembership in that the neighbors are ordered by
+// the hashes of their keys, and that empty children are skipped when checking
+// that the neighbors are adjacent.
+func verifySmtNonMembership(spec *ics23.ProofSpec, root ics23.CommitmentRoot, proof *ics23.CommitmentProof, key []byte) bool {
+	np := proof.GetNonexist()
+	if np == nil || !bytes.Equal(np.Key, key) {
+		return false
+	}
+	if np.Left == nil && np.Right == nil {
+		return false
+	}
+
+	path := sha256.Sum256(key)
+	if np.Left != nil {
+		if err := np.Left.Verify(spec, root, np.Left.Key, np.Left.Value); err != nil {
+			return false
+		}
+		leftPath := sha256.Sum256(np.Left.Key)
+		if bytes.Compare(leftPath[:], path[:]) >= 0 {
+			return false
+		}
+	}
+	if np.Right != nil {
+		if err := np.Right.Verify(spec, root, np.Right.Key, np.Right.Value); err != nil {
+			return false
+		}
+		rightPath := sha256.Sum256(np.Right.Key)
+		if bytes.Compare(path[:], rightPath[:]) >= 0 {
+			return false
+		}
+	}
+
+	switch {
+	case np.Left == nil:
+		return smtIsLeftMost(spec.InnerSpec, np.Right.Path)
+	case np.Right == nil:
+		return smtIsRightMost(spec.InnerSpec, np.Left.Path)
+	default:
+		return smtIsLeftNeighbor(spec.InnerSpec, np.Left.Path, np.Right.Path)
+	}
+}
+
+// smtIsLeftChild returns true if the step hashes a left child with its sibling.
+func smtIsLeftChild(spec *ics23.InnerSpec, step *ics23.InnerOp) bool {
+	return len(step.Prefix) == int(spec.MinPrefixLength) && len(step.Suffix) == int(spec.ChildSize)
+}
+
+// smtIsRightChild returns true if the step hashes a right child with its sibling.
+func smtIsRightChild(spec *ics23.InnerSpec, step *ics23.InnerOp) bool {
+	return len(step.Prefix) == int(spec.MinPrefixLength+spec.ChildSize) && len(step.Suffix) == 0
+}
+
+// smtIsLeftMost returns true if no leaf of the tree is left of path.
+func smtIsLeftMost(spec *ics23.InnerSpec, path []*ics23.InnerOp) bool {
+	for _, step := range path {
+		leftEmpty := smtIsRightChild(spec, step) && bytes.Equal(step.Prefix[spec.MinPrefixLength:], spec.EmptyChild)
+		if !smtIsLeftChild(spec, step) && !leftEmpty {
+			return false
+		}
+	}
+	return true
+}
+
+// smtIsRightMost returns true if no leaf of the tree is right of path.
+func smtIsRightMost(spec *ics23.InnerSpec, path []*ics23.InnerOp) bool {
+	for _, step := range path {
+		rightEmpty := smtIsLeftChild(spec, step) && bytes.Equal(step.Suffix, spec.EmptyChild)
+		if !smtIsRightChild(spec, step) && !rightEmpty {
+			return false
+		}
+	}
+	return true
+}
+
+// smtIsLeftNeighbor returns true if no leaf of the tree is between left and
+// right: below their common parent, left must be the right-most path of the
+// left child and right the left-most path of the right child.
+func smtIsLeftNeighbor(spec *ics23.InnerSpec, left, right []*ics23.InnerOp) bool {
+	for len(left) > 0 && len(right) > 0 {
+		topLeft, topRight := left[len(left)-1], right[len(right)-1]
+		left, right = left[:len(left)-1], right[:len(right)-1]
+		if bytes.Equal(topLeft.Prefix, topRight.Prefix) && bytes.Equal(topLeft.Suffix, topRight.Suffix) {
+			continue
+		}
+
+		return smtIsLeftChild(spec, topLeft) && smtIsRightChild(spec, topRight) &&
+			smtIsRightMost(spec, left) && smtIsLeftMost(spec, right)
+	}
+	return false
+}
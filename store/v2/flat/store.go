@@ -10,13 +10,18 @@ import (
 
 	dbm "github.com/cosmos/cosmos-sdk/db"
 	"github.com/cosmos/cosmos-sdk/db/prefix"
+	protoio "github.com/gogo/protobuf/io"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	util "github.com/cosmos/cosmos-sdk/internal"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/internal/pruning"
+	"github.com/cosmos/cosmos-sdk/store/v2/internal/snapshot"
 	"github.com/cosmos/cosmos-sdk/store/v2/smt"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -26,6 +31,8 @@ var (
 	_ types.KVStore       = (*Store)(nil)
 	_ types.CommitKVStore = (*Store)(nil)
 	_ types.Queryable     = (*Store)(nil)
+
+	_ snapshottypes.Snapshotter = (*Store)(nil)
 )
 
 var (
@@ -256,17 +263,13 @@ func (s *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
-	previous := cid.Version - 1
-	if s.opts.Pruning.KeepEvery != 1 && s.opts.Pruning.Interval != 0 && cid.Version%int64(s.opts.Pruning.Interval) == 0 {
-		// The range of newly prunable versions
-		lastPrunable := previous - int64(s.opts.Pruning.KeepRecent)
-		firstPrunable := lastPrunable - int64(s.opts.Pruning.Interval)
-		for version := firstPrunable; version <= lastPrunable; version++ {
-			if s.opts.Pruning.KeepEvery == 0 || version%int64(s.opts.Pruning.KeepEvery) != 0 {
-				s.stateDB.DeleteVersion(uint64(version))
-				if s.opts.MerkleDB != nil {
-					s.opts.MerkleDB.DeleteVersion(uint64(version))
-				}
+	for _, version := range pruning.PrunableVersions(versions, uint64(cid.Version), s.opts.Pruning) {
+		if err := s.stateDB.DeleteVersion(version); err != nil {
+			panic(err)
+		}
+		if s.opts.MerkleDB != nil {
+			if err := s.opts.MerkleDB.DeleteVersion(version); err != nil {
+				panic(err)
 			}
 		}
 	}
@@ -389,20 +392,19 @@ func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	}
 	res.Height = height
 
+	dbr, err := s.stateDB.ReaderAt(uint64(height))
+	if err != nil {
+		if errors.Is(err, dbm.ErrVersionDoesNotExist) {
+			err = sdkerrors.ErrInvalidHeight
+		}
+		return sdkerrors.QueryResult(err, false)
+	}
+	defer dbr.Discard()
+	contents := prefix.NewPrefixReader(dbr, dataPrefix)
+
 	switch req.Path {
 	case "/key":
-		var err error
 		res.Key = req.Data // data holds the key bytes
-
-		dbr, err := s.stateDB.ReaderAt(uint64(height))
-		if err != nil {
-			if errors.Is(err, dbm.ErrVersionDoesNotExist) {
-				err = sdkerrors.ErrInvalidHeight
-			}
-			return sdkerrors.QueryResult(err, false)
-		}
-		defer dbr.Discard()
-		contents := prefix.NewPrefixReader(dbr, dataPrefix)
 		res.Value, err = contents.Get(res.Key)
 		if err != nil {
 			return sdkerrors.QueryResult(err, false)
@@ -410,27 +412,10 @@ func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		if !req.Prove {
 			break
 		}
-		merkleView := dbr
-		if s.opts.MerkleDB != nil {
-			merkleView, err = s.opts.MerkleDB.ReaderAt(uint64(height))
-			if err != nil {
-				return sdkerrors.QueryResult(
-					fmt.Errorf("version exists in state DB but not Merkle DB: %v", height), false)
-			}
-			defer merkleView.Discard()
-		}
-		root, err := dbr.Get(merkleRootKey)
+		res.ProofOps, err = s.getProofAt(dbr, uint64(height), res.Key)
 		if err != nil {
 			return sdkerrors.QueryResult(err, false)
 		}
-		if root == nil {
-			return sdkerrors.QueryResult(errors.New("Merkle root hash not found"), false) //nolint:stylecheck
-		}
-		merkleStore := loadSMT(dbm.ReaderAsReadWriter(merkleView), root)
-		res.ProofOps, err = merkleStore.GetProof(res.Key)
-		if err != nil {
-			return sdkerrors.QueryResult(fmt.Errorf("Merkle proof creation failed for key: %v", res.Key), false) //nolint:stylecheck
-		}
 
 	case "/subspace":
 		pairs := kv.Pairs{
@@ -440,8 +425,11 @@ func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		subspace := req.Data
 		res.Key = subspace
 
-		iterator := s.Iterator(subspace, types.PrefixEndBytes(subspace))
-		for ; iterator.Valid(); iterator.Next() {
+		iterator, err := contents.Iterator(subspace, types.PrefixEndBytes(subspace))
+		if err != nil {
+			return sdkerrors.QueryResult(err, false)
+		}
+		for iterator.Next() {
 			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
 		}
 		iterator.Close()
@@ -460,6 +448,34 @@ func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return res
 }
 
+// getProofAt returns an ics23 proof of the existence or absence of key in the
+// SMT of the version read by dbr.
+func (s *Store) getProofAt(dbr dbm.DBReader, version uint64, key []byte) (*tmcrypto.ProofOps, error) {
+	merkleView := dbr
+	if s.opts.MerkleDB != nil {
+		var err error
+		merkleView, err = s.opts.MerkleDB.ReaderAt(version)
+		if err != nil {
+			return nil, fmt.Errorf("version exists in state DB but not Merkle DB: %v", version)
+		}
+		defer merkleView.Discard()
+	}
+	root, err := dbr.Get(merkleRootKey)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, errors.New("Merkle root hash not found") //nolint:stylecheck
+	}
+	merkleStore := loadSMT(dbm.ReaderAsReadWriter(merkleView), root)
+	proof, err := merkleStore.GetProofICS23(key, prefix.NewPrefixReader(dbr, indexPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("Merkle proof creation failed for key: %v: %w", key, err) //nolint:stylecheck
+	}
+	op := types.NewSmtCommitmentOp(key, proof)
+	return &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{op.ProofOp()}}, nil
+}
+
 func loadSMT(merkleTxn dbm.DBReadWriter, root []byte) *smt.Store {
 	merkleNodes := prefix.NewPrefixReadWriter(merkleTxn, merkleNodePrefix)
 	merkleValues := prefix.NewPrefixReadWriter(merkleTxn, merkleValuePrefix)
@@ -477,3 +493,96 @@ func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Cac
 func (s *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
 	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
 }
+
+// Snapshot implements snapshottypes.Snapshotter. The state mappings of the
+// version at height are exported as key-value items; the SMT is not exported,
+// as it is rebuilt from them on restore.
+func (s *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+	versions, err := s.stateDB.Versions()
+	if err != nil {
+		return nil, err
+	}
+	if !versions.Exists(height) {
+		return nil, sdkerrors.Wrapf(ErrVersionDoesNotExist, "cannot snapshot height %v", height)
+	}
+	view, err := s.stateDB.ReaderAt(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot.Export(func(w protoio.Writer) (err error) {
+		defer func() {
+			err = util.CombineErrors(err, view.Discard(), "view.Discard also failed")
+		}()
+		iter, err := prefix.NewPrefixReader(view, dataPrefix).Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+		defer iter.Close()
+		for iter.Next() {
+			if err := w.WriteMsg(snapshot.KVItem(iter.Key(), iter.Value())); err != nil {
+				return err
+			}
+		}
+		return iter.Error()
+	}), nil
+}
+
+// Restore implements snapshottypes.Snapshotter. The state mappings are written
+// to the empty store and the SMT is rebuilt from them, then the store is
+// committed at height.
+func (s *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+	versions, err := s.stateDB.Versions()
+	if err != nil {
+		return err
+	}
+	if versions.Count() != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot into a non-empty store")
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	err = snapshot.Import(chunks, func(item *types.SnapshotItem) error {
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_IAVL:
+			key, value, err := snapshot.ItemKV(item.IAVL)
+			if err != nil {
+				return err
+			}
+			s.Set(key, value)
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.commit(height)
+	return err
+}
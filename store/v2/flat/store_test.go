@@ -11,6 +11,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-sdk/db"
 	"github.com/cosmos/cosmos-sdk/db/memdb"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
@@ -457,6 +458,7 @@ func TestQuery(t *testing.T) {
 	require.True(t, qres.IsOK())
 	require.Equal(t, v1, qres.Value)
 	// and for the subspace
+	querySub.Height = cid.Version
 	qres = store.Query(querySub)
 	require.True(t, qres.IsOK())
 	require.Equal(t, valExpSub1, qres.Value)
@@ -480,7 +482,11 @@ func TestQuery(t *testing.T) {
 	qres = store.Query(query2)
 	require.True(t, qres.IsOK())
 	require.Equal(t, v2, qres.Value)
-	// and for the subspace
+	// and for the subspace, at the old and the new version
+	qres = store.Query(querySub)
+	require.True(t, qres.IsOK())
+	require.Equal(t, valExpSub1, qres.Value)
+	querySub.Height = cid.Version
 	qres = store.Query(querySub)
 	require.True(t, qres.IsOK())
 	require.Equal(t, valExpSub2, qres.Value)
@@ -553,6 +559,97 @@ func TestQuery(t *testing.T) {
 	store.Close()
 }
 
+func TestQueryProofs(t *testing.T) {
+	k1, k2, absent := []byte("key1"), []byte("key2"), []byte("absent")
+
+	for _, opts := range []StoreConfig{DefaultStoreConfig, {MerkleDB: memdb.NewDB()}} {
+		store, err := NewStore(memdb.NewDB(), opts)
+		require.NoError(t, err)
+		store.Set(k1, []byte("v1"))
+		cid1 := store.Commit()
+		store.Set(k1, []byte("v2"))
+		store.Set(k2, []byte("v2"))
+		cid2 := store.Commit()
+		store.Delete(k2)
+		store.Commit()
+
+		// runProof verifies the proof of key at height against the app hash
+		runProof := func(height int64, hash []byte, key []byte) []byte {
+			res := store.Query(abci.RequestQuery{Path: "/key", Data: key, Height: height, Prove: true})
+			require.True(t, res.IsOK(), res.Log)
+			require.Len(t, res.ProofOps.Ops, 1)
+			require.Equal(t, types.ProofOpSMTCommitment, res.ProofOps.Ops[0].Type)
+			op, err := types.CommitmentOpDecoder(res.ProofOps.Ops[0])
+			require.NoError(t, err)
+
+			var args [][]byte
+			if res.Value != nil {
+				args = [][]byte{res.Value}
+			}
+			root, err := op.Run(args)
+			require.NoError(t, err)
+			require.Equal(t, [][]byte{hash}, root)
+			return res.Value
+		}
+
+		require.Equal(t, []byte("v1"), runProof(cid1.Version, cid1.Hash, k1))
+		require.Nil(t, runProof(cid1.Version, cid1.Hash, k2))
+		require.Nil(t, runProof(cid1.Version, cid1.Hash, absent))
+		require.Equal(t, []byte("v2"), runProof(cid2.Version, cid2.Hash, k1))
+		require.Equal(t, []byte("v2"), runProof(cid2.Version, cid2.Hash, k2))
+		require.Nil(t, runProof(cid2.Version, cid2.Hash, absent))
+		require.Nil(t, runProof(0, cid2.Hash, absent))
+		require.NoError(t, store.Close())
+	}
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	source := newAlohaStore(t, memdb.NewDB())
+	cid1 := source.Commit()
+	source.Set([]byte("hello"), []byte("world"))
+	source.Set([]byte("empty"), []byte{})
+	source.Delete([]byte("aloha"))
+	source.Commit()
+
+	_, err := source.Snapshot(1, 2)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+	_, err = source.Snapshot(0, snapshottypes.CurrentFormat)
+	require.Error(t, err)
+	_, err = source.Snapshot(3, snapshottypes.CurrentFormat)
+	require.ErrorIs(t, err, ErrVersionDoesNotExist)
+
+	// past versions are restored with their own contents and root hash
+	chunks, err := source.Snapshot(1, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	target, err := NewStore(memdb.NewDB(), StoreConfig{MerkleDB: memdb.NewDB()})
+	require.NoError(t, err)
+	ready := make(chan struct{})
+	require.NoError(t, target.Restore(1, snapshottypes.CurrentFormat, chunks, ready))
+	<-ready
+	require.Equal(t, cid1, target.LastCommitID())
+	require.Equal(t, []byte("goodbye"), target.Get([]byte("hello")))
+	require.Equal(t, []byte("shalom"), target.Get([]byte("aloha")))
+
+	// a store can only be restored once
+	chunks, err = source.Snapshot(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.Error(t, target.Restore(2, snapshottypes.CurrentFormat, chunks, nil))
+
+	chunks, err = source.Snapshot(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	target, err = NewStore(memdb.NewDB(), DefaultStoreConfig)
+	require.NoError(t, err)
+	require.NoError(t, target.Restore(2, snapshottypes.CurrentFormat, chunks, nil))
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	require.Equal(t, []byte("world"), target.Get([]byte("hello")))
+	require.Equal(t, []byte{}, target.Get([]byte("empty")))
+	require.False(t, target.Has([]byte("aloha")))
+
+	// the restored store keeps committing on top of the snapshot
+	target.Set([]byte("hello"), []byte("again"))
+	require.Equal(t, int64(3), target.Commit().Version)
+}
+
 type dbDeleteVersionFails struct{ dbm.DBConnection }
 type dbRWCommitFails struct{ *memdb.MemDB }
 type dbRWCrudFails struct{ dbm.DBConnection }
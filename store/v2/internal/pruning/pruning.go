@@ -0,0 +1,37 @@
+// Package pruning selects the versions the v2 stores delete on commit.
+package pruning
+
+import (
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PrunableVersions returns the saved versions which must be deleted once the
+// version latest is committed. Versions are only pruned every opts.Interval
+// commits; the opts.KeepRecent versions preceding latest and every
+// opts.KeepEvery-th version are kept.
+func PrunableVersions(versions dbm.VersionSet, latest uint64, opts types.PruningOptions) []uint64 {
+	if opts.KeepEvery == 1 || opts.Interval == 0 || latest%opts.Interval != 0 {
+		return nil
+	}
+	if latest <= opts.KeepRecent+1 {
+		return nil
+	}
+	lastPrunable := latest - 1 - opts.KeepRecent
+
+	// the iterator must be exhausted
+	var prunable []uint64
+	for it := versions.Iterator(); it.Next(); {
+		version := it.Value()
+		if version > lastPrunable {
+			continue
+		}
+		if opts.KeepEvery == 0 || version%opts.KeepEvery != 0 {
+			prunable = append(prunable, version)
+		}
+	}
+	sort.Slice(prunable, func(i, j int) bool { return prunable[i] < prunable[j] })
+	return prunable
+}
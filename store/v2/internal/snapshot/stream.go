@@ -0,0 +1,127 @@
+// Package snapshot implements the stream of SnapshotItems of the v2 stores'
+// state sync snapshots, in the same chunked format as rootmulti.Store.
+package snapshot
+
+import (
+	"bufio"
+	"compress/zlib"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	chunkSize   = uint64(10e6)
+	bufferSize  = int(chunkSize)
+	maxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
+)
+
+// Export runs export in a new goroutine and returns the chunks of the items it
+// writes. The items are serialized as delimited Protobuf messages, compressed
+// with zlib and split into chunks:
+// SnapshotItem -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+func Export(export func(protoio.Writer) error) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	go func() {
+		chunkWriter := snapshots.NewChunkWriter(ch, chunkSize)
+		defer chunkWriter.Close()
+		bufWriter := bufio.NewWriterSize(chunkWriter, bufferSize)
+		defer func() {
+			if err := bufWriter.Flush(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
+			return
+		}
+		defer func() {
+			if err := zWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+		defer func() {
+			if err := protoWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+
+		if err := export(protoWriter); err != nil {
+			chunkWriter.CloseWithError(err)
+		}
+	}()
+
+	return ch
+}
+
+// Import reads the items from chunks, as written by Export, and passes them to
+// importItem in order.
+func Import(chunks <-chan io.ReadCloser, importItem func(*types.SnapshotItem) error) error {
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> SnapshotItem
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, maxItemSize)
+	defer protoReader.Close()
+
+	for {
+		item := &types.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		if err := importItem(item); err != nil {
+			return err
+		}
+	}
+}
+
+// KVItem returns the SnapshotItem of a key-value pair. As the v2 stores keep
+// no tree nodes, only the leaves are exported, as IAVL items of height 0.
+func KVItem(key, value []byte) *types.SnapshotItem {
+	return &types.SnapshotItem{
+		Item: &types.SnapshotItem_IAVL{
+			IAVL: &types.SnapshotIAVLItem{Key: key, Value: value},
+		},
+	}
+}
+
+// StoreItem returns the SnapshotItem which starts the items of the named store.
+func StoreItem(name string) *types.SnapshotItem {
+	return &types.SnapshotItem{
+		Item: &types.SnapshotItem_Store{
+			Store: &types.SnapshotStoreItem{Name: name},
+		},
+	}
+}
+
+// ItemKV returns the key-value pair of an item returned by KVItem. Protobuf
+// does not differentiate between empty and nil values, but nil values are not
+// allowed in the stores, so they are always set to empty.
+func ItemKV(item *types.SnapshotIAVLItem) (key, value []byte, err error) {
+	if len(item.Key) == 0 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot item key cannot be empty")
+	}
+	if item.Height != 0 {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected tree node of height %d", item.Height)
+	}
+	value = item.Value
+	if value == nil {
+		value = []byte{}
+	}
+	return item.Key, value, nil
+}
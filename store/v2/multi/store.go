@@ -0,0 +1,918 @@
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmdb "github.com/tendermint/tm-db"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/prefix"
+
+	util "github.com/cosmos/cosmos-sdk/internal"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/transient"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/internal/pruning"
+	"github.com/cosmos/cosmos-sdk/store/v2/internal/snapshot"
+	"github.com/cosmos/cosmos-sdk/store/v2/smt"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	_ types.CommitMultiStore = (*Store)(nil)
+	_ types.Queryable        = (*Store)(nil)
+
+	_ snapshottypes.Snapshotter = (*Store)(nil)
+)
+
+var (
+	metadataPrefix = []byte{0} // Prefix for the root store metadata
+	substorePrefix = []byte{1} // Prefix for the substore namespaces
+
+	commitInfoKey = []byte("c") // Metadata key of the CommitInfo of the current version
+)
+
+// Prefixes of the records of a substore within its namespace, as in flat.Store.
+var (
+	merkleRootKey     = []byte{0} // Key for root hash of Merkle tree
+	dataPrefix        = []byte{1} // Prefix for state mappings
+	indexPrefix       = []byte{2} // Prefix for Store reverse index
+	merkleNodePrefix  = []byte{3} // Prefix for Merkle tree nodes
+	merkleValuePrefix = []byte{4} // Prefix for Merkle value mappings
+)
+
+var (
+	ErrVersionDoesNotExist = errors.New("version does not exist")
+	ErrMaximumHeight       = errors.New("maximum block height reached")
+)
+
+// substoreNamespace returns the prefix of the records of the named substore.
+// The name is length-prefixed so that no namespace is a prefix of another.
+func substoreNamespace(name string) []byte {
+	ns := make([]byte, 0, len(substorePrefix)+1+len(name))
+	ns = append(ns, substorePrefix...)
+	ns = append(ns, byte(len(name)))
+	return append(ns, name...)
+}
+
+// Store is a CommitMultiStore which maps each StoreKey onto a namespace of a
+// single versioned DBConnection, so that all the substores are committed
+// atomically as one DB version. Each persistent substore maintains its own
+// SMT, and the app hash is the simple Merkle root of the substores' SMT roots,
+// as in rootmulti.Store. Transient and memory stores are kept in memory and
+// are not part of the app hash.
+type Store struct {
+	stateDB  dbm.DBConnection
+	stateTxn dbm.DBReadWriter
+
+	pruningOpts    types.PruningOptions
+	initialVersion uint64
+	lastCommitInfo *types.CommitInfo
+
+	storeTypes map[types.StoreKey]types.StoreType
+	keysByName map[string]types.StoreKey
+	substores  map[types.StoreKey]*substore
+	stores     map[types.StoreKey]types.CommitKVStore
+
+	traceWriter  io.Writer
+	traceContext types.TraceContext
+
+	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
+
+	mtx sync.RWMutex
+}
+
+// NewStore returns a new Store backed by db. The store is created with a
+// PruneNothing pruning strategy by default. After a store is created, KVStores
+// must be mounted and finally LoadLatestVersion must be called.
+func NewStore(db dbm.DBConnection) *Store {
+	return &Store{
+		stateDB:     db,
+		pruningOpts: types.PruneNothing,
+		storeTypes:  make(map[types.StoreKey]types.StoreType),
+		keysByName:  make(map[string]types.StoreKey),
+		substores:   make(map[types.StoreKey]*substore),
+		stores:      make(map[types.StoreKey]types.CommitKVStore),
+		listeners:   make(map[types.StoreKey][]types.WriteListener),
+	}
+}
+
+// GetPruning implements Committer.
+func (rs *Store) GetPruning() types.PruningOptions {
+	return rs.pruningOpts
+}
+
+// SetPruning implements Committer.
+func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
+	rs.pruningOpts = pruningOpts
+}
+
+// GetStoreType implements Store.
+func (rs *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeMulti
+}
+
+// MountStoreWithDB implements CommitMultiStore. All the persistent stores
+// share the root store's DBConnection, so db must be nil.
+func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db tmdb.DB) {
+	if key == nil {
+		panic("MountStoreWithDB() key cannot be nil")
+	}
+	if db != nil {
+		panic(fmt.Sprintf("store %s cannot be mounted with a separate DB", key.Name()))
+	}
+	if len(key.Name()) > math.MaxUint8 {
+		panic(fmt.Sprintf("store key name %s is too long", key.Name()))
+	}
+	if _, ok := rs.storeTypes[key]; ok {
+		panic(fmt.Sprintf("store duplicate store key %v", key))
+	}
+	if _, ok := rs.keysByName[key.Name()]; ok {
+		panic(fmt.Sprintf("store duplicate store key name %v", key))
+	}
+
+	switch typ {
+	case types.StoreTypeIAVL, types.StoreTypeDB, types.StoreTypeSMT, types.StoreTypePersistent,
+		types.StoreTypeTransient, types.StoreTypeMemory:
+	default:
+		panic(fmt.Sprintf("unsupported store type %s", typ))
+	}
+
+	rs.storeTypes[key] = typ
+	rs.keysByName[key.Name()] = key
+}
+
+// GetCommitStore implements CommitMultiStore.
+func (rs *Store) GetCommitStore(key types.StoreKey) types.CommitStore {
+	return rs.GetCommitKVStore(key)
+}
+
+// GetCommitKVStore implements CommitMultiStore. If the store is wrapped in an
+// inter-block cache, it will be unwrapped before returning.
+func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
+	if sub, ok := rs.substores[key]; ok {
+		return sub
+	}
+
+	return rs.stores[key]
+}
+
+// LoadLatestVersionAndUpgrade implements CommitMultiStore.
+func (rs *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
+	versions, err := rs.stateDB.Versions()
+	if err != nil {
+		return err
+	}
+	return rs.loadVersion(int64(versions.Last()), upgrades)
+}
+
+// LoadVersionAndUpgrade implements CommitMultiStore.
+func (rs *Store) LoadVersionAndUpgrade(ver int64, upgrades *types.StoreUpgrades) error {
+	return rs.loadVersion(ver, upgrades)
+}
+
+// LoadLatestVersion implements CommitMultiStore.
+func (rs *Store) LoadLatestVersion() error {
+	return rs.LoadLatestVersionAndUpgrade(nil)
+}
+
+// LoadVersion implements CommitMultiStore. Only the latest version can be
+// loaded, as past versions of the DB are read-only.
+func (rs *Store) LoadVersion(ver int64) error {
+	return rs.loadVersion(ver, nil)
+}
+
+func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) (err error) {
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+
+	versions, err := rs.stateDB.Versions()
+	if err != nil {
+		return err
+	}
+	if ver < 0 || uint64(ver) != versions.Last() {
+		return sdkerrors.Wrapf(ErrVersionDoesNotExist, "cannot load version %d, latest version is %d", ver, versions.Last())
+	}
+
+	// discard the uncommitted changes, if the store was already loaded
+	if rs.stateTxn != nil {
+		if err := rs.stateTxn.Discard(); err != nil {
+			return err
+		}
+		rs.stateTxn = nil
+	}
+	if err := rs.stateDB.Revert(); err != nil {
+		return err
+	}
+
+	stateTxn := rs.stateDB.ReadWriter()
+	defer func() {
+		if err != nil {
+			err = util.CombineErrors(err, stateTxn.Discard(), "stateTxn.Discard also failed")
+		}
+	}()
+
+	var cInfo *types.CommitInfo
+	if ver != 0 {
+		cInfo, err = getCommitInfo(stateTxn)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyUpgrades(stateTxn, upgrades); err != nil {
+		return err
+	}
+
+	substores := make(map[types.StoreKey]*substore)
+	stores := make(map[types.StoreKey]types.CommitKVStore)
+	for key, typ := range rs.storeTypes {
+		if upgrades.IsDeleted(key.Name()) {
+			continue
+		}
+
+		switch typ {
+		case types.StoreTypeTransient:
+			stores[key] = transient.NewStore()
+
+		case types.StoreTypeMemory:
+			stores[key] = mem.NewStore()
+
+		default:
+			sub := &substore{root: rs, name: key.Name()}
+			if err := sub.load(stateTxn); err != nil {
+				return sdkerrors.Wrapf(err, "failed to load store %s", key.Name())
+			}
+			substores[key] = sub
+
+			var store types.CommitKVStore = sub
+			if rs.interBlockCache != nil {
+				// Wrap and get a CommitKVStore with inter-block caching. Note, this should
+				// only wrap the primary CommitKVStore, not any store that is already
+				// branched as that will create unexpected behavior.
+				store = rs.interBlockCache.GetStoreCache(key, store)
+			}
+			stores[key] = store
+		}
+	}
+
+	rs.stateTxn = stateTxn
+	rs.lastCommitInfo = cInfo
+	rs.substores = substores
+	rs.stores = stores
+
+	return nil
+}
+
+// applyUpgrades deletes and renames the namespaces of the substores, as
+// specified by upgrades. The changes are committed with the next version.
+func applyUpgrades(txn dbm.DBReadWriter, upgrades *types.StoreUpgrades) error {
+	if upgrades == nil {
+		return nil
+	}
+
+	for _, name := range upgrades.Deleted {
+		if err := deleteNamespace(txn, substoreNamespace(name)); err != nil {
+			return sdkerrors.Wrapf(err, "failed to delete store %s", name)
+		}
+	}
+
+	for _, rename := range upgrades.Renamed {
+		oldNs := substoreNamespace(rename.OldKey)
+		newNs := substoreNamespace(rename.NewKey)
+		if err := copyNamespace(txn, oldNs, newNs); err != nil {
+			return sdkerrors.Wrapf(err, "failed to move store %s -> %s", rename.OldKey, rename.NewKey)
+		}
+		if err := deleteNamespace(txn, oldNs); err != nil {
+			return sdkerrors.Wrapf(err, "failed to delete store %s", rename.OldKey)
+		}
+	}
+
+	return nil
+}
+
+// deleteNamespace deletes all the records under ns, including the SMT of the
+// substore.
+func deleteNamespace(txn dbm.DBReadWriter, ns []byte) error {
+	bucket := prefix.NewPrefixReadWriter(txn, ns)
+
+	// Note that we cannot write while iterating, so load all keys here, delete below
+	var keys [][]byte
+	it, err := bucket.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	for it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+	}
+	if err := util.CombineErrors(it.Error(), it.Close(), "Close also failed"); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyNamespace copies all the records under oldNs to newNs. As the SMT is
+// keyed by the hashes of the substore keys, the copy has the same root.
+func copyNamespace(txn dbm.DBReadWriter, oldNs, newNs []byte) error {
+	oldBucket := prefix.NewPrefixReadWriter(txn, oldNs)
+	newBucket := prefix.NewPrefixReadWriter(txn, newNs)
+
+	var pairs []kv.Pair
+	it, err := oldBucket.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	for it.Next() {
+		pairs = append(pairs, kv.Pair{
+			Key:   append([]byte(nil), it.Key()...),
+			Value: append([]byte(nil), it.Value()...),
+		})
+	}
+	if err := util.CombineErrors(it.Error(), it.Close(), "Close also failed"); err != nil {
+		return err
+	}
+
+	for _, pair := range pairs {
+		if err := newBucket.Set(pair.Key, pair.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getCommitInfo reads the CommitInfo of the version r was opened at.
+func getCommitInfo(r dbm.DBReader) (*types.CommitInfo, error) {
+	bz, err := prefix.NewPrefixReader(r, metadataPrefix).Get(commitInfoKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, errors.New("no commit info found")
+	}
+
+	cInfo := &types.CommitInfo{}
+	if err := cInfo.Unmarshal(bz); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to unmarshal commit info")
+	}
+	return cInfo, nil
+}
+
+// Close discards the uncommitted changes and releases the DB transaction. It
+// does not close the underlying DBConnection.
+func (rs *Store) Close() error {
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+
+	if rs.stateTxn == nil {
+		return nil
+	}
+	err := rs.stateTxn.Discard()
+	rs.stateTxn = nil
+	return err
+}
+
+// SetInterBlockCache implements CommitMultiStore. When this is defined, all
+// the persistent substores loaded afterwards are wrapped with their respective
+// inter-block cache.
+func (rs *Store) SetInterBlockCache(c types.MultiStorePersistentCache) {
+	rs.interBlockCache = c
+}
+
+// SetTracer sets the tracer for the MultiStore that the underlying
+// stores will utilize to trace operations. A MultiStore is returned.
+func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
+	rs.traceWriter = w
+	return rs
+}
+
+// SetTracingContext updates the tracing context for the MultiStore by merging
+// the given context with the existing context by key. Any existing keys will
+// be overwritten. It is implied that the caller should update the context when
+// necessary between tracing operations. It returns a modified MultiStore.
+func (rs *Store) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	if rs.traceContext != nil {
+		for k, v := range tc {
+			rs.traceContext[k] = v
+		}
+	} else {
+		rs.traceContext = tc
+	}
+
+	return rs
+}
+
+// TracingEnabled returns if tracing is enabled for the MultiStore.
+func (rs *Store) TracingEnabled() bool {
+	return rs.traceWriter != nil
+}
+
+// AddListeners adds listeners for a specific KVStore
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) != 0
+}
+
+// LastCommitID implements Committer.
+func (rs *Store) LastCommitID() types.CommitID {
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+
+	if rs.lastCommitInfo == nil {
+		return types.CommitID{}
+	}
+
+	return rs.lastCommitInfo.CommitID()
+}
+
+// SetInitialVersion implements CommitMultiStore. It is used when starting a
+// new chain at an arbitrary height.
+func (rs *Store) SetInitialVersion(version int64) error {
+	if version < 0 {
+		return fmt.Errorf("initial version cannot be negative: %d", version)
+	}
+	rs.initialVersion = uint64(version)
+	return nil
+}
+
+// Commit implements Committer. It commits all the substores as a new version
+// of the DB and returns the combined app hash.
+func (rs *Store) Commit() types.CommitID {
+	versions, err := rs.stateDB.Versions()
+	if err != nil {
+		panic(err)
+	}
+	target := versions.Last() + 1
+	if target > math.MaxInt64 {
+		panic(ErrMaximumHeight)
+	}
+	// Fast forward to initial version if needed
+	if rs.initialVersion != 0 && target < rs.initialVersion {
+		target = rs.initialVersion
+	}
+	cid, err := rs.commit(target)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, version := range pruning.PrunableVersions(versions, uint64(cid.Version), rs.pruningOpts) {
+		if err := rs.stateDB.DeleteVersion(version); err != nil {
+			panic(err)
+		}
+	}
+	return *cid
+}
+
+func (rs *Store) commit(target uint64) (id *types.CommitID, err error) {
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+
+	storeInfos := make([]types.StoreInfo, 0, len(rs.substores))
+	for key, sub := range rs.substores {
+		root := sub.merkleStore.Root()
+		ns := prefix.NewPrefixReadWriter(rs.stateTxn, substoreNamespace(key.Name()))
+		if err = ns.Set(merkleRootKey, root); err != nil {
+			return
+		}
+		storeInfos = append(storeInfos, types.StoreInfo{
+			Name:     key.Name(),
+			CommitId: types.CommitID{Version: int64(target), Hash: root},
+		})
+	}
+	sort.Slice(storeInfos, func(i, j int) bool { return storeInfos[i].Name < storeInfos[j].Name })
+
+	cInfo := &types.CommitInfo{Version: int64(target), StoreInfos: storeInfos}
+	bz, err := cInfo.Marshal()
+	if err != nil {
+		return
+	}
+	if err = prefix.NewPrefixReadWriter(rs.stateTxn, metadataPrefix).Set(commitInfoKey, bz); err != nil {
+		return
+	}
+
+	if err = rs.stateTxn.Commit(); err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			err = util.CombineErrors(err, rs.stateDB.Revert(), "stateDB.Revert also failed")
+		}
+	}()
+	if err = rs.stateDB.SaveVersion(target); err != nil {
+		return
+	}
+
+	stateTxn := rs.stateDB.ReadWriter()
+	defer func() {
+		if err != nil {
+			err = util.CombineErrors(err, stateTxn.Discard(), "stateTxn.Discard also failed")
+		}
+	}()
+	for _, sub := range rs.substores {
+		if err = sub.load(stateTxn); err != nil {
+			return
+		}
+	}
+	rs.stateTxn = stateTxn
+
+	// reset the transient stores
+	for _, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			store.Commit()
+		}
+	}
+
+	rs.lastCommitInfo = cInfo
+	cid := cInfo.CommitID()
+	return &cid, nil
+}
+
+// CacheWrap implements CacheWrapper/Store/CommitStore.
+func (rs *Store) CacheWrap() types.CacheWrap {
+	return rs.CacheMultiStore().(types.CacheWrap)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return rs.CacheWrap()
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (rs *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	return rs.CacheWrap()
+}
+
+// CacheMultiStore creates ephemeral branch of the multi-store and returns a CacheMultiStore.
+// It implements the MultiStore interface.
+func (rs *Store) CacheMultiStore() types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	for k, v := range rs.stores {
+		stores[k] = v
+	}
+	return cachemulti.NewFromKVStore(mem.NewStore(), stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners)
+}
+
+// CacheMultiStoreWithVersion is analogous to CacheMultiStore except that the
+// persistent stores are read from the given version of the DB. An error is
+// returned if the version does not exist or was pruned. This should only be
+// used for querying and iterating at past heights.
+func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if version < 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height overflow: %d", version)
+	}
+
+	reader, err := rs.stateDB.ReaderAt(uint64(version))
+	if err != nil {
+		if errors.Is(err, dbm.ErrVersionDoesNotExist) {
+			err = sdkerrors.Wrapf(ErrVersionDoesNotExist, "version %d", version)
+		}
+		return nil, err
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	for key, store := range rs.stores {
+		if _, ok := rs.substores[key]; !ok {
+			stores[key] = store
+			continue
+		}
+
+		ns := prefix.NewPrefixReader(reader, substoreNamespace(key.Name()))
+		stores[key] = &viewSubstore{dataBucket: prefix.NewPrefixReader(ns, dataPrefix)}
+	}
+
+	return cachemulti.NewFromKVStore(mem.NewStore(), stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners), nil
+}
+
+// GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
+// not exist, it will panic.
+func (rs *Store) GetStore(key types.StoreKey) types.Store {
+	store := rs.GetCommitKVStore(key)
+	if store == nil {
+		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
+	}
+
+	return store
+}
+
+// GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
+// enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
+// store's tracer, otherwise, the original KVStore will be returned.
+//
+// NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
+// set on the root store.
+func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
+	s := rs.stores[key]
+	if s == nil {
+		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
+	}
+	store := types.KVStore(s)
+
+	if rs.TracingEnabled() {
+		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
+	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
+	return store
+}
+
+// Query implements the Queryable interface. The path must be of the form
+// /<substore>/key or /<substore>/subspace, as for rootmulti.Store.
+//
+// By default the queries are made at the latest height - 1, as the
+// app hash of the latest height is only included in the next block header.
+// Proofs are not supported yet.
+func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
+	storeName, subpath, err := parsePath(req.Path)
+	if err != nil {
+		return sdkerrors.QueryResult(err, false)
+	}
+
+	key, ok := rs.keysByName[storeName]
+	if !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", storeName), false)
+	}
+	if _, ok := rs.substores[key]; !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s doesn't support queries", storeName), false)
+	}
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"), false)
+	}
+
+	// if height is 0, use the latest height
+	height := req.Height
+	if height == 0 {
+		versions, err := rs.stateDB.Versions()
+		if err != nil {
+			return sdkerrors.QueryResult(errors.New("failed to get version info"), false)
+		}
+		latest := versions.Last()
+		if versions.Exists(latest - 1) {
+			height = int64(latest - 1)
+		} else {
+			height = int64(latest)
+		}
+	}
+	if height < 0 {
+		return sdkerrors.QueryResult(fmt.Errorf("height overflow: %v", height), false)
+	}
+
+	dbr, err := rs.stateDB.ReaderAt(uint64(height))
+	if err != nil {
+		if errors.Is(err, dbm.ErrVersionDoesNotExist) {
+			err = sdkerrors.ErrInvalidHeight
+		}
+		return sdkerrors.QueryResult(err, false)
+	}
+	defer dbr.Discard()
+	contents := prefix.NewPrefixReader(prefix.NewPrefixReader(dbr, substoreNamespace(storeName)), dataPrefix)
+
+	res := abci.ResponseQuery{Height: height, Key: req.Data}
+	switch subpath {
+	case "/key":
+		res.Value, err = contents.Get(req.Data)
+		if err != nil {
+			return sdkerrors.QueryResult(err, false)
+		}
+		if !req.Prove {
+			break
+		}
+		res.ProofOps, err = getProofAt(dbr, storeName, req.Data)
+		if err != nil {
+			return sdkerrors.QueryResult(err, false)
+		}
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		iterator := newIterator(mustIterator(contents.Iterator(req.Data, types.PrefixEndBytes(req.Data))))
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", subpath), false)
+	}
+
+	return res
+}
+
+// getProofAt returns the proof of the existence or absence of key in the named
+// substore of the version read by dbr: an ics23 proof of key against the root
+// of the substore's SMT, followed by the proof of that root against the app hash.
+func getProofAt(dbr dbm.DBReader, storeName string, key []byte) (*tmcrypto.ProofOps, error) {
+	cInfo, err := getCommitInfo(dbr)
+	if err != nil {
+		return nil, err
+	}
+	var found bool
+	for _, info := range cInfo.StoreInfos {
+		found = found || info.Name == storeName
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "store %s was not committed at height %d", storeName, cInfo.Version)
+	}
+
+	ns := prefix.NewPrefixReader(dbr, substoreNamespace(storeName))
+	root, err := ns.Get(merkleRootKey)
+	if err != nil {
+		return nil, err
+	}
+	merkleView := dbm.ReaderAsReadWriter(ns)
+	merkleStore := smt.LoadStore(
+		prefix.NewPrefixReadWriter(merkleView, merkleNodePrefix),
+		prefix.NewPrefixReadWriter(merkleView, merkleValuePrefix),
+		root,
+	)
+	proof, err := merkleStore.GetProofICS23(key, prefix.NewPrefixReader(ns, indexPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("Merkle proof creation failed for key: %v: %w", key, err) //nolint:stylecheck
+	}
+
+	return &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{
+		types.NewSmtCommitmentOp(key, proof).ProofOp(),
+		cInfo.ProofOp(storeName),
+	}}, nil
+}
+
+func mustIterator(it dbm.Iterator, err error) dbm.Iterator {
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
+
+// parsePath expects a format like /<storeName>[/<subpath>]
+// Must start with /, subpath may be empty
+// Returns error if it doesn't start with /
+func parsePath(path string) (storeName string, subpath string, err error) {
+	if !strings.HasPrefix(path, "/") {
+		return storeName, subpath, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid path: %s", path)
+	}
+
+	paths := strings.SplitN(path[1:], "/", 2)
+	storeName = paths[0]
+
+	if len(paths) == 2 {
+		subpath = "/" + paths[1]
+	}
+
+	return storeName, subpath, nil
+}
+
+// Snapshot implements snapshottypes.Snapshotter. The persistent substores of
+// the version at height are exported in the order of the commit info, each as
+// a store item followed by its key-value items. The SMTs are not exported, as
+// they are rebuilt from the key-value items on restore.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+
+	view, err := rs.stateDB.ReaderAt(height)
+	if err != nil {
+		if errors.Is(err, dbm.ErrVersionDoesNotExist) {
+			err = sdkerrors.Wrapf(ErrVersionDoesNotExist, "cannot snapshot height %v", height)
+		}
+		return nil, err
+	}
+	cInfo, err := getCommitInfo(view)
+	if err != nil {
+		return nil, util.CombineErrors(err, view.Discard(), "view.Discard also failed")
+	}
+
+	return snapshot.Export(func(w protoio.Writer) (err error) {
+		defer func() {
+			err = util.CombineErrors(err, view.Discard(), "view.Discard also failed")
+		}()
+		for _, info := range cInfo.StoreInfos {
+			if err := w.WriteMsg(snapshot.StoreItem(info.Name)); err != nil {
+				return err
+			}
+			ns := prefix.NewPrefixReader(view, substoreNamespace(info.Name))
+			if err := exportData(w, prefix.NewPrefixReader(ns, dataPrefix)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+func exportData(w protoio.Writer, data dbm.DBReader) error {
+	iter, err := data.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for iter.Next() {
+		if err := w.WriteMsg(snapshot.KVItem(iter.Key(), iter.Value())); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// Restore implements snapshottypes.Snapshotter. The key-value items are written
+// to the mounted substores of the empty store, rebuilding their SMTs, then the
+// store is committed at height.
+func (rs *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+	versions, err := rs.stateDB.Versions()
+	if err != nil {
+		return err
+	}
+	if versions.Count() != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot into a non-empty store")
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	var sub *substore
+	err = snapshot.Import(chunks, func(item *types.SnapshotItem) error {
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			key, ok := rs.keysByName[item.Store.Name]
+			if ok {
+				sub, ok = rs.substores[key]
+			}
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "no persistent store %q is mounted", item.Store.Name)
+			}
+			return nil
+		case *types.SnapshotItem_IAVL:
+			if sub == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received key-value item before store item")
+			}
+			key, value, err := snapshot.ItemKV(item.IAVL)
+			if err != nil {
+				return err
+			}
+			sub.Set(key, value)
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = rs.commit(height)
+	return err
+}
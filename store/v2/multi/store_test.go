@@ -0,0 +1,343 @@
+package multi
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	skey1  = types.NewKVStoreKey("store1")
+	skey2  = types.NewKVStoreKey("store2")
+	skey3  = types.NewKVStoreKey("store3")
+	tkey1  = types.NewTransientStoreKey("tstore1")
+	memkey = types.NewMemoryStoreKey("memstore1")
+)
+
+func newMultiStore(t *testing.T, db dbm.DBConnection, keys ...types.StoreKey) *Store {
+	store := NewStore(db)
+	for _, key := range keys {
+		switch key.(type) {
+		case *types.TransientStoreKey:
+			store.MountStoreWithDB(key, types.StoreTypeTransient, nil)
+		case *types.MemoryStoreKey:
+			store.MountStoreWithDB(key, types.StoreTypeMemory, nil)
+		default:
+			store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		}
+	}
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+func TestMountStores(t *testing.T) {
+	store := NewStore(memdb.NewDB())
+	store.MountStoreWithDB(skey1, types.StoreTypeIAVL, nil)
+
+	require.Panics(t, func() { store.MountStoreWithDB(nil, types.StoreTypeIAVL, nil) })
+	require.Panics(t, func() { store.MountStoreWithDB(skey1, types.StoreTypeIAVL, nil) })
+	require.Panics(t, func() { store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil) })
+	require.Panics(t, func() { store.MountStoreWithDB(skey2, types.StoreTypeMulti, nil) })
+}
+
+func TestSubstoresAreIsolated(t *testing.T) {
+	store := newMultiStore(t, memdb.NewDB(), skey1, skey2)
+
+	s1 := store.GetKVStore(skey1)
+	s2 := store.GetKVStore(skey2)
+	s1.Set([]byte("key"), []byte("value1"))
+	s2.Set([]byte("key"), []byte("value2"))
+	s2.Set([]byte("other"), []byte("value"))
+
+	require.Equal(t, []byte("value1"), s1.Get([]byte("key")))
+	require.Equal(t, []byte("value2"), s2.Get([]byte("key")))
+	require.False(t, s1.Has([]byte("other")))
+
+	var keys []string
+	it := s2.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"key", "other"}, keys)
+
+	require.Panics(t, func() { store.GetKVStore(skey3) })
+}
+
+func TestCommitAndReload(t *testing.T) {
+	db := memdb.NewDB()
+	store := newMultiStore(t, db, skey1, skey2, tkey1, memkey)
+	require.Equal(t, types.CommitID{}, store.LastCommitID())
+
+	store.GetKVStore(skey1).Set([]byte("hello"), []byte("goodbye"))
+	store.GetKVStore(tkey1).Set([]byte("transient"), []byte("value"))
+	store.GetKVStore(memkey).Set([]byte("memory"), []byte("value"))
+	cid := store.Commit()
+	require.Equal(t, int64(1), cid.Version)
+	require.NotEmpty(t, cid.Hash)
+	require.Equal(t, cid, store.LastCommitID())
+	require.Equal(t, int64(1), store.GetCommitKVStore(skey1).LastCommitID().Version)
+
+	// transient stores are reset on commit, memory stores are kept in memory
+	require.Nil(t, store.GetKVStore(tkey1).Get([]byte("transient")))
+	require.Equal(t, []byte("value"), store.GetKVStore(memkey).Get([]byte("memory")))
+
+	// an empty commit yields the same app hash
+	cid2 := store.Commit()
+	require.Equal(t, int64(2), cid2.Version)
+	require.Equal(t, cid.Hash, cid2.Hash)
+
+	// writing to any substore changes the app hash
+	store.GetKVStore(skey2).Set([]byte("hello"), []byte("world"))
+	cid3 := store.Commit()
+	require.NotEqual(t, cid.Hash, cid3.Hash)
+
+	// uncommitted changes are discarded on reload
+	store.GetKVStore(skey1).Set([]byte("uncommitted"), []byte("value"))
+	require.NoError(t, store.Close())
+
+	store = newMultiStore(t, db, skey1, skey2, tkey1, memkey)
+	require.Equal(t, cid3, store.LastCommitID())
+	require.Equal(t, []byte("goodbye"), store.GetKVStore(skey1).Get([]byte("hello")))
+	require.Equal(t, []byte("world"), store.GetKVStore(skey2).Get([]byte("hello")))
+	require.Nil(t, store.GetKVStore(skey1).Get([]byte("uncommitted")))
+	require.Nil(t, store.GetKVStore(memkey).Get([]byte("memory")))
+
+	// the same changes on another DB yield the same app hash
+	other := newMultiStore(t, memdb.NewDB(), skey1, skey2)
+	other.GetKVStore(skey1).Set([]byte("hello"), []byte("goodbye"))
+	other.GetKVStore(skey2).Set([]byte("hello"), []byte("world"))
+	require.Equal(t, cid3.Hash, other.Commit().Hash)
+
+	// only the latest version can be loaded
+	require.ErrorIs(t, store.LoadVersion(1), ErrVersionDoesNotExist)
+}
+
+func TestInitialVersion(t *testing.T) {
+	store := newMultiStore(t, memdb.NewDB(), skey1)
+	require.NoError(t, store.SetInitialVersion(5))
+
+	store.GetKVStore(skey1).Set([]byte("hello"), []byte("world"))
+	require.Equal(t, int64(5), store.Commit().Version)
+	require.Equal(t, int64(6), store.Commit().Version)
+}
+
+func TestCacheMultiStore(t *testing.T) {
+	store := newMultiStore(t, memdb.NewDB(), skey1, skey2)
+	store.GetKVStore(skey1).Set([]byte("key"), []byte("v1"))
+	store.Commit()
+	store.GetKVStore(skey1).Set([]byte("key"), []byte("v2"))
+	store.Commit()
+
+	cms := store.CacheMultiStore()
+	cms.GetKVStore(skey1).Set([]byte("key"), []byte("v3"))
+	require.Equal(t, []byte("v2"), store.GetKVStore(skey1).Get([]byte("key")))
+	cms.Write()
+	require.Equal(t, []byte("v3"), store.GetKVStore(skey1).Get([]byte("key")))
+
+	// past versions are read-only
+	view, err := store.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), view.GetKVStore(skey1).Get([]byte("key")))
+	view.GetKVStore(skey1).Set([]byte("key"), []byte("v4"))
+	require.Panics(t, view.Write)
+
+	_, err = store.CacheMultiStoreWithVersion(10)
+	require.ErrorIs(t, err, ErrVersionDoesNotExist)
+}
+
+func TestStoreUpgrades(t *testing.T) {
+	db := memdb.NewDB()
+	store := newMultiStore(t, db, skey1, skey2, skey3)
+	store.GetKVStore(skey1).Set([]byte("key"), []byte("value1"))
+	store.GetKVStore(skey2).Set([]byte("key"), []byte("value2"))
+	store.GetKVStore(skey3).Set([]byte("key"), []byte("value3"))
+	cid := store.Commit()
+	hash3 := store.GetCommitKVStore(skey3).LastCommitID().Hash
+	require.NoError(t, store.Close())
+
+	// store2 is deleted, and store3 is renamed to store4
+	skey4 := types.NewKVStoreKey("store4")
+	store = NewStore(db)
+	store.MountStoreWithDB(skey1, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(skey4, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{
+		Deleted: []string{"store2"},
+		Renamed: []types.StoreRename{{OldKey: "store3", NewKey: "store4"}},
+	}))
+	require.Equal(t, []byte("value1"), store.GetKVStore(skey1).Get([]byte("key")))
+	require.Equal(t, []byte("value3"), store.GetKVStore(skey4).Get([]byte("key")))
+
+	cid2 := store.Commit()
+	require.NotEqual(t, cid.Hash, cid2.Hash)
+	require.Equal(t, hash3, store.GetCommitKVStore(skey4).LastCommitID().Hash)
+	require.NoError(t, store.Close())
+
+	// the deleted and renamed stores are empty when mounted again
+	store = newMultiStore(t, db, skey1, skey2, skey3, skey4)
+	require.Nil(t, store.GetKVStore(skey2).Get([]byte("key")))
+	require.Nil(t, store.GetKVStore(skey3).Get([]byte("key")))
+	require.Equal(t, []byte("value3"), store.GetKVStore(skey4).Get([]byte("key")))
+}
+
+func TestQuery(t *testing.T) {
+	store := newMultiStore(t, memdb.NewDB(), skey1, tkey1)
+	store.GetKVStore(skey1).Set([]byte("key1"), []byte("v1"))
+	store.Commit()
+	store.GetKVStore(skey1).Set([]byte("key1"), []byte("v2"))
+	store.GetKVStore(skey1).Set([]byte("key2"), []byte("v2"))
+	store.Commit()
+
+	// the default height is the latest height - 1
+	res := store.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key1")})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, []byte("v1"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key1"), Height: 2})
+	require.Equal(t, []byte("v2"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/store1/subspace", Data: []byte("key"), Height: 2})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Len(t, pairs.Pairs, 2)
+
+	res = store.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key1"), Height: 10})
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), res.Code)
+
+	res = store.Query(abci.RequestQuery{Path: "/store2/key", Data: []byte("key1")})
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Code)
+
+	res = store.Query(abci.RequestQuery{Path: "/tstore1/key", Data: []byte("key1")})
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Code)
+
+}
+
+func TestQueryProofs(t *testing.T) {
+	store := newMultiStore(t, memdb.NewDB(), skey1, skey2)
+	store.GetKVStore(skey1).Set([]byte("key1"), []byte("v1"))
+	store.GetKVStore(skey2).Set([]byte("key1"), []byte("other"))
+	cid1 := store.Commit()
+	store.GetKVStore(skey1).Set([]byte("key1"), []byte("v2"))
+	store.GetKVStore(skey1).Set([]byte("key2"), []byte("v2"))
+	cid2 := store.Commit()
+	store.Commit()
+
+	prt := rootmulti.DefaultProofRuntime()
+	query := func(height int64, key string) abci.ResponseQuery {
+		res := store.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte(key), Height: height, Prove: true})
+		require.Equal(t, uint32(0), res.Code, res.Log)
+		require.Len(t, res.ProofOps.Ops, 2)
+		return res
+	}
+
+	// existence proofs at past heights
+	res := query(cid1.Version, "key1")
+	require.Equal(t, []byte("v1"), res.Value)
+	require.NoError(t, prt.VerifyValue(res.ProofOps, cid1.Hash, "/store1/key1", res.Value))
+	require.Error(t, prt.VerifyValue(res.ProofOps, cid1.Hash, "/store1/key1", []byte("v2")))
+	require.Error(t, prt.VerifyValue(res.ProofOps, cid1.Hash, "/store2/key1", res.Value))
+	require.Error(t, prt.VerifyValue(res.ProofOps, cid2.Hash, "/store1/key1", res.Value))
+
+	res = query(cid2.Version, "key1")
+	require.Equal(t, []byte("v2"), res.Value)
+	require.NoError(t, prt.VerifyValue(res.ProofOps, cid2.Hash, "/store1/key1", res.Value))
+
+	// absence proofs at past heights
+	res = query(cid1.Version, "key2")
+	require.Nil(t, res.Value)
+	require.NoError(t, prt.VerifyAbsence(res.ProofOps, cid1.Hash, "/store1/key2"))
+	require.Error(t, prt.VerifyAbsence(res.ProofOps, cid1.Hash, "/store1/key1"))
+
+	res = query(cid2.Version, "key2")
+	require.Error(t, prt.VerifyAbsence(res.ProofOps, cid2.Hash, "/store1/key2"))
+	require.NoError(t, prt.VerifyValue(res.ProofOps, cid2.Hash, "/store1/key2", []byte("v2")))
+}
+
+func TestPruning(t *testing.T) {
+	db := memdb.NewDB()
+	store := newMultiStore(t, db, skey1)
+	store.SetPruning(types.PruningOptions{KeepRecent: 2, KeepEvery: 4, Interval: 5})
+
+	for i := byte(1); i <= 10; i++ {
+		store.GetKVStore(skey1).Set([]byte{i}, []byte{i})
+		store.Commit()
+	}
+
+	versions, err := db.Versions()
+	require.NoError(t, err)
+	for v, kept := range map[uint64]bool{1: false, 2: false, 3: false, 4: true, 5: false, 6: false, 7: false, 8: true, 9: true, 10: true} {
+		require.Equal(t, kept, versions.Exists(v), "version %d", v)
+	}
+
+	// pruned heights cannot be queried
+	res := store.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte{1}, Height: 3})
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), res.Code)
+	res = store.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte{1}, Height: 4, Prove: true})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	source := newMultiStore(t, memdb.NewDB(), skey1, skey2, tkey1)
+	source.GetKVStore(skey1).Set([]byte("key"), []byte("value1"))
+	source.GetKVStore(skey2).Set([]byte("key"), []byte("value2"))
+	source.GetKVStore(skey2).Set([]byte("empty"), []byte{})
+	source.GetKVStore(tkey1).Set([]byte("key"), []byte("transient"))
+	cid := source.Commit()
+	source.GetKVStore(skey1).Set([]byte("key"), []byte("later"))
+	source.Commit()
+
+	_, err := source.Snapshot(1, 2)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+	_, err = source.Snapshot(3, snapshottypes.CurrentFormat)
+	require.ErrorIs(t, err, ErrVersionDoesNotExist)
+
+	chunks, err := source.Snapshot(1, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	target := newMultiStore(t, memdb.NewDB(), skey1, skey2, tkey1)
+	ready := make(chan struct{})
+	require.NoError(t, target.Restore(1, snapshottypes.CurrentFormat, chunks, ready))
+	<-ready
+	require.Equal(t, cid, target.LastCommitID())
+	require.Equal(t, []byte("value1"), target.GetKVStore(skey1).Get([]byte("key")))
+	require.Equal(t, []byte("value2"), target.GetKVStore(skey2).Get([]byte("key")))
+	require.Equal(t, []byte{}, target.GetKVStore(skey2).Get([]byte("empty")))
+	require.Nil(t, target.GetKVStore(tkey1).Get([]byte("key")))
+	require.Equal(t, int64(2), target.Commit().Version)
+
+	// a store can only be restored once
+	chunks, err = source.Snapshot(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.Error(t, target.Restore(2, snapshottypes.CurrentFormat, chunks, nil))
+
+	// every snapshotted store must be mounted
+	chunks, err = source.Snapshot(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	target = newMultiStore(t, memdb.NewDB(), skey1)
+	require.Error(t, target.Restore(2, snapshottypes.CurrentFormat, chunks, nil))
+}
+
+func TestListenersAndTracing(t *testing.T) {
+	store := newMultiStore(t, memdb.NewDB(), skey1, skey2)
+	require.False(t, store.ListeningEnabled(skey1))
+	require.False(t, store.TracingEnabled())
+
+	listener := types.NewStoreKVPairWriteListener(new(bytes.Buffer), nil)
+	store.AddListeners(skey1, []types.WriteListener{listener})
+	require.True(t, store.ListeningEnabled(skey1))
+	require.False(t, store.ListeningEnabled(skey2))
+
+	store.SetTracer(new(bytes.Buffer))
+	require.True(t, store.TracingEnabled())
+}
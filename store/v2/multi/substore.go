@@ -0,0 +1,253 @@
+package multi
+
+import (
+	"crypto/sha256"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/prefix"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/smt"
+)
+
+var (
+	_ types.KVStore       = (*substore)(nil)
+	_ types.CommitKVStore = (*substore)(nil)
+	_ types.KVStore       = (*viewSubstore)(nil)
+)
+
+// substore is the KVStore of a single StoreKey. Its records are stored under
+// its own namespace of the root store's DB, and it maintains its own SMT whose
+// root is committed by the root store.
+type substore struct {
+	root *Store
+	name string
+
+	dataBucket  dbm.DBReadWriter
+	indexBucket dbm.DBReadWriter
+	merkleStore *smt.Store
+}
+
+// load (re)initializes the substore on top of the root store's transaction,
+// loading the SMT from its last committed root, if any.
+func (s *substore) load(txn dbm.DBReadWriter) error {
+	ns := prefix.NewPrefixReadWriter(txn, substoreNamespace(s.name))
+	root, err := ns.Get(merkleRootKey)
+	if err != nil {
+		return err
+	}
+
+	merkleNodes := prefix.NewPrefixReadWriter(ns, merkleNodePrefix)
+	merkleValues := prefix.NewPrefixReadWriter(ns, merkleValuePrefix)
+	if root == nil {
+		s.merkleStore = smt.NewStore(merkleNodes, merkleValues)
+	} else {
+		s.merkleStore = smt.LoadStore(merkleNodes, merkleValues, root)
+	}
+
+	s.dataBucket = prefix.NewPrefixReadWriter(ns, dataPrefix)
+	s.indexBucket = prefix.NewPrefixReadWriter(ns, indexPrefix)
+	return nil
+}
+
+// Get implements KVStore.
+func (s *substore) Get(key []byte) []byte {
+	s.root.mtx.RLock()
+	defer s.root.mtx.RUnlock()
+
+	val, err := s.dataBucket.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// Has implements KVStore.
+func (s *substore) Has(key []byte) bool {
+	s.root.mtx.RLock()
+	defer s.root.mtx.RUnlock()
+
+	has, err := s.dataBucket.Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// Set implements KVStore.
+func (s *substore) Set(key, value []byte) {
+	s.root.mtx.Lock()
+	defer s.root.mtx.Unlock()
+
+	err := s.dataBucket.Set(key, value)
+	if err != nil {
+		panic(err)
+	}
+	s.merkleStore.Set(key, value)
+	khash := sha256.Sum256(key)
+	err = s.indexBucket.Set(khash[:], key)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Delete implements KVStore.
+func (s *substore) Delete(key []byte) {
+	khash := sha256.Sum256(key)
+	s.root.mtx.Lock()
+	defer s.root.mtx.Unlock()
+
+	s.merkleStore.Delete(key)
+	_ = s.indexBucket.Delete(khash[:])
+	_ = s.dataBucket.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s *substore) Iterator(start, end []byte) types.Iterator {
+	iter, err := s.dataBucket.Iterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return newIterator(iter)
+}
+
+// ReverseIterator implements KVStore.
+func (s *substore) ReverseIterator(start, end []byte) types.Iterator {
+	iter, err := s.dataBucket.ReverseIterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return newIterator(iter)
+}
+
+// GetStoreType implements Store.
+func (s *substore) GetStoreType() types.StoreType {
+	return types.StoreTypePersistent
+}
+
+// Commit implements Committer. Substores can only be committed through the
+// root store, which commits all of them atomically.
+func (s *substore) Commit() types.CommitID {
+	panic("cannot commit a substore directly, commit the root store instead")
+}
+
+// LastCommitID implements Committer.
+func (s *substore) LastCommitID() types.CommitID {
+	s.root.mtx.RLock()
+	defer s.root.mtx.RUnlock()
+
+	if s.root.lastCommitInfo == nil {
+		return types.CommitID{}
+	}
+	for _, info := range s.root.lastCommitInfo.StoreInfos {
+		if info.Name == s.name {
+			return info.CommitId
+		}
+	}
+	return types.CommitID{}
+}
+
+// GetPruning implements Committer. Pruning options are set on the root store.
+func (s *substore) GetPruning() types.PruningOptions { return s.root.GetPruning() }
+
+// SetPruning is a no-op as pruning options cannot be set on a substore. They
+// must be set on the root store.
+func (s *substore) SetPruning(types.PruningOptions) {}
+
+func (s *substore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *substore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *substore) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+// viewSubstore is a read-only KVStore of a substore at a past version.
+type viewSubstore struct {
+	dataBucket dbm.DBReader
+}
+
+// Get implements KVStore.
+func (s *viewSubstore) Get(key []byte) []byte {
+	val, err := s.dataBucket.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// Has implements KVStore.
+func (s *viewSubstore) Has(key []byte) bool {
+	has, err := s.dataBucket.Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// Set implements KVStore. It panics as past versions are read-only.
+func (s *viewSubstore) Set(key, value []byte) {
+	panic(dbm.ErrReadOnly)
+}
+
+// Delete implements KVStore. It panics as past versions are read-only.
+func (s *viewSubstore) Delete(key []byte) {
+	panic(dbm.ErrReadOnly)
+}
+
+// Iterator implements KVStore.
+func (s *viewSubstore) Iterator(start, end []byte) types.Iterator {
+	iter, err := s.dataBucket.Iterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return newIterator(iter)
+}
+
+// ReverseIterator implements KVStore.
+func (s *viewSubstore) ReverseIterator(start, end []byte) types.Iterator {
+	iter, err := s.dataBucket.ReverseIterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return newIterator(iter)
+}
+
+// GetStoreType implements Store.
+func (s *viewSubstore) GetStoreType() types.StoreType {
+	return types.StoreTypePersistent
+}
+
+func (s *viewSubstore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *viewSubstore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *viewSubstore) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+type contentsIterator struct {
+	dbm.Iterator
+	valid bool
+}
+
+func newIterator(source dbm.Iterator) *contentsIterator {
+	ret := &contentsIterator{Iterator: source}
+	ret.Next()
+	return ret
+}
+
+func (it *contentsIterator) Next()       { it.valid = it.Iterator.Next() }
+func (it *contentsIterator) Valid() bool { return it.valid }
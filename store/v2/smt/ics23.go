@@ -0,0 +1,113 @@
+package smt
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	ics23 "github.com/confio/ics23/go"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var errEmptyTree = errors.New("cannot prove the absence of a key in an empty tree")
+
+// GetProofICS23 returns an ics23 CommitmentProof of the existence or the absence
+// of key, which can be verified against types.SmtSpec. The preimages map the
+// path of each key of the tree, i.e. its SHA-256 hash, to the key itself; they
+// are used to find the neighbors of an absent key.
+func (s *Store) GetProofICS23(key []byte, preimages dbm.DBReader) (*ics23.CommitmentProof, error) {
+	if len(key) == 0 {
+		return nil, errKeyEmpty
+	}
+	has, err := s.tree.Has(key)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		exist, err := s.existenceProof(key)
+		if err != nil {
+			return nil, err
+		}
+		return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: exist}}, nil
+	}
+
+	nonexist, err := s.nonExistenceProof(key, preimages)
+	if err != nil {
+		return nil, err
+	}
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonexist}}, nil
+}
+
+func (s *Store) existenceProof(key []byte) (*ics23.ExistenceProof, error) {
+	value, err := s.tree.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := s.tree.Prove(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// The side nodes are ordered from the leaf up to the root.
+	path := sha256.Sum256(key)
+	depth := len(proof.SideNodes)
+	inner := make([]*ics23.InnerOp, depth)
+	for i, sideNode := range proof.SideNodes {
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256, Prefix: []byte{1}}
+		if isRightChild(path[:], depth-1-i) {
+			op.Prefix = append(op.Prefix, sideNode...)
+		} else {
+			op.Suffix = sideNode
+		}
+		inner[i] = op
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   key,
+		Value: value,
+		Leaf:  types.SmtSpec.LeafSpec,
+		Path:  inner,
+	}, nil
+}
+
+// nonExistenceProof proves the absence of key with the existence of its
+// neighbors, the keys whose paths immediately precede and follow its path.
+func (s *Store) nonExistenceProof(key []byte, preimages dbm.DBReader) (*ics23.NonExistenceProof, error) {
+	path := sha256.Sum256(key)
+	left, err := s.neighborProof(preimages.ReverseIterator(nil, path[:]))
+	if err != nil {
+		return nil, err
+	}
+	right, err := s.neighborProof(preimages.Iterator(path[:], nil))
+	if err != nil {
+		return nil, err
+	}
+	if left == nil && right == nil {
+		return nil, errEmptyTree
+	}
+
+	return &ics23.NonExistenceProof{Key: key, Left: left, Right: right}, nil
+}
+
+// neighborProof returns the existence proof of the first key of the iterator,
+// or nil if it is empty.
+func (s *Store) neighborProof(it dbm.Iterator, err error) (*ics23.ExistenceProof, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Next() {
+		return nil, it.Error()
+	}
+	key := make([]byte, len(it.Value()))
+	copy(key, it.Value())
+	return s.existenceProof(key)
+}
+
+// isRightChild returns true if the node at depth of the path is a right child,
+// i.e. the bit of the path at depth is set.
+func isRightChild(path []byte, depth int) bool {
+	return path[depth/8]>>(7-depth%8)&1 == 1
+}
@@ -0,0 +1,118 @@
+package smt_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	store "github.com/cosmos/cosmos-sdk/store/v2/smt"
+	"github.com/lazyledger/smt"
+)
+
+// newStoreWithPreimages returns a store containing keys, with their values
+// equal to the keys, and the preimages of their paths.
+func newStoreWithPreimages(t *testing.T, keys ...string) (*store.Store, dbm.DBReadWriter) {
+	s := store.NewStore(smt.NewSimpleMap(), smt.NewSimpleMap())
+	preimages := memdb.NewDB().ReadWriter()
+	for _, key := range keys {
+		s.Set([]byte(key), []byte(key))
+		path := sha256.Sum256([]byte(key))
+		require.NoError(t, preimages.Set(path[:], []byte(key)))
+	}
+	return s, preimages
+}
+
+func runProof(t *testing.T, s *store.Store, preimages dbm.DBReader, key []byte, args [][]byte) error {
+	proof, err := s.GetProofICS23(key, preimages)
+	require.NoError(t, err)
+
+	// the proof is run after being encoded and decoded
+	op, err := types.CommitmentOpDecoder(types.NewSmtCommitmentOp(key, proof).ProofOp())
+	require.NoError(t, err)
+	root, err := op.Run(args)
+	if err == nil {
+		require.Equal(t, [][]byte{s.Root()}, root)
+	}
+	return err
+}
+
+func TestProofICS23(t *testing.T) {
+	var keys []string
+	for i := 0; i < 50; i++ {
+		keys = append(keys, fmt.Sprintf("key%d", i))
+	}
+	s, preimages := newStoreWithPreimages(t, keys...)
+
+	for _, key := range keys {
+		require.NoError(t, runProof(t, s, preimages, []byte(key), [][]byte{[]byte(key)}))
+		require.Error(t, runProof(t, s, preimages, []byte(key), [][]byte{[]byte("other")}))
+		require.Error(t, runProof(t, s, preimages, []byte(key), nil))
+	}
+
+	// absent keys cover the left-most and right-most paths of the tree
+	for i := 0; i < 500; i++ {
+		key := []byte(fmt.Sprintf("absent%d", i))
+		require.NoError(t, runProof(t, s, preimages, key, nil))
+		require.Error(t, runProof(t, s, preimages, key, [][]byte{key}))
+	}
+}
+
+func TestProofICS23Tampered(t *testing.T) {
+	keys := []string{"a", "b", "c", "d", "e", "f"}
+	s, preimages := newStoreWithPreimages(t, keys...)
+	sort.Slice(keys, func(i, j int) bool { return pathOf(keys[i]) < pathOf(keys[j]) })
+
+	// find an absent key between the two first keys of the tree
+	var absent []byte
+	for i := 0; absent == nil; i++ {
+		key := fmt.Sprintf("absent%d", i)
+		if pathOf(keys[0]) < pathOf(key) && pathOf(key) < pathOf(keys[1]) {
+			absent = []byte(key)
+		}
+	}
+	proof, err := s.GetProofICS23(absent, preimages)
+	require.NoError(t, err)
+	nonexist := proof.GetNonexist()
+	require.Equal(t, []byte(keys[0]), nonexist.Left.Key)
+	require.Equal(t, []byte(keys[1]), nonexist.Right.Key)
+
+	// the absence proof of a key does not prove the absence of another key
+	_, err = types.NewSmtCommitmentOp([]byte(keys[0]), proof).Run(nil)
+	require.Error(t, err)
+
+	// neighbors which are not adjacent do not prove an absence
+	right, err := s.GetProofICS23([]byte(keys[2]), preimages)
+	require.NoError(t, err)
+	nonexist.Right = right.GetExist()
+	_, err = types.NewSmtCommitmentOp(absent, proof).Run(nil)
+	require.Error(t, err)
+
+	// nor does a missing neighbor
+	nonexist.Right = nil
+	_, err = types.NewSmtCommitmentOp(absent, proof).Run(nil)
+	require.Error(t, err)
+}
+
+func pathOf(key string) string {
+	path := sha256.Sum256([]byte(key))
+	return string(path[:])
+}
+
+func TestProofICS23EdgeCases(t *testing.T) {
+	s, preimages := newStoreWithPreimages(t)
+	_, err := s.GetProofICS23([]byte("key"), preimages)
+	require.Error(t, err)
+	_, err = s.GetProofICS23(nil, preimages)
+	require.Error(t, err)
+
+	// the root of a tree with a single leaf is the leaf
+	s, preimages = newStoreWithPreimages(t, "key")
+	require.NoError(t, runProof(t, s, preimages, []byte("key"), [][]byte{[]byte("key")}))
+	require.NoError(t, runProof(t, s, preimages, []byte("absent"), nil))
+}
@@ -36,6 +36,7 @@ type Context struct {
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
 	minGasPrice   DecCoins
+	priority      int64 // The tx priority, only relevant in CheckTx
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
 }
@@ -57,6 +58,7 @@ func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
+func (c Context) Priority() int64             { return c.priority }
 func (c Context) EventManager() *EventManager { return c.eventManager }
 
 // clone the header before returning
@@ -202,6 +204,12 @@ func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority
+func (c Context) WithPriority(p int64) Context {
+	c.priority = p
+	return c
+}
+
 // WithConsensusParams returns a Context with an updated consensus params
 func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	c.consParams = params
@@ -104,6 +104,7 @@ func (s *contextTestSuite) TestContextWithCustom() {
 		WithVoteInfos(voteinfos).
 		WithGasMeter(meter).
 		WithMinGasPrices(minGasPrices).
+		WithPriority(10).
 		WithBlockGasMeter(blockGasMeter).
 		WithHeaderHash(headerHash)
 	s.Require().Equal(height, ctx.BlockHeight())
@@ -114,6 +115,7 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	s.Require().Equal(voteinfos, ctx.VoteInfos())
 	s.Require().Equal(meter, ctx.GasMeter())
 	s.Require().Equal(minGasPrices, ctx.MinGasPrices())
+	s.Require().Equal(int64(10), ctx.Priority())
 	s.Require().Equal(blockGasMeter, ctx.BlockGasMeter())
 	s.Require().Equal(headerHash, ctx.HeaderHash().Bytes())
 	s.Require().False(ctx.WithIsCheckTx(false).IsCheckTx())
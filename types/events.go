@@ -228,6 +228,9 @@ var (
 	AttributeKeyAccountSequence = "acc_seq"
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
+	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyTip             = "tip"
+	AttributeKeyTipper          = "tipper"
 
 	EventTypeMessage = "message"
 
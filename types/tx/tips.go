@@ -2,6 +2,7 @@ package tx
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // TipTx defines the interface to be implemented by Txs that handle Tips.
@@ -9,3 +10,17 @@ type TipTx interface {
 	sdk.FeeTx
 	GetTip() *Tip
 }
+
+// ValidateBasic performs stateless validation of the tip.
+func (t *Tip) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Tipper); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address: %s", err)
+	}
+
+	coins := sdk.Coins(t.Amount)
+	if coins.Empty() || !coins.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", coins)
+	}
+
+	return nil
+}
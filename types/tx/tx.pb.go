@@ -907,6 +907,85 @@ func (m *Tip) GetTipper() string {
 	return ""
 }
 
+// AuxSignerData is the intermediary format that an auxiliary signer (e.g. a
+// tipper) builds and sends to the fee payer (who will build and broadcast the
+// actual tx). AuxSignerData is not a valid tx in itself, and will be rejected
+// by the node if sent directly as-is.
+type AuxSignerData struct {
+	// address is the bech32-encoded address of the auxiliary signer. If using
+	// AuxSignerData across different chains, the bech32 prefix of the target
+	// chain (where the final transaction is broadcasted) should be used.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sign_doc is the SIGN_MODE_DIRECT_AUX sign doc that the auxiliary signer
+	// signs.
+	SignDoc *SignDocDirectAux `protobuf:"bytes,2,opt,name=sign_doc,json=signDoc,proto3" json:"sign_doc,omitempty"`
+	// mode is the signing mode of the single signer.
+	Mode signing.SignMode `protobuf:"varint,3,opt,name=mode,proto3,enum=cosmos.tx.signing.v1beta1.SignMode" json:"mode,omitempty"`
+	// sig is the signature of the sign doc.
+	Sig []byte `protobuf:"bytes,4,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *AuxSignerData) Reset()         { *m = AuxSignerData{} }
+func (m *AuxSignerData) String() string { return proto.CompactTextString(m) }
+func (*AuxSignerData) ProtoMessage()    {}
+func (*AuxSignerData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{10}
+}
+func (m *AuxSignerData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuxSignerData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuxSignerData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuxSignerData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuxSignerData.Merge(m, src)
+}
+func (m *AuxSignerData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuxSignerData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuxSignerData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuxSignerData proto.InternalMessageInfo
+
+func (m *AuxSignerData) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AuxSignerData) GetSignDoc() *SignDocDirectAux {
+	if m != nil {
+		return m.SignDoc
+	}
+	return nil
+}
+
+func (m *AuxSignerData) GetMode() signing.SignMode {
+	if m != nil {
+		return m.Mode
+	}
+	return signing.SignMode_SIGN_MODE_UNSPECIFIED
+}
+
+func (m *AuxSignerData) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
@@ -920,72 +999,77 @@ func init() {
 	proto.RegisterType((*ModeInfo_Multi)(nil), "cosmos.tx.v1beta1.ModeInfo.Multi")
 	proto.RegisterType((*Fee)(nil), "cosmos.tx.v1beta1.Fee")
 	proto.RegisterType((*Tip)(nil), "cosmos.tx.v1beta1.Tip")
+	proto.RegisterType((*AuxSignerData)(nil), "cosmos.tx.v1beta1.AuxSignerData")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x66, 0x6d, 0xc7, 0x7e, 0x6d, 0xfa, 0x67, 0x14, 0x21, 0xc7, 0x51, 0xdd, 0x60, 0x54,
-	0xf0, 0x25, 0xbb, 0x69, 0x7a, 0x68, 0x41, 0x48, 0x60, 0xb7, 0x54, 0xa9, 0x4a, 0x41, 0x9a, 0xe4,
-	0xd4, 0xcb, 0x6a, 0x76, 0x3d, 0x59, 0x8f, 0xea, 0x9d, 0x59, 0x76, 0x66, 0xc1, 0xfb, 0x21, 0x90,
-	0x2a, 0x24, 0xc4, 0x95, 0x33, 0x67, 0x3e, 0x44, 0x8f, 0x15, 0x27, 0x4e, 0x50, 0x25, 0x47, 0x24,
-	0xbe, 0x02, 0x68, 0x66, 0x67, 0x37, 0x69, 0x09, 0x76, 0x25, 0x38, 0xed, 0xcc, 0x9b, 0xdf, 0xfb,
-	0xcd, 0xef, 0xfd, 0xd9, 0x37, 0xd0, 0x8f, 0x84, 0x4c, 0x84, 0xf4, 0xd5, 0xc2, 0xff, 0xfa, 0x76,
-	0x48, 0x15, 0xb9, 0xed, 0xab, 0x85, 0x97, 0x66, 0x42, 0x09, 0x74, 0xbd, 0x3c, 0xf3, 0xd4, 0xc2,
-	0xb3, 0x67, 0xfd, 0xcd, 0x58, 0xc4, 0xc2, 0x9c, 0xfa, 0x7a, 0x55, 0x02, 0xfb, 0xbb, 0x96, 0x24,
-	0xca, 0x8a, 0x54, 0x09, 0x3f, 0xc9, 0xe7, 0x8a, 0x49, 0x16, 0xd7, 0x8c, 0x95, 0xc1, 0xc2, 0x07,
-	0x16, 0x1e, 0x12, 0x49, 0x6b, 0x4c, 0x24, 0x18, 0xb7, 0xe7, 0x1f, 0x9c, 0x69, 0x92, 0x2c, 0xe6,
-	0x8c, 0x9f, 0x31, 0xd9, 0xbd, 0x05, 0x6e, 0xc5, 0x42, 0xc4, 0x73, 0xea, 0x9b, 0x5d, 0x98, 0x1f,
-	0xfb, 0x84, 0x17, 0xd5, 0x51, 0xc9, 0x11, 0x94, 0x5a, 0x6d, 0x20, 0x66, 0x33, 0xfc, 0xd6, 0x81,
-	0xb5, 0xa3, 0x05, 0xda, 0x85, 0x66, 0x28, 0xa6, 0x45, 0xcf, 0xd9, 0x71, 0x46, 0x97, 0xf6, 0xb7,
-	0xbc, 0x7f, 0x04, 0xeb, 0x1d, 0x2d, 0x26, 0x62, 0x5a, 0x60, 0x03, 0x43, 0xf7, 0xa0, 0x4b, 0x72,
-	0x35, 0x0b, 0x18, 0x3f, 0x16, 0xbd, 0x35, 0xe3, 0xb3, 0x7d, 0x81, 0xcf, 0x38, 0x57, 0xb3, 0x47,
-	0xfc, 0x58, 0xe0, 0x0e, 0xb1, 0x2b, 0x34, 0x00, 0xd0, 0xb2, 0x89, 0xca, 0x33, 0x2a, 0x7b, 0xee,
-	0x8e, 0x3b, 0xba, 0x8c, 0xcf, 0x59, 0x86, 0x1c, 0x5a, 0x47, 0x0b, 0x4c, 0xbe, 0x41, 0x37, 0x00,
-	0xf4, 0x55, 0x41, 0x58, 0x28, 0x2a, 0x8d, 0xae, 0xcb, 0xb8, 0xab, 0x2d, 0x13, 0x6d, 0x40, 0xef,
-	0xc3, 0xd5, 0x5a, 0x81, 0xc5, 0xac, 0x19, 0xcc, 0x46, 0x75, 0x55, 0x89, 0x5b, 0x75, 0xdf, 0x77,
-	0x0e, 0xac, 0x1f, 0xb2, 0x98, 0x3f, 0x10, 0xd1, 0xff, 0x75, 0xe5, 0x16, 0x74, 0xa2, 0x19, 0x61,
-	0x3c, 0x60, 0xd3, 0x9e, 0xbb, 0xe3, 0x8c, 0xba, 0x78, 0xdd, 0xec, 0x1f, 0x4d, 0xd1, 0x2d, 0xb8,
-	0x42, 0xa2, 0x48, 0xe4, 0x5c, 0x05, 0x3c, 0x4f, 0x42, 0x9a, 0xf5, 0x9a, 0x3b, 0xce, 0xa8, 0x89,
-	0x37, 0xac, 0xf5, 0x0b, 0x63, 0x1c, 0xfe, 0xe9, 0xc0, 0x35, 0x2b, 0xea, 0x01, 0xcb, 0x68, 0xa4,
-	0xc6, 0xf9, 0x62, 0x95, 0xba, 0x3b, 0x00, 0x69, 0x1e, 0xce, 0x59, 0x14, 0x3c, 0xa3, 0x85, 0xad,
-	0xc9, 0xa6, 0x57, 0xf6, 0x84, 0x57, 0xf5, 0x84, 0x37, 0xe6, 0x05, 0xee, 0x96, 0xb8, 0xc7, 0xb4,
-	0xf8, 0xef, 0x52, 0x51, 0x1f, 0x3a, 0x92, 0x7e, 0x95, 0x53, 0x1e, 0xd1, 0x5e, 0xcb, 0x00, 0xea,
-	0x3d, 0x1a, 0x81, 0xab, 0x58, 0xda, 0x6b, 0x1b, 0x2d, 0xef, 0x5c, 0xd4, 0x53, 0x2c, 0xc5, 0x1a,
-	0x32, 0xfc, 0x7e, 0x0d, 0xda, 0x65, 0x83, 0xa1, 0x3d, 0xe8, 0x24, 0x54, 0x4a, 0x12, 0x9b, 0x20,
-	0xdd, 0x7f, 0x8d, 0xa2, 0x46, 0x21, 0x04, 0xcd, 0x84, 0x26, 0x65, 0x1f, 0x76, 0xb1, 0x59, 0x6b,
-	0xf5, 0x8a, 0x25, 0x54, 0xe4, 0x2a, 0x98, 0x51, 0x16, 0xcf, 0x94, 0x09, 0xaf, 0x89, 0x37, 0xac,
-	0xf5, 0xc0, 0x18, 0xd1, 0x04, 0xae, 0xd3, 0x85, 0xa2, 0x5c, 0x32, 0xc1, 0x03, 0x91, 0x2a, 0x26,
-	0xb8, 0xec, 0xfd, 0xb5, 0xbe, 0xe4, 0xda, 0x6b, 0x35, 0xfe, 0xcb, 0x12, 0x8e, 0x9e, 0xc2, 0x80,
-	0x0b, 0x1e, 0x44, 0x19, 0x53, 0x2c, 0x22, 0xf3, 0xe0, 0x02, 0xc2, 0xab, 0x4b, 0x08, 0xb7, 0xb9,
-	0xe0, 0xf7, 0xad, 0xef, 0x67, 0x6f, 0x70, 0x0f, 0x7f, 0x74, 0xa0, 0x53, 0xfd, 0x44, 0xe8, 0x53,
-	0xb8, 0xac, 0x1b, 0x97, 0x66, 0xa6, 0x03, 0xab, 0xec, 0xdc, 0xb8, 0x20, 0xaf, 0x87, 0x06, 0x66,
-	0xfe, 0xbc, 0x4b, 0xb2, 0x5e, 0x4b, 0x5d, 0x90, 0x63, 0x4a, 0x6d, 0x73, 0x5c, 0x54, 0x90, 0x87,
-	0x94, 0x62, 0x0d, 0xa9, 0x4a, 0xe7, 0xae, 0x2e, 0xdd, 0x0f, 0x0e, 0xc0, 0xd9, 0x7d, 0x6f, 0xb4,
-	0xa1, 0xf3, 0x76, 0x6d, 0x78, 0x0f, 0xba, 0x89, 0x98, 0xd2, 0x55, 0xe3, 0xe4, 0x89, 0x98, 0xd2,
-	0x72, 0x9c, 0x24, 0x76, 0xf5, 0x5a, 0xfb, 0xb9, 0xaf, 0xb7, 0xdf, 0xf0, 0xd5, 0x1a, 0x74, 0x2a,
-	0x17, 0xf4, 0x31, 0xb4, 0x25, 0xe3, 0xf1, 0x9c, 0x5a, 0x4d, 0xc3, 0x25, 0xfc, 0xde, 0xa1, 0x41,
-	0x1e, 0x34, 0xb0, 0xf5, 0x41, 0x1f, 0x42, 0xcb, 0x8c, 0x6d, 0x2b, 0xee, 0xdd, 0x65, 0xce, 0x4f,
-	0x34, 0xf0, 0xa0, 0x81, 0x4b, 0x8f, 0xfe, 0x18, 0xda, 0x25, 0x1d, 0xba, 0x0b, 0x4d, 0xad, 0xdb,
-	0x08, 0xb8, 0xb2, 0xff, 0xde, 0x39, 0x8e, 0x6a, 0x90, 0x9f, 0xaf, 0x9f, 0xe6, 0xc3, 0xc6, 0xa1,
-	0xff, 0xdc, 0x81, 0x96, 0x61, 0x45, 0x8f, 0xa1, 0x13, 0x32, 0x45, 0xb2, 0x8c, 0x54, 0xb9, 0xf5,
-	0x2b, 0x9a, 0xf2, 0xb9, 0xf1, 0xea, 0xd7, 0xa5, 0xe2, 0xba, 0x2f, 0x92, 0x94, 0x44, 0x6a, 0xc2,
-	0xd4, 0x58, 0xbb, 0xe1, 0x9a, 0x00, 0x7d, 0x04, 0x50, 0x67, 0x5d, 0x8f, 0x32, 0x77, 0x55, 0xda,
-	0xbb, 0x55, 0xda, 0xe5, 0xa4, 0x05, 0xae, 0xcc, 0x93, 0xe1, 0x1f, 0x0e, 0xb8, 0x0f, 0x29, 0x45,
-	0x11, 0xb4, 0x49, 0xa2, 0xa7, 0x82, 0x6d, 0xca, 0xfa, 0x01, 0xd1, 0xaf, 0xda, 0x39, 0x29, 0x8c,
-	0x4f, 0xf6, 0x5e, 0xfc, 0x76, 0xb3, 0xf1, 0xd3, 0xef, 0x37, 0x47, 0x31, 0x53, 0xb3, 0x3c, 0xf4,
-	0x22, 0x91, 0xf8, 0xd5, 0x8b, 0x69, 0x3e, 0xbb, 0x72, 0xfa, 0xcc, 0x57, 0x45, 0x4a, 0xa5, 0x71,
-	0x90, 0xd8, 0x52, 0xa3, 0x6d, 0xe8, 0xc6, 0x44, 0x06, 0x73, 0x96, 0x30, 0x65, 0x0a, 0xd1, 0xc4,
-	0x9d, 0x98, 0xc8, 0xcf, 0xf5, 0x1e, 0x79, 0xd0, 0x4a, 0x49, 0x41, 0xb3, 0x72, 0x8c, 0x4d, 0x7a,
-	0xbf, 0xfc, 0xbc, 0xbb, 0x69, 0x35, 0x8c, 0xa7, 0xd3, 0x8c, 0x4a, 0x79, 0xa8, 0x32, 0xc6, 0x63,
-	0x5c, 0xc2, 0xd0, 0x3e, 0xac, 0xc7, 0x19, 0xe1, 0xca, 0xce, 0xb5, 0x65, 0x1e, 0x15, 0x70, 0x98,
-	0x82, 0x7b, 0xc4, 0x52, 0x74, 0xf7, 0xed, 0x83, 0x6d, 0xea, 0x60, 0xeb, 0x00, 0xf6, 0xa0, 0xad,
-	0x58, 0x9a, 0xd2, 0xac, 0x1c, 0x55, 0x4b, 0xae, 0xb4, 0xb8, 0xc9, 0x27, 0x2f, 0x4e, 0x06, 0xce,
-	0xcb, 0x93, 0x81, 0xf3, 0xea, 0x64, 0xe0, 0x3c, 0x3f, 0x1d, 0x34, 0x5e, 0x9e, 0x0e, 0x1a, 0xbf,
-	0x9e, 0x0e, 0x1a, 0x4f, 0x6f, 0xad, 0x4e, 0x9f, 0xaf, 0x16, 0x61, 0xdb, 0xfc, 0x72, 0x77, 0xfe,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x82, 0x38, 0x16, 0x01, 0xda, 0x08, 0x00, 0x00,
+	// 1015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdb, 0xb6,
+	0x1b, 0xb6, 0x2c, 0xdb, 0xb1, 0xdf, 0x26, 0xfd, 0x43, 0x14, 0x3f, 0x28, 0x0e, 0xea, 0xe6, 0xe7,
+	0xa2, 0x9b, 0x2f, 0x91, 0xd2, 0xf4, 0xd0, 0x6e, 0x18, 0xb6, 0xd9, 0xcd, 0x8a, 0x14, 0x5d, 0x37,
+	0x80, 0xc9, 0xa9, 0x17, 0x81, 0x96, 0x19, 0x99, 0xa8, 0x45, 0x6a, 0x22, 0xb5, 0xd9, 0x1f, 0x62,
+	0x40, 0x31, 0x60, 0xd8, 0x75, 0xe7, 0x9d, 0xf7, 0x21, 0x7a, 0x1a, 0x8a, 0x9d, 0x76, 0xda, 0x8a,
+	0xe4, 0x38, 0x60, 0x5f, 0x61, 0x03, 0x29, 0x4a, 0x49, 0xbb, 0x24, 0xee, 0xb0, 0x9d, 0x44, 0xbe,
+	0x7c, 0xde, 0x87, 0xcf, 0x4b, 0x3e, 0x7a, 0x09, 0xdd, 0x48, 0xc8, 0x44, 0xc8, 0x40, 0xcd, 0x83,
+	0x2f, 0xef, 0x8c, 0xa9, 0x22, 0x77, 0x02, 0x35, 0xf7, 0xd3, 0x4c, 0x28, 0x81, 0xae, 0x15, 0x6b,
+	0xbe, 0x9a, 0xfb, 0x76, 0xad, 0x7b, 0x3d, 0x16, 0xb1, 0x30, 0xab, 0x81, 0x1e, 0x15, 0xc0, 0xee,
+	0x96, 0x25, 0x89, 0xb2, 0x45, 0xaa, 0x44, 0x90, 0xe4, 0x33, 0xc5, 0x24, 0x8b, 0x2b, 0xc6, 0x32,
+	0x60, 0xe1, 0x3d, 0x0b, 0x1f, 0x13, 0x49, 0x2b, 0x4c, 0x24, 0x18, 0xb7, 0xeb, 0xef, 0x9e, 0x68,
+	0x92, 0x2c, 0xe6, 0x8c, 0x9f, 0x30, 0xd9, 0xb9, 0x05, 0xae, 0xc7, 0x42, 0xc4, 0x33, 0x1a, 0x98,
+	0xd9, 0x38, 0x3f, 0x0c, 0x08, 0x5f, 0x94, 0x4b, 0x05, 0x47, 0x58, 0x68, 0xb5, 0x85, 0x98, 0x49,
+	0xff, 0x6b, 0x07, 0xea, 0x07, 0x73, 0xb4, 0x05, 0x8d, 0xb1, 0x98, 0x2c, 0x3c, 0x67, 0xd3, 0x19,
+	0x5c, 0xda, 0x59, 0xf7, 0xff, 0x56, 0xac, 0x7f, 0x30, 0x1f, 0x89, 0xc9, 0x02, 0x1b, 0x18, 0xba,
+	0x0f, 0x1d, 0x92, 0xab, 0x69, 0xc8, 0xf8, 0xa1, 0xf0, 0xea, 0x26, 0x67, 0xe3, 0x8c, 0x9c, 0x61,
+	0xae, 0xa6, 0x8f, 0xf8, 0xa1, 0xc0, 0x6d, 0x62, 0x47, 0xa8, 0x07, 0xa0, 0x65, 0x13, 0x95, 0x67,
+	0x54, 0x7a, 0xee, 0xa6, 0x3b, 0x58, 0xc5, 0xa7, 0x22, 0x7d, 0x0e, 0xcd, 0x83, 0x39, 0x26, 0x5f,
+	0xa1, 0x1b, 0x00, 0x7a, 0xab, 0x70, 0xbc, 0x50, 0x54, 0x1a, 0x5d, 0xab, 0xb8, 0xa3, 0x23, 0x23,
+	0x1d, 0x40, 0xef, 0xc0, 0x95, 0x4a, 0x81, 0xc5, 0xd4, 0x0d, 0x66, 0xad, 0xdc, 0xaa, 0xc0, 0x2d,
+	0xdb, 0xef, 0x1b, 0x07, 0x56, 0xf6, 0x59, 0xcc, 0x77, 0x45, 0xf4, 0x5f, 0x6d, 0xb9, 0x0e, 0xed,
+	0x68, 0x4a, 0x18, 0x0f, 0xd9, 0xc4, 0x73, 0x37, 0x9d, 0x41, 0x07, 0xaf, 0x98, 0xf9, 0xa3, 0x09,
+	0xba, 0x0d, 0x97, 0x49, 0x14, 0x89, 0x9c, 0xab, 0x90, 0xe7, 0xc9, 0x98, 0x66, 0x5e, 0x63, 0xd3,
+	0x19, 0x34, 0xf0, 0x9a, 0x8d, 0x7e, 0x66, 0x82, 0xfd, 0x3f, 0x1c, 0xb8, 0x6a, 0x45, 0xed, 0xb2,
+	0x8c, 0x46, 0x6a, 0x98, 0xcf, 0x97, 0xa9, 0xbb, 0x0b, 0x90, 0xe6, 0xe3, 0x19, 0x8b, 0xc2, 0x67,
+	0x74, 0x61, 0xef, 0xe4, 0xba, 0x5f, 0x78, 0xc2, 0x2f, 0x3d, 0xe1, 0x0f, 0xf9, 0x02, 0x77, 0x0a,
+	0xdc, 0x63, 0xba, 0xf8, 0xf7, 0x52, 0x51, 0x17, 0xda, 0x92, 0x7e, 0x91, 0x53, 0x1e, 0x51, 0xaf,
+	0x69, 0x00, 0xd5, 0x1c, 0x0d, 0xc0, 0x55, 0x2c, 0xf5, 0x5a, 0x46, 0xcb, 0xff, 0xce, 0xf2, 0x14,
+	0x4b, 0xb1, 0x86, 0xf4, 0xbf, 0xad, 0x43, 0xab, 0x30, 0x18, 0xda, 0x86, 0x76, 0x42, 0xa5, 0x24,
+	0xb1, 0x29, 0xd2, 0x3d, 0xb7, 0x8a, 0x0a, 0x85, 0x10, 0x34, 0x12, 0x9a, 0x14, 0x3e, 0xec, 0x60,
+	0x33, 0xd6, 0xea, 0x15, 0x4b, 0xa8, 0xc8, 0x55, 0x38, 0xa5, 0x2c, 0x9e, 0x2a, 0x53, 0x5e, 0x03,
+	0xaf, 0xd9, 0xe8, 0x9e, 0x09, 0xa2, 0x11, 0x5c, 0xa3, 0x73, 0x45, 0xb9, 0x64, 0x82, 0x87, 0x22,
+	0x55, 0x4c, 0x70, 0xe9, 0xfd, 0xb9, 0x72, 0xc1, 0xb6, 0x57, 0x2b, 0xfc, 0xe7, 0x05, 0x1c, 0x3d,
+	0x85, 0x1e, 0x17, 0x3c, 0x8c, 0x32, 0xa6, 0x58, 0x44, 0x66, 0xe1, 0x19, 0x84, 0x57, 0x2e, 0x20,
+	0xdc, 0xe0, 0x82, 0x3f, 0xb0, 0xb9, 0x9f, 0xbc, 0xc1, 0xdd, 0xff, 0xde, 0x81, 0x76, 0xf9, 0x13,
+	0xa1, 0x8f, 0x61, 0x55, 0x1b, 0x97, 0x66, 0xc6, 0x81, 0xe5, 0xe9, 0xdc, 0x38, 0xe3, 0x5c, 0xf7,
+	0x0d, 0xcc, 0xfc, 0x79, 0x97, 0x64, 0x35, 0x96, 0xfa, 0x42, 0x0e, 0x29, 0xf5, 0xea, 0xe7, 0x5e,
+	0xc8, 0x43, 0x4a, 0xb1, 0x86, 0x94, 0x57, 0xe7, 0x2e, 0xbf, 0xba, 0xef, 0x1c, 0x80, 0x93, 0xfd,
+	0xde, 0xb0, 0xa1, 0xf3, 0x76, 0x36, 0xbc, 0x0f, 0x9d, 0x44, 0x4c, 0xe8, 0xb2, 0x76, 0xf2, 0x44,
+	0x4c, 0x68, 0xd1, 0x4e, 0x12, 0x3b, 0x7a, 0xcd, 0x7e, 0xee, 0xeb, 0xf6, 0xeb, 0xbf, 0xaa, 0x43,
+	0xbb, 0x4c, 0x41, 0x1f, 0x40, 0x4b, 0x32, 0x1e, 0xcf, 0xa8, 0xd5, 0xd4, 0xbf, 0x80, 0xdf, 0xdf,
+	0x37, 0xc8, 0xbd, 0x1a, 0xb6, 0x39, 0xe8, 0x3d, 0x68, 0x9a, 0xb6, 0x6d, 0xc5, 0xfd, 0xff, 0xa2,
+	0xe4, 0x27, 0x1a, 0xb8, 0x57, 0xc3, 0x45, 0x46, 0x77, 0x08, 0xad, 0x82, 0x0e, 0xdd, 0x83, 0x86,
+	0xd6, 0x6d, 0x04, 0x5c, 0xde, 0xb9, 0x75, 0x8a, 0xa3, 0x6c, 0xe4, 0xa7, 0xef, 0x4f, 0xf3, 0x61,
+	0x93, 0xd0, 0x7d, 0xee, 0x40, 0xd3, 0xb0, 0xa2, 0xc7, 0xd0, 0x1e, 0x33, 0x45, 0xb2, 0x8c, 0x94,
+	0x67, 0x1b, 0x94, 0x34, 0xc5, 0x73, 0xe3, 0x57, 0xaf, 0x4b, 0xc9, 0xf5, 0x40, 0x24, 0x29, 0x89,
+	0xd4, 0x88, 0xa9, 0xa1, 0x4e, 0xc3, 0x15, 0x01, 0x7a, 0x1f, 0xa0, 0x3a, 0x75, 0xdd, 0xca, 0xdc,
+	0x65, 0xc7, 0xde, 0x29, 0x8f, 0x5d, 0x8e, 0x9a, 0xe0, 0xca, 0x3c, 0xe9, 0xff, 0xee, 0x80, 0xfb,
+	0x90, 0x52, 0x14, 0x41, 0x8b, 0x24, 0xba, 0x2b, 0x58, 0x53, 0x56, 0x0f, 0x88, 0x7e, 0xd5, 0x4e,
+	0x49, 0x61, 0x7c, 0xb4, 0xfd, 0xe2, 0xd7, 0x9b, 0xb5, 0x1f, 0x7e, 0xbb, 0x39, 0x88, 0x99, 0x9a,
+	0xe6, 0x63, 0x3f, 0x12, 0x49, 0x50, 0xbe, 0x98, 0xe6, 0xb3, 0x25, 0x27, 0xcf, 0x02, 0xb5, 0x48,
+	0xa9, 0x34, 0x09, 0x12, 0x5b, 0x6a, 0xb4, 0x01, 0x9d, 0x98, 0xc8, 0x70, 0xc6, 0x12, 0xa6, 0xcc,
+	0x45, 0x34, 0x70, 0x3b, 0x26, 0xf2, 0x53, 0x3d, 0x47, 0x3e, 0x34, 0x53, 0xb2, 0xa0, 0x59, 0xd1,
+	0xc6, 0x46, 0xde, 0xcf, 0x3f, 0x6e, 0x5d, 0xb7, 0x1a, 0x86, 0x93, 0x49, 0x46, 0xa5, 0xdc, 0x57,
+	0x19, 0xe3, 0x31, 0x2e, 0x60, 0x68, 0x07, 0x56, 0xe2, 0x8c, 0x70, 0x65, 0xfb, 0xda, 0x45, 0x19,
+	0x25, 0xb0, 0x9f, 0x82, 0x7b, 0xc0, 0x52, 0x74, 0xef, 0xed, 0x8b, 0x6d, 0xe8, 0x62, 0xab, 0x02,
+	0xb6, 0xa1, 0xa5, 0x58, 0x9a, 0xd2, 0xcc, 0xab, 0x2f, 0xd9, 0xd2, 0xe2, 0xfa, 0x3f, 0x39, 0xb0,
+	0x36, 0xcc, 0xe7, 0xc5, 0xff, 0xb5, 0x4b, 0x14, 0xd1, 0xba, 0x49, 0x01, 0xf5, 0x9c, 0x25, 0x24,
+	0x25, 0x10, 0x7d, 0x08, 0x6d, 0xed, 0xb0, 0x70, 0x22, 0x22, 0x6b, 0xe0, 0x5b, 0xe7, 0x34, 0x8d,
+	0xd3, 0x0f, 0x0e, 0x5e, 0x91, 0x45, 0xa4, 0x32, 0xae, 0xfb, 0x0f, 0x8d, 0x8b, 0xae, 0x82, 0x2b,
+	0x59, 0x6c, 0x0e, 0x78, 0x15, 0xeb, 0xe1, 0xe8, 0xa3, 0x17, 0x47, 0x3d, 0xe7, 0xe5, 0x51, 0xcf,
+	0x79, 0x75, 0xd4, 0x73, 0x9e, 0x1f, 0xf7, 0x6a, 0x2f, 0x8f, 0x7b, 0xb5, 0x5f, 0x8e, 0x7b, 0xb5,
+	0xa7, 0xb7, 0x97, 0xfb, 0x21, 0x50, 0xf3, 0x71, 0xcb, 0xf4, 0x90, 0xbb, 0x7f, 0x0d, 0x00, 0x83,
+	0x68, 0x7c, 0x6c, 0xab, 0x09, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1655,6 +1739,60 @@ func (m *Tip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuxSignerData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuxSignerData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuxSignerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignDoc != nil {
+		{
+			size, err := m.SignDoc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1956,6 +2094,30 @@ func (m *Tip) Size() (n int) {
 	return n
 }
 
+func (m *AuxSignerData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignDoc != nil {
+		l = m.SignDoc.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3753,6 +3915,177 @@ func (m *Tip) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AuxSignerData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuxSignerData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuxSignerData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignDoc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignDoc == nil {
+				m.SignDoc = &SignDocDirectAux{}
+			}
+			if err := m.SignDoc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= signing.SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
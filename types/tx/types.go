@@ -79,6 +79,12 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	if authInfo.Tip != nil {
+		if err := authInfo.Tip.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// GetAuxToFeeCommand returns the command which lets a fee payer finish and
+// broadcast a tx from the aux signer data generated with the --aux flag.
+func GetAuxToFeeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-to-fee [aux_signed_tx.json]",
+		Short: "Include the aux signer data in a tx, add the fee and broadcast it",
+		Long: strings.TrimSpace(`Read the aux signer data generated with the --aux flag from
+[aux_signed_tx.json], add the fee, sign the tx as the fee payer (--from) and
+broadcast it. The tip in the aux signer data, if any, is transferred to the
+fee payer.
+
+$ <appd> tx aux-to-fee ./aux_signed_tx.json --from feepayer --fees 10stake
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.IsAux {
+				return errors.New("the --aux flag cannot be used with aux-to-fee")
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var auxSignerData tx.AuxSignerData
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &auxSignerData); err != nil {
+				return err
+			}
+
+			txf := clienttx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if !clientCtx.Offline {
+				txf, err = txf.Prepare(clientCtx)
+				if err != nil {
+					return err
+				}
+			}
+
+			txBuilder, err := txf.BuildTxWithAuxSignerData(clientCtx.GetFromAddress(), auxSignerData)
+			if err != nil {
+				return err
+			}
+
+			if err := clienttx.Sign(txf, clientCtx.GetFromName(), txBuilder, false); err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(string(json) + "\n")
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,7 +16,8 @@ import (
 var _ tx.Handler = mempoolFeeTxHandler{}
 
 type mempoolFeeTxHandler struct {
-	next tx.Handler
+	oracle GasPriceOracle
+	next   tx.Handler
 }
 
 // MempoolFeeMiddleware will check if the transaction's fee is at least as large
@@ -30,7 +32,21 @@ func MempoolFeeMiddleware(txh tx.Handler) tx.Handler {
 	}
 }
 
-// CheckTx implements tx.Handler.CheckTx.
+// NewMempoolFeeMiddleware returns a MempoolFeeMiddleware which, if oracle is
+// not nil, values the fee and the minimum gas prices in the oracle's base
+// denom. This allows a validator to accept fees in several denoms, as long as
+// their total value covers the minimum gas price.
+func NewMempoolFeeMiddleware(oracle GasPriceOracle) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return mempoolFeeTxHandler{
+			oracle: oracle,
+			next:   txh,
+		}
+	}
+}
+
+// CheckTx implements tx.Handler.CheckTx. It also sets the tx priority, derived
+// from the effective gas price of the tx, on the context and in the response.
 func (txh mempoolFeeTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -42,27 +58,127 @@ func (txh mempoolFeeTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
 
-	// Ensure that the provided fees meet a minimum threshold for the validator,
-	// if this is a CheckTx. This is only for local mempool purposes, and thus
-	// is only ran on check tx.
-	minGasPrices := sdkCtx.MinGasPrices()
-	if !minGasPrices.IsZero() {
-		requiredFees := make(sdk.Coins, len(minGasPrices))
-
-		// Determine the required fees by multiplying each required minimum gas
-		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-		glDec := sdk.NewDec(int64(gas))
-		for i, gp := range minGasPrices {
-			fee := gp.Amount.Mul(glDec)
-			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	var (
+		priority int64
+		err      error
+	)
+	if txh.oracle == nil {
+		err = checkMinGasPrices(sdkCtx, feeCoins, gas)
+		priority = getTxPriority(feeCoins, gas)
+	} else {
+		priority, err = txh.checkMinGasPricesWithOracle(sdkCtx, feeCoins, gas)
+	}
+	if err != nil {
+		return abci.ResponseCheckTx{}, err
+	}
+
+	res, err := txh.next.CheckTx(sdk.WrapSDKContext(sdkCtx.WithPriority(priority)), tx, req)
+	if err != nil {
+		return res, err
+	}
+
+	res.Priority = priority
+
+	return res, nil
+}
+
+// checkMinGasPrices ensures that the provided fees meet a minimum threshold
+// for the validator, if this is a CheckTx. This is only for local mempool
+// purposes, and thus is only ran on check tx.
+func checkMinGasPrices(ctx sdk.Context, feeCoins sdk.Coins, gas uint64) error {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+
+	// Determine the required fees by multiplying each required minimum gas
+	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+	glDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	if !feeCoins.IsAnyGTE(requiredFees) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+	}
+
+	return nil
+}
+
+// checkMinGasPricesWithOracle values the fee in the oracle's base denom and
+// checks it against the lowest minimum gas price valued in the same denom. It
+// returns the tx priority, i.e. the effective gas price in the base denom.
+func (txh mempoolFeeTxHandler) checkMinGasPricesWithOracle(ctx sdk.Context, feeCoins sdk.Coins, gas uint64) (int64, error) {
+	baseDenom := txh.oracle.BaseDenom()
+	feeValue := convertToBaseDenom(ctx, txh.oracle, sdk.NewDecCoinsFromCoins(feeCoins...))
+
+	var priority int64
+	if gas > 0 {
+		priority = decToPriority(feeValue.QuoInt64(int64(gas)))
+	}
+
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return priority, nil
+	}
+
+	var minGasPrice sdk.Dec
+	for _, gp := range minGasPrices {
+		price := convertToBaseDenom(ctx, txh.oracle, sdk.DecCoins{gp})
+		if !price.IsPositive() {
+			continue
 		}
 
-		if !feeCoins.IsAnyGTE(requiredFees) {
-			return abci.ResponseCheckTx{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+		if minGasPrice.IsNil() || price.LT(minGasPrice) {
+			minGasPrice = price
 		}
 	}
 
-	return txh.next.CheckTx(ctx, tx, req)
+	// None of the minimum gas prices can be valued by the oracle, so fall
+	// back to comparing the fee denoms one by one.
+	if minGasPrice.IsNil() {
+		return priority, checkMinGasPrices(ctx, feeCoins, gas)
+	}
+
+	requiredFee := minGasPrice.Mul(sdk.NewDec(int64(gas))).Ceil()
+	if feeValue.LT(requiredFee) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s (%s%s) required: %s%s", feeCoins, feeValue, baseDenom, requiredFee, baseDenom)
+	}
+
+	return priority, nil
+}
+
+// getTxPriority returns the priority of a tx paying feeCoins for gas, i.e.
+// its lowest gas price among the fee denoms, as they cannot be valued in a
+// common denom without a GasPriceOracle.
+func getTxPriority(feeCoins sdk.Coins, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+	for i, c := range feeCoins {
+		p := decToPriority(c.Amount.ToDec().QuoInt64(int64(gas)))
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
+// decToPriority truncates a gas price to an int64 priority, saturating it at
+// math.MaxInt64.
+func decToPriority(gasPrice sdk.Dec) int64 {
+	p := gasPrice.TruncateInt()
+	if !p.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return p.Int64()
 }
 
 // DeliverTx implements tx.Handler.DeliverTx.
@@ -1,9 +1,15 @@
 package middleware_test
 
 import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -97,3 +103,195 @@ func (s *MWTestSuite) TestDeductFees() {
 
 	s.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+// priorityTxHandler records the tx priority set on the context in CheckTx.
+type priorityTxHandler struct {
+	noopTxHandler
+	priority *int64
+}
+
+func (txh priorityTxHandler) CheckTx(ctx context.Context, _ sdk.Tx, _ abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	*txh.priority = sdk.UnwrapSDKContext(ctx).Priority()
+	return abci.ResponseCheckTx{}, nil
+}
+
+func (s *MWTestSuite) TestMempoolFeePriority() {
+	ctx := s.SetupTest(true) // setup
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	oracle := middleware.NewStaticGasPriceOracle("stake", map[string]sdk.Dec{"atom": sdk.NewDec(2)})
+
+	checkTx := func(oracle middleware.GasPriceOracle, fee sdk.Coins, gas uint64) int64 {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gas)
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+		s.Require().NoError(err)
+
+		var priority int64
+		txHandler := middleware.ComposeMiddlewares(priorityTxHandler{priority: &priority}, middleware.NewMempoolFeeMiddleware(oracle))
+		res, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)))), tx, abci.RequestCheckTx{})
+		s.Require().NoError(err)
+		s.Require().Equal(priority, res.Priority, "the response and context priorities differ")
+
+		return res.Priority
+	}
+
+	testCases := []struct {
+		name        string
+		oracle      middleware.GasPriceOracle
+		fee         sdk.Coins
+		gas         uint64
+		expPriority int64
+	}{
+		{
+			"no oracle, single denom",
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), 100,
+			1,
+		},
+		{
+			"no oracle, lowest gas price among denoms",
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 1000)), 100,
+			3,
+		},
+		{
+			"oracle, fee in another denom",
+			oracle,
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), 10,
+			12,
+		},
+		{
+			"oracle, fee summed across denoms",
+			oracle,
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 400), sdk.NewInt64Coin("stake", 200)), 100,
+			10,
+		},
+		{
+			"oracle, saturated priority",
+			oracle,
+			sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(math.MaxUint64))), 1,
+			math.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expPriority, checkTx(tc.oracle, tc.fee, tc.gas))
+		})
+	}
+
+	// a higher effective gas price in the base denom gives a higher priority,
+	// whichever denoms the fee is paid in
+	low := checkTx(oracle, sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 300)), 100)
+	mid := checkTx(oracle, sdk.NewCoins(sdk.NewInt64Coin("stake", 700)), 100)
+	high := checkTx(oracle, sdk.NewCoins(sdk.NewInt64Coin("atom", 400)), 100)
+	s.Require().Equal([]int64{5, 7, 8}, []int64{low, mid, high})
+}
+
+func (s *MWTestSuite) TestMempoolFeeOracle() {
+	ctx := s.SetupTest(true) // setup
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	oracle := middleware.NewStaticGasPriceOracle("stake", map[string]sdk.Dec{"atom": sdk.NewDec(2)})
+
+	testCases := []struct {
+		name        string
+		minGasPrice sdk.DecCoins
+		fee         sdk.Coins
+		gas         uint64
+		expErr      error
+	}{
+		{
+			"no min gas price",
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("foo", 1)), 100,
+			nil,
+		},
+		{
+			"fee in another denom covers min gas price",
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), 10,
+			nil,
+		},
+		{
+			"fee summed across denoms",
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 40), sdk.NewInt64Coin("stake", 20)), 100,
+			nil,
+		},
+		{
+			"insufficient fee",
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), 100,
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"unsupported fee denom is ignored",
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)), 100,
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"min gas price valued in base denom",
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), 100,
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"min gas prices not supported by the oracle",
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("foo", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), 100,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			txBuilder.SetFeeAmount(tc.fee)
+			txBuilder.SetGasLimit(tc.gas)
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+			s.Require().NoError(err)
+
+			txHandler := middleware.ComposeMiddlewares(noopTxHandler{}, middleware.NewMempoolFeeMiddleware(oracle))
+			_, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx.WithMinGasPrices(tc.minGasPrice)), tx, abci.RequestCheckTx{})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *MWTestSuite) TestLoadGasPriceOracleFromFile() {
+	ctx := s.SetupTest(true) // setup
+	dir := s.T().TempDir()
+
+	path := filepath.Join(dir, "oracle.json")
+	s.Require().NoError(os.WriteFile(path, []byte(`{"base_denom":"stake","exchange_rates":{"atom":"2.5"}}`), 0o600))
+	oracle, err := middleware.LoadGasPriceOracleFromFile(path)
+	s.Require().NoError(err)
+	s.Require().Equal("stake", oracle.BaseDenom())
+
+	rate, ok := oracle.ExchangeRate(ctx, "atom")
+	s.Require().True(ok)
+	s.Require().Equal(sdk.MustNewDecFromStr("2.5"), rate)
+	rate, ok = oracle.ExchangeRate(ctx, "stake")
+	s.Require().True(ok)
+	s.Require().Equal(sdk.OneDec(), rate)
+	_, ok = oracle.ExchangeRate(ctx, "foo")
+	s.Require().False(ok)
+
+	badPath := filepath.Join(dir, "bad.json")
+	s.Require().NoError(os.WriteFile(badPath, []byte(`{"base_denom":"stake","exchange_rates":{"atom":"-1"}}`), 0o600))
+	_, err = middleware.LoadGasPriceOracleFromFile(badPath)
+	s.Require().Error(err)
+}
@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"encoding/json"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GasPriceOracle converts fee denoms into a common base denom, so that a tx
+// paying its fee in several denoms can be compared against the node's minimum
+// gas prices and prioritized against other txs.
+type GasPriceOracle interface {
+	// BaseDenom returns the denom in which all the fees are valued.
+	BaseDenom() string
+	// ExchangeRate returns the value of one unit of denom expressed in units
+	// of the base denom. It returns false if the denom is not supported.
+	ExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
+
+// ExchangeRateFn returns the value of one unit of denom expressed in units of
+// the base denom, e.g. by querying a price module.
+type ExchangeRateFn func(ctx sdk.Context, denom string) (sdk.Dec, bool)
+
+var _ GasPriceOracle = gasPriceOracle{}
+
+type gasPriceOracle struct {
+	baseDenom    string
+	exchangeRate ExchangeRateFn
+}
+
+// NewGasPriceOracle returns a GasPriceOracle which values fees in baseDenom,
+// using exchangeRate to convert the other denoms. The base denom always has an
+// exchange rate of 1.
+func NewGasPriceOracle(baseDenom string, exchangeRate ExchangeRateFn) GasPriceOracle {
+	return gasPriceOracle{
+		baseDenom:    baseDenom,
+		exchangeRate: exchangeRate,
+	}
+}
+
+// NewStaticGasPriceOracle returns a GasPriceOracle which values fees in
+// baseDenom, using a fixed set of exchange rates.
+func NewStaticGasPriceOracle(baseDenom string, rates map[string]sdk.Dec) GasPriceOracle {
+	return NewGasPriceOracle(baseDenom, func(_ sdk.Context, denom string) (sdk.Dec, bool) {
+		rate, ok := rates[denom]
+		return rate, ok
+	})
+}
+
+// gasPriceOracleFile is the JSON format read by LoadGasPriceOracleFromFile.
+type gasPriceOracleFile struct {
+	BaseDenom     string            `json:"base_denom"`
+	ExchangeRates map[string]string `json:"exchange_rates"`
+}
+
+// LoadGasPriceOracleFromFile returns a static GasPriceOracle read from a local
+// JSON file of the form:
+//
+//	{"base_denom": "stake", "exchange_rates": {"atom": "2.5", "photon": "0.1"}}
+func LoadGasPriceOracleFromFile(path string) (GasPriceOracle, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file gasPriceOracleFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(file.BaseDenom); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid base denom: %s", err)
+	}
+
+	rates := make(map[string]sdk.Dec, len(file.ExchangeRates))
+	for denom, rateStr := range file.ExchangeRates {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom: %s", err)
+		}
+
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid exchange rate for %s: %s", denom, err)
+		}
+		if !rate.IsPositive() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "exchange rate for %s must be positive, got %s", denom, rate)
+		}
+
+		rates[denom] = rate
+	}
+
+	return NewStaticGasPriceOracle(file.BaseDenom, rates), nil
+}
+
+// BaseDenom implements GasPriceOracle.BaseDenom.
+func (o gasPriceOracle) BaseDenom() string {
+	return o.baseDenom
+}
+
+// ExchangeRate implements GasPriceOracle.ExchangeRate.
+func (o gasPriceOracle) ExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if denom == o.baseDenom {
+		return sdk.OneDec(), true
+	}
+
+	if o.exchangeRate == nil {
+		return sdk.Dec{}, false
+	}
+
+	rate, ok := o.exchangeRate(ctx, denom)
+	if !ok || rate.IsNil() || !rate.IsPositive() {
+		return sdk.Dec{}, false
+	}
+
+	return rate, true
+}
+
+// convertToBaseDenom returns the total value of coins in the oracle's base
+// denom. Denoms not supported by the oracle are ignored.
+func convertToBaseDenom(ctx sdk.Context, oracle GasPriceOracle, coins sdk.DecCoins) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, coin := range coins {
+		rate, ok := oracle.ExchangeRate(ctx, coin.Denom)
+		if !ok {
+			continue
+		}
+
+		total = total.Add(coin.Amount.Mul(rate))
+	}
+
+	return total
+}
@@ -44,6 +44,9 @@ type TxHandlerOptions struct {
 	FeegrantKeeper  FeegrantKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+	// GasPriceOracle, if set, values the fees in a common base denom when
+	// checking them against the minimum gas prices in CheckTx.
+	GasPriceOracle GasPriceOracle
 }
 
 // NewDefaultTxHandler defines a TxHandler middleware stacks that should work
@@ -83,7 +86,7 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		// Reject all extension options which can optionally be included in the
 		// tx.
 		RejectExtensionOptionsMiddleware,
-		MempoolFeeMiddleware,
+		NewMempoolFeeMiddleware(options.GasPriceOracle),
 		ValidateBasicMiddleware,
 		TxTimeoutHeightMiddleware,
 		ValidateMemoMiddleware(options.AccountKeeper),
@@ -94,5 +97,8 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		SigGasConsumeMiddleware(options.AccountKeeper, sigGasConsumer),
 		SigVerificationMiddleware(options.AccountKeeper, options.SignModeHandler),
 		IncrementSequenceMiddleware(options.AccountKeeper),
+		// Transfer the tip from the tipper to the fee payer. Make sure it
+		// runs after the signatures are verified.
+		NewTipMiddleware(options.BankKeeper),
 	), nil
 }
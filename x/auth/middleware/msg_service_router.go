@@ -130,6 +130,21 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// WrapHandler replaces the handler of the msgs with the given type URL by the
+// handler returned by wrap, which receives the registered handler. It lets a
+// module take over the execution of another module's msgs, and must be called
+// after the msg services have been registered.
+//
+// This function PANICs if no handler is registered for typeURL.
+func (msr *MsgServiceRouter) WrapHandler(typeURL string, wrap func(MsgServiceHandler) MsgServiceHandler) {
+	handler, found := msr.routes[typeURL]
+	if !found {
+		panic(fmt.Errorf("no msg service handler registered for %s", typeURL))
+	}
+
+	msr.routes[typeURL] = wrap(handler)
+}
+
 func noopDecoder(_ interface{}) error { return nil }
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
 	return nil, nil
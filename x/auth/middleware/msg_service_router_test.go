@@ -7,6 +7,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 )
 
@@ -53,3 +55,25 @@ func TestRegisterMsgServiceTwice(t *testing.T) {
 		)
 	})
 }
+
+func TestWrapHandler(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	msr := middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry)
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	msg := &testdata.TestMsg{}
+	require.Panics(t, func() {
+		msr.WrapHandler(sdk.MsgTypeURL(msg), func(h middleware.MsgServiceHandler) middleware.MsgServiceHandler { return h })
+	})
+
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+	createDog := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "spot"}}
+	msr.WrapHandler(sdk.MsgTypeURL(createDog), func(next middleware.MsgServiceHandler) middleware.MsgServiceHandler {
+		return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+	})
+
+	_, err := msr.Handler(createDog)(sdk.Context{}, createDog)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ tx.Handler = tipsTxHandler{}
+
+type tipsTxHandler struct {
+	bankKeeper types.BankKeeper
+	next       tx.Handler
+}
+
+// NewTipMiddleware returns a new middleware for handling meta-transactions
+// with tips. It checks that the tipper is a signer of the tx and signed with
+// SIGN_MODE_DIRECT_AUX, then transfers the tip from the tipper to the fee
+// payer. Like the fee, the tip is transferred even if the msgs fail.
+// CONTRACT: Tx must implement TipTx and SigVerifiableTx to use tipsTxHandler,
+// and the middleware must run after signature verification.
+func NewTipMiddleware(bankKeeper types.BankKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return tipsTxHandler{
+			bankKeeper: bankKeeper,
+			next:       txh,
+		}
+	}
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh tipsTxHandler) CheckTx(ctx context.Context, sdkTx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	if err := txh.transferTip(ctx, sdkTx); err != nil {
+		return abci.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, sdkTx, req)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh tipsTxHandler) DeliverTx(ctx context.Context, sdkTx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	if err := txh.transferTip(ctx, sdkTx); err != nil {
+		return abci.ResponseDeliverTx{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, sdkTx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh tipsTxHandler) SimulateTx(ctx context.Context, sdkTx sdk.Tx, req tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
+	if err := txh.transferTip(ctx, sdkTx); err != nil {
+		return tx.ResponseSimulateTx{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, sdkTx, req)
+}
+
+// transferTip validates the tip of the tx, if any, and transfers it from the
+// tipper to the fee payer.
+func (txh tipsTxHandler) transferTip(ctx context.Context, sdkTx sdk.Tx) error {
+	tipTx, ok := sdkTx.(tx.TipTx)
+	if !ok || tipTx.GetTip() == nil {
+		return nil
+	}
+
+	tip := tipTx.GetTip()
+	if err := tip.ValidateBasic(); err != nil {
+		return err
+	}
+
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return err
+	}
+
+	feePayer := tipTx.FeePayer()
+	if err := validateTipSignatures(sdkTx, tipper, feePayer); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := txh.bankKeeper.SendCoins(sdkCtx, tipper, feePayer, tip.Amount); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyTip, sdk.Coins(tip.Amount).String()),
+		sdk.NewAttribute(sdk.AttributeKeyTipper, tip.Tipper),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()),
+	))
+
+	return nil
+}
+
+// validateTipSignatures checks that the tipper is a signer of the tx and
+// signed with SIGN_MODE_DIRECT_AUX, the only sign mode in which a tipper signs
+// over the tip without signing over the fee. It also checks that the fee payer
+// did not sign with SIGN_MODE_DIRECT_AUX, as the fee would then be malleable.
+func validateTipSignatures(sdkTx sdk.Tx, tipper, feePayer sdk.AccAddress) error {
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	tipperFound := false
+	for i, signer := range sigTx.GetSigners() {
+		if i >= len(sigs) {
+			break
+		}
+
+		data, ok := sigs[i].Data.(*signing.SingleSignatureData)
+		isDirectAux := ok && data.SignMode == signing.SignMode_SIGN_MODE_DIRECT_AUX
+
+		if signer.Equals(feePayer) && isDirectAux {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "fee payer %s cannot sign with %s", feePayer, signing.SignMode_SIGN_MODE_DIRECT_AUX)
+		}
+
+		if signer.Equals(tipper) {
+			if !isDirectAux {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must sign with %s", tipper, signing.SignMode_SIGN_MODE_DIRECT_AUX)
+			}
+			tipperFound = true
+		}
+	}
+
+	if !tipperFound {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s is not a signer of the tx", tipper)
+	}
+
+	return nil
+}
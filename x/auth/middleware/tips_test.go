@@ -0,0 +1,163 @@
+package middleware_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func (s *MWTestSuite) TestTips() {
+	var (
+		tip       = sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+		feeAmount = testdata.NewTestFeeAmount()
+	)
+
+	testcases := []struct {
+		name       string
+		tip        sdk.Coins
+		tipperMode signing.SignMode
+		expErr     error
+	}{
+		{"tipper signs with DIRECT_AUX", tip, signing.SignMode_SIGN_MODE_DIRECT_AUX, nil},
+		{"tipper signs with DIRECT", tip, signing.SignMode_SIGN_MODE_DIRECT, sdkerrors.ErrUnauthorized},
+		{"tipper signs with LEGACY_AMINO_JSON", tip, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sdkerrors.ErrUnauthorized},
+		{"tipper has insufficient funds", sdk.NewCoins(sdk.NewInt64Coin("atom", 100000000)), signing.SignMode_SIGN_MODE_DIRECT_AUX, sdkerrors.ErrInsufficientFunds},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := s.SetupTest(false) // setup
+			accts := s.createTestAccounts(ctx, 2)
+			tipper, feePayer := accts[0], accts[1]
+
+			txBuilder := s.mkTipTx(ctx, tipper, feePayer, tc.tip, feeAmount)
+			if tc.tipperMode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+				// Replace the tipper's DIRECT_AUX signature with one in
+				// another sign mode.
+				sigs, err := txBuilder.GetTx().GetSignaturesV2()
+				s.Require().NoError(err)
+				sigs[0].Data = &signing.SingleSignatureData{SignMode: tc.tipperMode, Signature: []byte("sig")}
+				s.Require().NoError(txBuilder.SetSignatures(sigs...))
+			}
+
+			txHandler := middleware.ComposeMiddlewares(noopTxHandler{}, middleware.NewTipMiddleware(s.app.BankKeeper))
+			_, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), txBuilder.GetTx(), abci.RequestDeliverTx{})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			initCoins := sdk.NewInt64Coin("atom", 10000000)
+			s.Require().Equal(initCoins.Sub(tc.tip[0]), s.app.BankKeeper.GetBalance(ctx, tipper.acc.GetAddress(), "atom"))
+			s.Require().Equal(initCoins.Add(tc.tip[0]), s.app.BankKeeper.GetBalance(ctx, feePayer.acc.GetAddress(), "atom"))
+		})
+	}
+}
+
+func (s *MWTestSuite) TestTipsWithDefaultTxHandler() {
+	ctx := s.SetupTest(false) // setup
+	accts := s.createTestAccounts(ctx, 2)
+	tipper, feePayer := accts[0], accts[1]
+	tip := sdk.NewInt64Coin("atom", 1000)
+	feeAmount := testdata.NewTestFeeAmount()
+
+	txBuilder := s.mkTipTx(ctx, tipper, feePayer, sdk.NewCoins(tip), feeAmount)
+	res, err := s.txHandler.DeliverTx(sdk.WrapSDKContext(ctx), txBuilder.GetTx(), abci.RequestDeliverTx{})
+	s.Require().NoError(err)
+
+	initCoins := sdk.NewInt64Coin("atom", 10000000)
+	s.Require().Equal(initCoins.Sub(tip), s.app.BankKeeper.GetBalance(ctx, tipper.acc.GetAddress(), "atom"))
+	s.Require().Equal(initCoins.Add(tip).Sub(feeAmount[0]), s.app.BankKeeper.GetBalance(ctx, feePayer.acc.GetAddress(), "atom"))
+
+	found := false
+	for _, event := range res.Events {
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdk.AttributeKeyTipper {
+				s.Require().Equal(tipper.acc.GetAddress().String(), string(attr.Value))
+				found = true
+			}
+		}
+	}
+	s.Require().True(found, "tip event not emitted")
+}
+
+func (s *MWTestSuite) TestTipsFeePayerDirectAux() {
+	ctx := s.SetupTest(false) // setup
+	accts := s.createTestAccounts(ctx, 2)
+	tipper, feePayer := accts[0], accts[1]
+
+	txBuilder := s.mkTipTx(ctx, tipper, feePayer, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), testdata.NewTestFeeAmount())
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	s.Require().NoError(err)
+	sigs[1].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT_AUX, Signature: []byte("sig")}
+	s.Require().NoError(txBuilder.SetSignatures(sigs...))
+
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler{}, middleware.NewTipMiddleware(s.app.BankKeeper))
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), txBuilder.GetTx(), abci.RequestDeliverTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+// mkTipTx creates a tx where the tipper signs a TestMsg and a tip in
+// SIGN_MODE_DIRECT_AUX, and the fee payer then adds the fee and signs in
+// SIGN_MODE_DIRECT.
+func (s *MWTestSuite) mkTipTx(ctx sdk.Context, tipper, feePayer testAccount, tip, feeAmount sdk.Coins) client.TxBuilder {
+	tipperAddr, feePayerAddr := tipper.acc.GetAddress(), feePayer.acc.GetAddress()
+
+	// Tipper creates and signs the AuxSignerData.
+	auxBuilder := clienttx.NewAuxTxBuilder()
+	auxBuilder.SetAddress(tipperAddr.String())
+	auxBuilder.SetAccountNumber(tipper.acc.GetAccountNumber())
+	auxBuilder.SetSequence(tipper.acc.GetSequence())
+	auxBuilder.SetChainID(ctx.ChainID())
+	auxBuilder.SetMemo("tipped tx")
+	s.Require().NoError(auxBuilder.SetMsgs(testdata.NewTestMsg(tipperAddr)))
+	s.Require().NoError(auxBuilder.SetPubKey(tipper.priv.PubKey()))
+	auxBuilder.SetTip(&txtypes.Tip{Tipper: tipperAddr.String(), Amount: tip})
+	signBz, err := auxBuilder.GetSignBytes()
+	s.Require().NoError(err)
+	sig, err := tipper.priv.Sign(signBz)
+	s.Require().NoError(err)
+	auxBuilder.SetSignature(sig)
+	auxSignerData, err := auxBuilder.GetAuxSignerData()
+	s.Require().NoError(err)
+
+	// Fee payer adds the fee and signs in DIRECT mode.
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.AddAuxSignerData(auxSignerData))
+	txBuilder.SetFeePayer(feePayerAddr)
+	txBuilder.SetFeeAmount(feeAmount)
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Len(sigs, 1)
+	feePayerSig := signing.SignatureV2{
+		PubKey:   feePayer.priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: feePayer.acc.GetSequence(),
+	}
+	s.Require().NoError(txBuilder.SetSignatures(sigs[0], feePayerSig))
+
+	signerData := xauthsigning.SignerData{
+		Address:       feePayerAddr.String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: feePayer.acc.GetAccountNumber(),
+		Sequence:      feePayer.acc.GetSequence(),
+		SignerIndex:   1,
+	}
+	feePayerSig, err = clienttx.SignWithPrivKey(signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder, feePayer.priv, s.clientCtx.TxConfig, feePayer.acc.GetSequence())
+	s.Require().NoError(err)
+	s.Require().NoError(txBuilder.SetSignatures(sigs[0], feePayerSig))
+
+	return txBuilder
+}
@@ -1,9 +1,12 @@
 package tx
 
 import (
+	"bytes"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +31,10 @@ type wrapper struct {
 	authInfoBz []byte
 
 	txBodyHasUnknownNonCriticals bool
+
+	// cdc is used to decode the body signed by an auxiliary signer. It is
+	// only set on wrappers created by the TxConfig.
+	cdc codec.ProtoCodecMarshaler
 }
 
 var (
@@ -309,16 +316,91 @@ func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	return nil
 }
 
+// AddAuxSignerData adds the signature of an auxiliary signer, e.g. a tipper,
+// to the tx. If the builder has no msgs yet, the body signed by the auxiliary
+// signer is used as the tx body, otherwise both bodies must match. The tip
+// signed by the auxiliary signer is set on the tx.
+func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(w.tx.Body.Messages) == 0 {
+		if w.cdc == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrLogic, "TxBuilder has no codec to decode the aux signer's body")
+		}
+
+		var body tx.TxBody
+		if err := w.cdc.Unmarshal(data.SignDoc.BodyBytes, &body); err != nil {
+			return err
+		}
+
+		w.tx.Body = &body
+		// keep the exact bytes the aux signer signed over
+		w.bodyBz = data.SignDoc.BodyBytes
+	} else if !bytes.Equal(w.getBodyBytes(), data.SignDoc.BodyBytes) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "TxBuilder body does not match the body signed by the aux signer")
+	}
+
+	if w.tx.AuthInfo.Tip != nil && !proto.Equal(w.tx.AuthInfo.Tip, data.SignDoc.Tip) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "TxBuilder has tip %s, got %s in AuxSignerData", w.tx.AuthInfo.Tip, data.SignDoc.Tip)
+	}
+	w.SetTip(data.SignDoc.Tip)
+
+	// get the aux signer's index in GetSigners
+	signerIndex := -1
+	for i, signer := range w.GetSigners() {
+		if signer.String() == data.Address {
+			signerIndex = i
+			break
+		}
+	}
+	if signerIndex < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "address %s is not a signer", data.Address)
+	}
+
+	w.setSignerInfoAtIndex(signerIndex, &tx.SignerInfo{
+		PublicKey: data.SignDoc.PublicKey,
+		ModeInfo:  &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: data.Mode}}},
+		Sequence:  data.SignDoc.Sequence,
+	})
+	w.setSignatureAtIndex(signerIndex, data.Sig)
+
+	return nil
+}
+
 func (w *wrapper) setSignerInfos(infos []*tx.SignerInfo) {
 	w.tx.AuthInfo.SignerInfos = infos
 	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
 	w.authInfoBz = nil
 }
 
+func (w *wrapper) setSignerInfoAtIndex(index int, info *tx.SignerInfo) {
+	if n := index + 1; len(w.tx.AuthInfo.SignerInfos) < n {
+		infos := make([]*tx.SignerInfo, n)
+		copy(infos, w.tx.AuthInfo.SignerInfos)
+		w.tx.AuthInfo.SignerInfos = infos
+	}
+
+	w.tx.AuthInfo.SignerInfos[index] = info
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	w.authInfoBz = nil
+}
+
 func (w *wrapper) setSignatures(sigs [][]byte) {
 	w.tx.Signatures = sigs
 }
 
+func (w *wrapper) setSignatureAtIndex(index int, sig []byte) {
+	if n := index + 1; len(w.tx.Signatures) < n {
+		sigs := make([][]byte, n)
+		copy(sigs, w.tx.Signatures)
+		w.tx.Signatures = sigs
+	}
+
+	w.tx.Signatures[index] = sig
+}
+
 func (w *wrapper) GetTx() authsigning.Tx {
 	return w
 }
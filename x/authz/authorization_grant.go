@@ -9,8 +9,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewGrant returns new Grant
-func NewGrant(a Authorization, expiration time.Time) (Grant, error) {
+// NewGrant returns new Grant. A nil expiration creates a grant which never expires.
+func NewGrant(a Authorization, expiration *time.Time) (Grant, error) {
 	g := Grant{
 		Expiration: expiration,
 	}
@@ -51,7 +51,7 @@ func (g Grant) GetAuthorization() Authorization {
 }
 
 func (g Grant) ValidateBasic() error {
-	if g.Expiration.Unix() < time.Now().Unix() {
+	if g.Expiration != nil && g.Expiration.Unix() < time.Now().Unix() {
 		return sdkerrors.Wrap(ErrInvalidExpirationTime, "Time can't be in the past")
 	}
 
@@ -24,6 +24,25 @@ type Authorization interface {
 	ValidateBasic() error
 }
 
+// MultiMsgAuthorization is an Authorization granting permissions on several Msg types at
+// once. The keeper stores the grant under each of the Msg type URLs and keeps the copies
+// in sync, so the Msgs share the state of the authorization, e.g. a call budget.
+type MultiMsgAuthorization interface {
+	Authorization
+
+	// MsgTypeURLs returns the fully-qualified Msg service method URLs covered by the
+	// authorization. MsgTypeURL must return one of them.
+	MsgTypeURLs() []string
+}
+
+// MsgTypeURLs returns the Msg type URLs covered by the authorization.
+func MsgTypeURLs(a Authorization) []string {
+	if m, ok := a.(MultiMsgAuthorization); ok {
+		return m.MsgTypeURLs()
+	}
+	return []string{a.MsgTypeURL()}
+}
+
 // AcceptResponse instruments the controller of an authz message if the request is accepted
 // and if it should be updated or deleted.
 type AcceptResponse struct {
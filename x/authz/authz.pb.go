@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -69,18 +70,113 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// MaxCallsAuthorization gives the grantee permissions to execute the provided
+// methods on behalf of the granter's account a limited number of times. The
+// calls to all the methods share the same budget and rate limit.
+type MaxCallsAuthorization struct {
+	// msgs are the type URLs of the Msgs the grantee is allowed to execute.
+	Msgs []string `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// remaining_calls is the number of calls left, shared by all the msgs. The
+	// grant is deleted once it reaches zero. Zero means no total limit, which
+	// requires a rate_limit.
+	RemainingCalls uint64 `protobuf:"varint,2,opt,name=remaining_calls,json=remainingCalls,proto3" json:"remaining_calls,omitempty"`
+	// rate_limit, if set, limits the number of calls in a rolling time window.
+	RateLimit *RateLimit `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+}
+
+func (m *MaxCallsAuthorization) Reset()         { *m = MaxCallsAuthorization{} }
+func (m *MaxCallsAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MaxCallsAuthorization) ProtoMessage()    {}
+func (*MaxCallsAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *MaxCallsAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxCallsAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxCallsAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxCallsAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxCallsAuthorization.Merge(m, src)
+}
+func (m *MaxCallsAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxCallsAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxCallsAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxCallsAuthorization proto.InternalMessageInfo
+
+// RateLimit allows at most max_calls calls in any rolling window of the given
+// period.
+type RateLimit struct {
+	// period is the length of the rolling time window.
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	// max_calls is the maximum number of calls in any window of length period.
+	MaxCalls uint64 `protobuf:"varint,2,opt,name=max_calls,json=maxCalls,proto3" json:"max_calls,omitempty"`
+	// recent_calls are the block times of the calls made in the last period, in
+	// increasing order.
+	RecentCalls []time.Time `protobuf:"bytes,3,rep,name=recent_calls,json=recentCalls,proto3,stdtime" json:"recent_calls"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
 	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	Expiration    time.Time  `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// time when the grant will expire and will be pruned. If null, then the grant
+	// doesn't have a time expiration (other conditions in `authorization`
+	// may apply to invalidate the grant)
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -109,34 +205,138 @@ func (m *Grant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Grant proto.InternalMessageInfo
 
+// GrantAuthorization extends a grant with both the addresses of the grantee and granter.
+// It is used in genesis.proto and query.proto
+type GrantAuthorization struct {
+	Granter       string     `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// expiration is the time when the grant expires, or null if it never expires.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
+func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
+func (*GrantAuthorization) ProtoMessage()    {}
+func (*GrantAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{4}
+}
+func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantAuthorization.Merge(m, src)
+}
+func (m *GrantAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantAuthorization proto.InternalMessageInfo
+
+// GrantQueueItem contains the list of TypeURL of the sdk.Msg grants of a
+// granter-grantee pair which expire at the same time.
+type GrantQueueItem struct {
+	// msg_type_urls contains the list of TypeURL of a sdk.Msg.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
+func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
+func (*GrantQueueItem) ProtoMessage()    {}
+func (*GrantQueueItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{5}
+}
+func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantQueueItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantQueueItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantQueueItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantQueueItem.Merge(m, src)
+}
+func (m *GrantQueueItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantQueueItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantQueueItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*MaxCallsAuthorization)(nil), "cosmos.authz.v1beta1.MaxCallsAuthorization")
+	proto.RegisterType((*RateLimit)(nil), "cosmos.authz.v1beta1.RateLimit")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
+	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
+	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0x84, 0xf0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x20, 0x2a, 0xf4, 0x20, 0x62, 0x50,
-	0x15, 0x52, 0x92, 0x10, 0xd1, 0x78, 0xb0, 0x1a, 0x7d, 0xa8, 0x12, 0x30, 0x47, 0x4a, 0x3e, 0x3d,
-	0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x1f, 0xcc, 0x4b, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d, 0x2d,
-	0x2e, 0x49, 0xcc, 0x2d, 0x80, 0x2a, 0x10, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x68, 0x04, 0xb1, 0xa0,
-	0xa2, 0x92, 0xe8, 0xda, 0x12, 0xf3, 0x2a, 0x21, 0x52, 0x4a, 0xd6, 0x5c, 0x22, 0xee, 0xa9, 0x79,
-	0xa9, 0x45, 0x99, 0xc9, 0x8e, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9,
-	0x79, 0x42, 0x02, 0x5c, 0xcc, 0xb9, 0xc5, 0xe9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20,
-	0xa6, 0x95, 0xe0, 0xa9, 0x2d, 0xba, 0xbc, 0x28, 0x8a, 0x94, 0xe6, 0x30, 0x72, 0xb1, 0xba, 0x17,
-	0x25, 0xe6, 0x95, 0x08, 0xf9, 0x72, 0xf1, 0x26, 0x22, 0x4b, 0x81, 0x35, 0x72, 0x1b, 0x89, 0xe8,
-	0x41, 0x6c, 0xd6, 0x83, 0xd9, 0xac, 0xe7, 0x98, 0x57, 0xe9, 0x84, 0x69, 0x52, 0x10, 0xaa, 0x6e,
-	0x21, 0x17, 0x2e, 0xae, 0xd4, 0x8a, 0x82, 0xcc, 0x22, 0x88, 0x59, 0x4c, 0x60, 0xb3, 0xa4, 0x30,
-	0xcc, 0x0a, 0x81, 0x79, 0xde, 0x89, 0xe3, 0xc4, 0x3d, 0x79, 0x86, 0x09, 0xf7, 0xe5, 0x19, 0x83,
-	0x90, 0xf4, 0x39, 0x39, 0x9d, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x2a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xd0, 0x40,
-	0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x15, 0x90, 0x88, 0x4a, 0x62, 0x03, 0xdb, 0x66, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x46, 0xfb, 0xa1, 0x7a, 0xcd, 0x01, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x97, 0x31, 0x56, 0x97, 0x0d, 0xb0, 0x8a, 0x94, 0x0d, 0x29, 0x8d, 0x2a, 0x24, 0x7a,
+	0x59, 0xa2, 0x15, 0x4e, 0x4c, 0x42, 0x5a, 0x99, 0x34, 0x21, 0xb1, 0x03, 0x61, 0x5c, 0xb8, 0x54,
+	0x6e, 0x6b, 0x3c, 0x8b, 0x38, 0x8e, 0x6c, 0x07, 0xb5, 0xfb, 0x2b, 0xc6, 0x8d, 0x03, 0x77, 0x6e,
+	0x9c, 0xf6, 0x47, 0x4c, 0x9c, 0x26, 0x4e, 0x9c, 0xf8, 0xb1, 0xfe, 0x23, 0x28, 0xb6, 0x33, 0xd6,
+	0x75, 0x12, 0x08, 0x71, 0xca, 0xf3, 0x7b, 0xdf, 0xf7, 0xfc, 0xbd, 0xcf, 0xb1, 0x61, 0x38, 0x14,
+	0x8a, 0x0b, 0x15, 0xe3, 0x42, 0x1f, 0x1c, 0xc6, 0x6f, 0x37, 0x07, 0x44, 0xe3, 0x4d, 0xbb, 0x8a,
+	0x72, 0x29, 0xb4, 0x40, 0x4d, 0x8b, 0x88, 0x6c, 0xce, 0x21, 0xd6, 0xd7, 0x6c, 0xb6, 0x6f, 0x30,
+	0xb1, 0x83, 0x98, 0xc5, 0x7a, 0x8b, 0x0a, 0x41, 0x53, 0x12, 0x9b, 0xd5, 0xa0, 0x78, 0x1d, 0x6b,
+	0xc6, 0x89, 0xd2, 0x98, 0xe7, 0x0e, 0x10, 0x5c, 0x06, 0x8c, 0x0a, 0x89, 0x35, 0x13, 0x99, 0xab,
+	0x37, 0xa9, 0xa0, 0xc2, 0x36, 0x2e, 0x23, 0x97, 0x5d, 0xbb, 0xcc, 0xc2, 0xd9, 0xc4, 0x96, 0xda,
+	0x5b, 0xb0, 0xb9, 0x4b, 0x32, 0x22, 0xd9, 0x70, 0xbb, 0xd0, 0x07, 0x42, 0xb2, 0x43, 0xd3, 0x0e,
+	0xdd, 0x82, 0x1e, 0x57, 0xd4, 0x07, 0x21, 0xe8, 0xd4, 0x93, 0x32, 0x7c, 0x74, 0xfb, 0xf3, 0xf1,
+	0xc6, 0xca, 0x0c, 0xa8, 0xfd, 0x11, 0xc0, 0x3b, 0x7b, 0x78, 0xfc, 0x04, 0xa7, 0xa9, 0x9a, 0xa5,
+	0x23, 0xb8, 0xc8, 0x15, 0x55, 0x3e, 0x08, 0xbd, 0x4e, 0x3d, 0x31, 0x31, 0xba, 0x0f, 0x6f, 0x4a,
+	0xc2, 0x31, 0xcb, 0x58, 0x46, 0xfb, 0xc3, 0x92, 0xe3, 0x2f, 0x84, 0xa0, 0xb3, 0x98, 0xac, 0x9e,
+	0xa7, 0x4d, 0x27, 0xf4, 0x18, 0x42, 0x89, 0x35, 0xe9, 0xa7, 0x8c, 0x33, 0xed, 0x7b, 0x21, 0xe8,
+	0x34, 0xba, 0xad, 0xe8, 0x2a, 0x2f, 0xa3, 0x04, 0x6b, 0xf2, 0xac, 0x84, 0x25, 0x75, 0x59, 0x85,
+	0x57, 0x29, 0xfd, 0x04, 0x60, 0xfd, 0x1c, 0x8b, 0xb6, 0xe0, 0x52, 0x4e, 0x24, 0x13, 0x23, 0x33,
+	0x5f, 0xa3, 0xbb, 0x16, 0x59, 0x83, 0xa2, 0xca, 0xa0, 0x68, 0xc7, 0xd9, 0xda, 0x5b, 0x3e, 0xf9,
+	0xd6, 0xaa, 0xbd, 0xff, 0xde, 0x02, 0x89, 0xa3, 0xa0, 0xbb, 0xb0, 0xce, 0xf1, 0x78, 0x66, 0x80,
+	0x65, 0xee, 0x4c, 0x40, 0xbb, 0xf0, 0x86, 0x24, 0x43, 0x92, 0x69, 0x57, 0xf7, 0x42, 0xaf, 0xd3,
+	0xe8, 0xae, 0xcf, 0xf5, 0xdf, 0xaf, 0xce, 0xd5, 0x6e, 0x70, 0x54, 0x6e, 0xd0, 0xb0, 0x4c, 0xd3,
+	0xa8, 0xfd, 0x01, 0xc0, 0x6b, 0xbb, 0x12, 0x67, 0x1a, 0xed, 0xc1, 0x15, 0x7c, 0x71, 0x16, 0xa7,
+	0xb9, 0x39, 0xd7, 0x73, 0x3b, 0x9b, 0xf4, 0xe6, 0x47, 0x4f, 0x66, 0xd9, 0x68, 0x07, 0x42, 0x32,
+	0xce, 0x99, 0x1d, 0xcf, 0xe8, 0xff, 0xb3, 0x3e, 0x60, 0xf4, 0x5d, 0xe0, 0xb5, 0xdf, 0x2d, 0x40,
+	0x64, 0xe4, 0xcd, 0x1e, 0x7b, 0x17, 0x5e, 0xa7, 0x65, 0x96, 0x48, 0xfb, 0xe7, 0xf4, 0xfc, 0x2f,
+	0xc7, 0x1b, 0xd5, 0x2d, 0xd8, 0x1e, 0x8d, 0x24, 0x51, 0xea, 0x85, 0x96, 0x2c, 0xa3, 0x49, 0x05,
+	0xfc, 0xcd, 0x21, 0xfe, 0xc2, 0xdf, 0x71, 0xc8, 0xbc, 0x27, 0xde, 0x7f, 0xf4, 0x64, 0xf1, 0x1f,
+	0x3d, 0x79, 0x08, 0x57, 0x8d, 0x25, 0xcf, 0x0b, 0x52, 0x90, 0xa7, 0x9a, 0x70, 0xd4, 0x86, 0x2b,
+	0x5c, 0xd1, 0xbe, 0x9e, 0xe4, 0xa4, 0x5f, 0xc8, 0xb4, 0xba, 0x0e, 0x0d, 0xae, 0xe8, 0xfe, 0x24,
+	0x27, 0x2f, 0x65, 0xaa, 0x7a, 0xbd, 0x93, 0x9f, 0x41, 0xed, 0xe4, 0x2c, 0x00, 0xa7, 0x67, 0x01,
+	0xf8, 0x71, 0x16, 0x80, 0xa3, 0x69, 0x50, 0x3b, 0x9d, 0x06, 0xb5, 0xaf, 0xd3, 0xa0, 0xf6, 0xea,
+	0x1e, 0x65, 0xfa, 0xa0, 0x18, 0x44, 0x43, 0xc1, 0xdd, 0x4b, 0xe1, 0x3e, 0x1b, 0x6a, 0xf4, 0x26,
+	0x1e, 0xdb, 0xd7, 0x66, 0xb0, 0x64, 0x34, 0x3e, 0xf8, 0x35, 0x00, 0xcd, 0x32, 0x33, 0xf9, 0x92,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -169,6 +369,103 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxCallsAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxCallsAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxCallsAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RemainingCalls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingCalls))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Msgs[iNdEx])
+			copy(dAtA[i:], m.Msgs[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecentCalls) > 0 {
+		for iNdEx := len(m.RecentCalls) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RecentCalls[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RecentCalls[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintAuthz(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxCalls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxCalls))
+		i--
+		dAtA[i] = 0x10
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -189,75 +486,607 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
-	if err1 != nil {
-		return 0, err1
+	if m.Expiration != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
 	}
-	i -= n1
-	i = encodeVarintAuthz(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
 	if m.Authorization != nil {
 		{
 			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintAuthz(dAtA, i, uint64(size))
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantQueueItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantQueueItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantQueueItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenericAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *MaxCallsAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, s := range m.Msgs {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.RemainingCalls != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingCalls))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.MaxCalls != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxCalls))
+	}
+	if len(m.RecentCalls) > 0 {
+		for _, e := range m.RecentCalls {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantQueueItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenericAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenericAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxCallsAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxCallsAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxCallsAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCalls", wireType)
+			}
+			m.RemainingCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalls", wireType)
+			}
+			m.MaxCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentCalls = append(m.RecentCalls, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.RecentCalls[len(m.RecentCalls)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthz(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenericAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovAuthz(uint64(l))
 	}
-	return n
-}
 
-func (m *Grant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Authorization != nil {
-		l = m.Authorization.Size()
-		n += 1 + l + sovAuthz(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
-	n += 1 + l + sovAuthz(uint64(l))
-	return n
-}
-
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuthz(x uint64) (n int) {
-	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
+func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -280,17 +1109,17 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenericAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenericAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -300,23 +1129,63 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			if m.Authorization == nil {
+				m.Authorization = &types.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -339,7 +1208,7 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -362,13 +1231,77 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
 			}
@@ -404,7 +1337,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
@@ -433,9 +1366,94 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantQueueItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantQueueItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantQueueItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 	authorizationQueryCmd.AddCommand(
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
+		GetQueryGranteeGrants(),
+		GetCmdQueryExpiringGrants(),
 	)
 
 	return authorizationQueryCmd
@@ -93,17 +97,21 @@ $ %s query %s grants cosmos1skjw.. cosmos1skjwj.. %s
 	return cmd
 }
 
+// GetQueryGranterGrants implements the query granter grants command.
 func GetQueryGranterGrants() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "granter-grants [granter-addr]",
-		Args:  cobra.ExactArgs(1),
-		Short: "query authorization grants granted by granter",
+		Use:   "granter-grants [granter-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "query authorization grants granted by granter and optionally a msg-type-url",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query authorization grants granted by granter.
+			fmt.Sprintf(`Query authorization grants granted by granter. If msg-type-url
+is set, it will select grants only for that msg type.
 Examples:
 $ %s q %s granter-grants cosmos1skj..
+$ %s q %s granter-grants cosmos1skj.. %s
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -115,6 +123,10 @@ $ %s q %s granter-grants cosmos1skj..
 			if err != nil {
 				return err
 			}
+			var msgAuthorized = ""
+			if len(args) >= 2 {
+				msgAuthorized = args[1]
+			}
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
@@ -126,6 +138,7 @@ $ %s q %s granter-grants cosmos1skj..
 				cmd.Context(),
 				&authz.QueryGranterGrantsRequest{
 					Granter:    granter.String(),
+					MsgTypeUrl: msgAuthorized,
 					Pagination: pageReq,
 				},
 			)
@@ -140,3 +153,115 @@ $ %s q %s granter-grants cosmos1skj..
 	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
 	return cmd
 }
+
+// GetQueryGranteeGrants implements the query grantee grants command.
+func GetQueryGranteeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grantee-grants [grantee-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "query authorization grants held by grantee and optionally a msg-type-url",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants held by grantee. If msg-type-url
+is set, it will select grants only for that msg type.
+Examples:
+$ %s q %s grantee-grants cosmos1skj..
+$ %s q %s grantee-grants cosmos1skj.. %s
+`,
+				version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var msgAuthorized = ""
+			if len(args) >= 2 {
+				msgAuthorized = args[1]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.GranteeGrants(
+				cmd.Context(),
+				&authz.QueryGranteeGrantsRequest{
+					Grantee:    grantee.String(),
+					MsgTypeUrl: msgAuthorized,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
+	return cmd
+}
+
+// GetCmdQueryExpiringGrants implements the query expiring grants command.
+func GetCmdQueryExpiringGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-grants [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query authorization grants expiring in a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants expiring at or after start-time and before end-time,
+ordered by expiration. Times are Unix timestamps.
+Examples:
+$ %s q %s expiring-grants 1636106400 1636192800
+`,
+				version.AppName, authz.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			start, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start-time %s: %w", args[0], err)
+			}
+			end, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end-time %s: %w", args[1], err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringGrants(
+				cmd.Context(),
+				&authz.QueryExpiringGrantsRequest{
+					StartTime:  time.Unix(start, 0).UTC(),
+					EndTime:    time.Unix(end, 0).UTC(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-grants")
+	return cmd
+}
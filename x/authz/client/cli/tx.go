@@ -26,6 +26,12 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagAllowList         = "allow-list"
+	FlagMsgTypes          = "msg-types"
+	FlagMaxCalls          = "max-calls"
+	FlagRateLimitCalls    = "rate-limit-calls"
+	FlagRateLimitPeriod   = "rate-limit-period"
+	maxCalls              = "max-calls"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -53,15 +59,16 @@ func GetTxCmd() *cobra.Command {
 
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"max-calls\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
- $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --allow-list=cosmos1r2m..,cosmos1x9d.. --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. max-calls --msg-types=/cosmos.gov.v1beta1.MsgVote,/cosmos.gov.v1beta1.MsgDeposit --max-calls=10 --rate-limit-calls=2 --rate-limit-period=24h --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -97,7 +104,17 @@ Examples:
 					return fmt.Errorf("spend-limit should be greater than zero")
 				}
 
-				authorization = bank.NewSendAuthorization(spendLimit)
+				allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+				if err != nil {
+					return err
+				}
+
+				allowed, err := bech32toAccAddresses(allowList)
+				if err != nil {
+					return err
+				}
+
+				authorization = bank.NewSendAuthorization(spendLimit, allowed)
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
 				if err != nil {
@@ -105,6 +122,33 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case maxCalls:
+				msgTypes, err := cmd.Flags().GetStringSlice(FlagMsgTypes)
+				if err != nil {
+					return err
+				}
+
+				calls, err := cmd.Flags().GetUint64(FlagMaxCalls)
+				if err != nil {
+					return err
+				}
+
+				rateLimitCalls, err := cmd.Flags().GetUint64(FlagRateLimitCalls)
+				if err != nil {
+					return err
+				}
+
+				rateLimitPeriod, err := cmd.Flags().GetDuration(FlagRateLimitPeriod)
+				if err != nil {
+					return err
+				}
+
+				var rateLimit *authz.RateLimit
+				if rateLimitCalls != 0 || rateLimitPeriod != 0 {
+					rateLimit = authz.NewRateLimit(rateLimitPeriod, rateLimitCalls)
+				}
+
+				authorization = authz.NewMaxCallsAuthorization(msgTypes, calls, rateLimit)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -160,7 +204,13 @@ Examples:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
 
-			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			var expiration *time.Time
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
 			if err != nil {
 				return err
 			}
@@ -173,7 +223,12 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
-	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed recipient addresses of a Send Authorization separated by ,")
+	cmd.Flags().StringSlice(FlagMsgTypes, []string{}, "The Msg method names for which we are creating a MaxCallsAuthorization separated by ,")
+	cmd.Flags().Uint64(FlagMaxCalls, 0, "Total number of calls allowed by a MaxCallsAuthorization. Default (0) is no total limit.")
+	cmd.Flags().Uint64(FlagRateLimitCalls, 0, fmt.Sprintf("Number of calls allowed by a MaxCallsAuthorization within each rate limit period, at most %d", authz.MaxRateLimitCalls))
+	cmd.Flags().Duration(FlagRateLimitPeriod, 0, "Rolling time window of the MaxCallsAuthorization rate limit, e.g. 24h")
+	cmd.Flags().Int64(FlagExpiration, 0, "The Unix timestamp of the grant expiration. Default (0) is a grant which never expires.")
 	return cmd
 }
 
@@ -249,6 +304,18 @@ Example:
 	return cmd
 }
 
+func bech32toAccAddresses(accAddrs []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(accAddrs))
+	for i, addr := range accAddrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = accAddr
+	}
+	return addrs, nil
+}
+
 func bech32toValidatorAddresses(validators []string) ([]sdk.ValAddress, error) {
 	vals := make([]sdk.ValAddress, len(validators))
 	for i, validator := range validators {
@@ -161,7 +161,7 @@ func (s *IntegrationTestSuite) TestQueryAuthorization() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			`{"@type":"/cosmos.bank.v1beta1.SendAuthorization","spend_limit":[{"denom":"steak","amount":"100"}]}`,
+			`{"@type":"/cosmos.bank.v1beta1.SendAuthorization","spend_limit":[{"denom":"steak","amount":"100"}],"allow_list":[]}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -234,6 +234,17 @@ func (s *IntegrationTestSuite) TestQueryGranterGrants() {
 			"",
 			2,
 		},
+		{
+			"valid case with msg type filter",
+			[]string{
+				val.Address.String(),
+				typeMsgSend,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			2,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -252,3 +263,133 @@ func (s *IntegrationTestSuite) TestQueryGranterGrants() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryGranteeGrants() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[1]
+	require := s.Require()
+	sendMsgType := typeMsgSend
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedErr string
+	}{
+		{
+			"invalid address",
+			[]string{
+				"invalid-address",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"valid case",
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+		},
+		{
+			"valid case with msg type filter",
+			[]string{
+				grantee.String(),
+				sendMsgType,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetQueryGranteeGrants()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(out.String(), tc.expectedErr)
+			} else {
+				require.NoError(err)
+				var grants authz.QueryGranteeGrantsResponse
+				require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants))
+				// the send authorization granted in SetupSuite
+				require.NotEmpty(grants.Grants)
+				for _, grant := range grants.Grants {
+					require.Equal(grantee.String(), grant.Grantee)
+					if len(tc.args) == 3 {
+						require.Contains(grant.Authorization.TypeUrl, "SendAuthorization")
+					}
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryExpiringGrants() {
+	val := s.network.Validators[0]
+	require := s.Require()
+	now := time.Now()
+	oneDay := now.AddDate(0, 0, 1)
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedErr string
+	}{
+		{
+			"invalid start time",
+			[]string{
+				"yesterday",
+				fmt.Sprintf("%d", oneDay.Unix()),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"invalid start-time",
+		},
+		{
+			"end time before start time",
+			[]string{
+				fmt.Sprintf("%d", oneDay.Unix()),
+				fmt.Sprintf("%d", now.Unix()),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"start time must be before end time",
+		},
+		{
+			"valid case",
+			[]string{
+				fmt.Sprintf("%d", now.Unix()),
+				fmt.Sprintf("%d", oneDay.Unix()),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryExpiringGrants()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(err)
+				var grants authz.QueryExpiringGrantsResponse
+				require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants))
+				for _, grant := range grants.Grants {
+					require.NotNil(grant.Expiration)
+					require.True(grant.Expiration.Before(oneDay))
+				}
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"cosmos.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&MaxCallsAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
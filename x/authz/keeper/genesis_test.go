@@ -1,15 +1,19 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -41,16 +45,48 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 
 	now := suite.ctx.BlockHeader().Time
 	grant := &bank.SendAuthorization{SpendLimit: coins}
-	err := suite.keeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, grant, now.Add(time.Hour))
+	expires := now.Add(time.Hour)
+	err := suite.keeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, grant, &expires)
+	suite.Require().NoError(err)
+	generic := authz.NewGenericAuthorization(sdk.MsgTypeURL(&bank.MsgMultiSend{}))
+	err = suite.keeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, generic, nil)
 	suite.Require().NoError(err)
 	genesis := suite.keeper.ExportGenesis(suite.ctx)
 
 	// Clear keeper
 	suite.keeper.DeleteGrant(suite.ctx, granteeAddr, granterAddr, grant.MsgTypeURL())
+	suite.keeper.DeleteGrant(suite.ctx, granteeAddr, granterAddr, generic.MsgTypeURL())
 
 	suite.keeper.InitGenesis(suite.ctx, genesis)
 	newGenesis := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().Equal(genesis, newGenesis)
+
+	// the imported grant with an expiration is pruned by the expiration queue
+	ctx := suite.ctx.WithBlockTime(expires.Add(time.Second))
+	removed, err := suite.keeper.DequeueAndDeleteExpiredGrants(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, removed)
+	suite.Require().Len(suite.keeper.ExportGenesis(ctx).Authorization, 1)
+}
+
+func (suite *GenesisTestSuite) TestInitGenesisSkipsExpiredGrants() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+	any, err := codectypes.NewAnyWithValue(&bank.SendAuthorization{SpendLimit: coins})
+	suite.Require().NoError(err)
+
+	var logs bytes.Buffer
+	ctx := suite.ctx.WithLogger(log.NewTMLogger(log.NewSyncWriter(&logs)))
+
+	expired := ctx.BlockTime().Add(-time.Hour)
+	suite.keeper.InitGenesis(ctx, authz.NewGenesisState([]authz.GrantAuthorization{{
+		Granter:       granterAddr.String(),
+		Grantee:       granteeAddr.String(),
+		Authorization: any,
+		Expiration:    &expired,
+	}}))
+	suite.Require().Empty(suite.keeper.ExportGenesis(ctx).Authorization)
+	suite.Require().Contains(logs.String(), "skipped expired grants in genesis")
+	suite.Require().Contains(logs.String(), "count=1")
 }
 
 func TestGenesisTestSuite(t *testing.T) {
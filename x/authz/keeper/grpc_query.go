@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"google.golang.org/grpc/codes"
@@ -62,7 +63,7 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 		if err != nil {
 			return false, err
 		}
-		auth1 := auth.GetAuthorization()
+		auth1 := k.withCallHistory(ctx, grantee, granter, auth.GetAuthorization())
 		if accumulate {
 			msg, ok := auth1.(proto.Message)
 			if !ok {
@@ -108,12 +109,17 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 	var authorizations []*authz.Grant
 	pageRes, err := query.FilteredPaginate(authzStore, req.Pagination, func(key []byte, value []byte,
 		accumulate bool) (bool, error) {
+		grantee, msgType := splitAddressAndMsgType(key)
+		if req.MsgTypeUrl != "" && msgType != req.MsgTypeUrl {
+			return false, nil
+		}
+
 		auth, err := unmarshalAuthorization(k.cdc, value)
 		if err != nil {
 			return false, err
 		}
 
-		auth1 := auth.GetAuthorization()
+		auth1 := k.withCallHistory(ctx, grantee, granter, auth.GetAuthorization())
 		if accumulate {
 			any, err := codectypes.NewAnyWithValue(auth1)
 			if err != nil {
@@ -137,6 +143,156 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 	}, nil
 }
 
+// GranteeGrants implements the Query/GranteeGrants gRPC method.
+func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	granteeStore := prefix.NewStore(store, granteeStoreKey(grantee, nil, ""))
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.FilteredPaginate(granteeStore, req.Pagination, func(key []byte, _ []byte,
+		accumulate bool) (bool, error) {
+		granter, msgType := splitAddressAndMsgType(key)
+		if req.MsgTypeUrl != "" && msgType != req.MsgTypeUrl {
+			return false, nil
+		}
+
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+		if !found {
+			return false, status.Errorf(codes.Internal, "grantee index points to a missing grant")
+		}
+		if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			any, err := codectypes.NewAnyWithValue(k.withCallHistory(ctx, grantee, granter, grant.GetAuthorization()))
+			if err != nil {
+				return false, status.Errorf(codes.Internal, err.Error())
+			}
+
+			grants = append(grants, &authz.GrantAuthorization{
+				Granter:       granter.String(),
+				Grantee:       grantee.String(),
+				Authorization: any,
+				Expiration:    grant.Expiration,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranteeGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ExpiringGrants implements the Query/ExpiringGrants gRPC method. The grants are read from the
+// expiration queue and paginated by queue entry, so a page may contain more grants than the
+// requested limit when a granter gave several authorizations with the same expiration.
+func (k Keeper) ExpiringGrants(c context.Context, req *authz.QueryExpiringGrantsRequest) (*authz.QueryExpiringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if !req.StartTime.Before(req.EndTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "start time must be before end time")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, status.Errorf(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GrantQueuePrefix)
+	start, end := sdk.FormatTimeBytes(req.StartTime), sdk.FormatTimeBytes(req.EndTime)
+	useKey := len(pageReq.Key) != 0
+	if useKey {
+		if bytes.Compare(pageReq.Key, start) < 0 || bytes.Compare(pageReq.Key, end) >= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "pagination key is outside of the time window")
+		}
+		start = pageReq.Key
+	}
+
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+
+	var (
+		grants  []*authz.GrantAuthorization
+		count   uint64
+		nextKey []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count > pageReq.Offset+limit {
+			if nextKey == nil {
+				nextKey = append([]byte{}, iter.Key()...)
+			}
+			if !countTotal || useKey {
+				break
+			}
+			continue
+		}
+
+		_, granter, grantee, err := parseGrantQueueKey(append(GrantQueuePrefix, iter.Key()...))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(iter.Value(), &queueItem); err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		for _, typeURL := range queueItem.MsgTypeUrls {
+			grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, typeURL))
+			if !found {
+				continue
+			}
+			grants = append(grants, &authz.GrantAuthorization{
+				Granter:       granter.String(),
+				Grantee:       grantee.String(),
+				Authorization: grant.Authorization,
+				Expiration:    grant.Expiration,
+			})
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal && !useKey {
+		pageRes.Total = count
+	}
+
+	return &authz.QueryExpiringGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
 // unmarshal an authorization from a store value
 func unmarshalAuthorization(cdc codec.BinaryCodec, value []byte) (v authz.Grant, err error) {
 	err = cdc.Unmarshal(value, &v)
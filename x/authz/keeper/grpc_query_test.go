@@ -58,10 +58,10 @@ func (suite *TestSuite) TestGRPCQueryAuthorization() {
 		{
 			"Success",
 			func(require *require.Assertions) {
-				now := ctx.BlockHeader().Time
+				expiration := ctx.BlockHeader().Time.Add(time.Hour)
 				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 				expAuthorization = &banktypes.SendAuthorization{SpendLimit: newCoins}
-				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], expAuthorization, now.Add(time.Hour))
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], expAuthorization, &expiration)
 				require.NoError(err)
 				req = &authz.QueryGrantsRequest{
 					Granter:    addrs[1].String(),
@@ -129,10 +129,10 @@ func (suite *TestSuite) TestGRPCQueryAuthorizations() {
 		{
 			"Success",
 			func() {
-				now := ctx.BlockHeader().Time
+				expiration := ctx.BlockHeader().Time.Add(time.Hour)
 				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 				expAuthorization = &banktypes.SendAuthorization{SpendLimit: newCoins}
-				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], expAuthorization, now.Add(time.Hour))
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], expAuthorization, &expiration)
 				suite.Require().NoError(err)
 				req = &authz.QueryGrantsRequest{
 					Granter: addrs[1].String(),
@@ -185,10 +185,10 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 		{
 			"valid case, single authorization",
 			func() {
-				now := ctx.BlockHeader().Time
+				expiration := ctx.BlockHeader().Time.Add(time.Hour)
 				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 				authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
-				err := app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, now.Add(time.Hour))
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, &expiration)
 				require.NoError(err)
 			},
 			false,
@@ -200,10 +200,10 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 		{
 			"valid case, multiple authorization",
 			func() {
-				now := ctx.BlockHeader().Time
+				expiration := ctx.BlockHeader().Time.Add(time.Hour)
 				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 				authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
-				err := app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[0], authorization, now.Add(time.Hour))
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[0], authorization, &expiration)
 				require.NoError(err)
 			},
 			false,
@@ -224,6 +224,20 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, msg type filter",
+			func() {
+				authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, nil)
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			},
+			1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -239,3 +253,176 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
+	require := suite.Require()
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+	multiSendAuthz := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], sendAuthz, &expiration))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], multiSendAuthz, nil))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[2], sendAuthz, nil))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], sendAuthz, nil))
+
+	testCases := []struct {
+		msg      string
+		request  authz.QueryGranteeGrantsRequest
+		expError bool
+		numItems int
+	}{
+		{
+			"fail invalid grantee addr",
+			authz.QueryGranteeGrantsRequest{},
+			true,
+			0,
+		},
+		{
+			"valid case, no grants",
+			authz.QueryGranteeGrantsRequest{Grantee: addrs[2].String()},
+			false,
+			0,
+		},
+		{
+			"valid case, all grants",
+			authz.QueryGranteeGrantsRequest{Grantee: addrs[0].String()},
+			false,
+			3,
+		},
+		{
+			"valid case, msg type filter",
+			authz.QueryGranteeGrantsRequest{Grantee: addrs[0].String(), MsgTypeUrl: sendAuthz.MsgTypeURL()},
+			false,
+			2,
+		},
+		{
+			"valid case, pagination",
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			false,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.GranteeGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Len(result.Grants, tc.numItems)
+			for _, grant := range result.Grants {
+				require.Equal(tc.request.Grantee, grant.Grantee)
+				if tc.request.MsgTypeUrl != "" {
+					var a authz.Authorization
+					require.NoError(app.InterfaceRegistry().UnpackAny(grant.Authorization, &a))
+					require.Equal(tc.request.MsgTypeUrl, a.MsgTypeURL())
+				}
+			}
+		})
+	}
+
+	suite.Run("Case revoked and expired grants are not listed", func() {
+		require.NoError(app.AuthzKeeper.DeleteGrant(ctx, addrs[0], addrs[1], multiSendAuthz.MsgTypeURL()))
+		ctx := ctx.WithBlockTime(expiration.Add(time.Second))
+		res, err := app.AuthzKeeper.GranteeGrants(sdk.WrapSDKContext(ctx), &authz.QueryGranteeGrantsRequest{Grantee: addrs[0].String()})
+		require.NoError(err)
+		require.Len(res.Grants, 1)
+		require.Equal(addrs[2].String(), res.Grants[0].Granter)
+
+		_, err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 10)
+		require.NoError(err)
+		res, err = app.AuthzKeeper.GranteeGrants(sdk.WrapSDKContext(ctx), &authz.QueryGranteeGrantsRequest{Grantee: addrs[0].String()})
+		require.NoError(err)
+		require.Len(res.Grants, 1)
+	})
+}
+
+func (suite *TestSuite) TestGRPCQueryExpiringGrants() {
+	require := suite.Require()
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	now := ctx.BlockTime()
+	oneHour, twoHours, threeHours := now.Add(time.Hour), now.Add(2*time.Hour), now.Add(3*time.Hour)
+	authorization := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, &oneHour))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[0], authorization, &twoHours))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[1], authorization, &threeHours))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], authorization, nil))
+
+	testCases := []struct {
+		msg      string
+		request  authz.QueryExpiringGrantsRequest
+		expError bool
+		expected []string
+	}{
+		{
+			"fail empty time window",
+			authz.QueryExpiringGrantsRequest{StartTime: twoHours, EndTime: oneHour},
+			true,
+			nil,
+		},
+		{
+			"valid case, end time is exclusive",
+			authz.QueryExpiringGrantsRequest{StartTime: now, EndTime: threeHours},
+			false,
+			[]string{addrs[1].String(), addrs[2].String()},
+		},
+		{
+			"valid case, start time is inclusive",
+			authz.QueryExpiringGrantsRequest{StartTime: twoHours, EndTime: now.AddDate(1, 0, 0)},
+			false,
+			[]string{addrs[2].String(), addrs[2].String()},
+		},
+		{
+			"valid case, pagination",
+			authz.QueryExpiringGrantsRequest{
+				StartTime:  now,
+				EndTime:    now.AddDate(1, 0, 0),
+				Pagination: &query.PageRequest{Limit: 1, Offset: 1},
+			},
+			false,
+			[]string{addrs[2].String()},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.ExpiringGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Len(result.Grants, len(tc.expected))
+			for i, grantee := range tc.expected {
+				require.Equal(grantee, result.Grants[i].Grantee)
+				require.NotNil(result.Grants[i].Expiration)
+			}
+		})
+	}
+
+	suite.Run("Case key pagination", func() {
+		req := &authz.QueryExpiringGrantsRequest{
+			StartTime:  now,
+			EndTime:    now.AddDate(1, 0, 0),
+			Pagination: &query.PageRequest{Limit: 2},
+		}
+		result, err := queryClient.ExpiringGrants(gocontext.Background(), req)
+		require.NoError(err)
+		require.Len(result.Grants, 2)
+		require.NotNil(result.Pagination.NextKey)
+
+		req.Pagination = &query.PageRequest{Key: result.Pagination.NextKey, Limit: 2}
+		result, err = queryClient.ExpiringGrants(gocontext.Background(), req)
+		require.NoError(err)
+		require.Len(result.Grants, 1)
+		require.Equal(addrs[1].String(), result.Grants[0].Granter)
+		require.Nil(result.Pagination.NextKey)
+	})
+}
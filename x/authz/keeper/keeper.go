@@ -48,16 +48,11 @@ func (k Keeper) getGrant(ctx sdk.Context, skey []byte) (grant authz.Grant, found
 	return grant, true
 }
 
-func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, updated authz.Authorization) error {
-	skey := grantStoreKey(grantee, granter, updated.MsgTypeURL())
-	grant, found := k.getGrant(ctx, skey)
-	if !found {
-		return sdkerrors.ErrNotFound.Wrap("authorization not found")
-	}
-
+func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgTypes []string, updated authz.Authorization) error {
+	updated, calls := splitCallHistory(updated)
 	msg, ok := updated.(proto.Message)
 	if !ok {
-		sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
+		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
 	}
 
 	any, err := codectypes.NewAnyWithValue(msg)
@@ -65,12 +60,118 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 		return err
 	}
 
-	grant.Authorization = any
 	store := ctx.KVStore(k.storeKey)
-	store.Set(skey, k.cdc.MustMarshal(&grant))
+	for _, msgType := range msgTypes {
+		skey := grantStoreKey(grantee, granter, msgType)
+		grant, found := k.getGrant(ctx, skey)
+		if !found {
+			return sdkerrors.ErrNotFound.Wrap("authorization not found")
+		}
+
+		// every copy keeps its own expiration
+		grant.Authorization = any
+		store.Set(skey, k.cdc.MustMarshal(&grant))
+	}
+	k.setCallHistory(ctx, grantee, granter, updated, calls)
 	return nil
 }
 
+// splitCallHistory returns the authorization without the rate limit call history of a
+// MaxCallsAuthorization, along with the call history. The call history is stored once per
+// grant, rather than in each copy of the grant.
+func splitCallHistory(authorization authz.Authorization) (authz.Authorization, []time.Time) {
+	a, ok := authorization.(*authz.MaxCallsAuthorization)
+	if !ok || a.RateLimit == nil {
+		return authorization, nil
+	}
+
+	rateLimit := *a.RateLimit
+	rateLimit.RecentCalls = nil
+	stripped := *a
+	stripped.RateLimit = &rateLimit
+	return &stripped, a.RateLimit.RecentCalls
+}
+
+// withCallHistory returns the authorization along with the stored rate limit call history
+// of its grant.
+func (k Keeper) withCallHistory(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization) authz.Authorization {
+	a, ok := authorization.(*authz.MaxCallsAuthorization)
+	if !ok || a.RateLimit == nil {
+		return authorization
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(callHistoryStoreKey(grantee, granter, a.Msgs))
+	if bz == nil {
+		return authorization
+	}
+	var history authz.RateLimit
+	k.cdc.MustUnmarshal(bz, &history)
+
+	rateLimit := *a.RateLimit
+	rateLimit.RecentCalls = history.RecentCalls
+	hydrated := *a
+	hydrated.RateLimit = &rateLimit
+	return &hydrated
+}
+
+// setCallHistory stores the rate limit call history of the grant of the authorization, as a
+// RateLimit only holding the recent calls.
+func (k Keeper) setCallHistory(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, calls []time.Time) {
+	a, ok := authorization.(*authz.MaxCallsAuthorization)
+	if !ok || a.RateLimit == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := callHistoryStoreKey(grantee, granter, a.Msgs)
+	if len(calls) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&authz.RateLimit{RecentCalls: calls}))
+}
+
+// releaseCallHistory deletes the rate limit call history of the grant once none of its
+// copies is left.
+func (k Keeper) releaseCallHistory(ctx sdk.Context, grantee, granter sdk.AccAddress, grant authz.Grant) {
+	a, ok := grant.GetAuthorization().(*authz.MaxCallsAuthorization)
+	if !ok || a.RateLimit == nil {
+		return
+	}
+
+	for _, t := range a.Msgs {
+		other, found := k.getGrant(ctx, grantStoreKey(grantee, granter, t))
+		if found && other.Authorization.Equal(grant.Authorization) {
+			return
+		}
+	}
+	ctx.KVStore(k.storeKey).Delete(callHistoryStoreKey(grantee, granter, a.Msgs))
+}
+
+// sharedMsgTypes returns the Msg type URLs under which the authorization stored for msgType
+// is saved. For a MultiMsgAuthorization these are the copies of its Msg types still holding
+// the same authorization, copies which were revoked or overwritten by another grant are
+// excluded.
+func (k Keeper) sharedMsgTypes(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string, authorization authz.Authorization) []string {
+	msgTypes := authz.MsgTypeURLs(authorization)
+	if len(msgTypes) == 1 {
+		return []string{msgType}
+	}
+
+	grant, _ := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+	shared := []string{msgType}
+	for _, t := range msgTypes {
+		if t == msgType {
+			continue
+		}
+		other, found := k.getGrant(ctx, grantStoreKey(grantee, granter, t))
+		if found && other.Authorization.Equal(grant.Authorization) {
+			shared = append(shared, t)
+		}
+	}
+	return shared
+}
+
 // DispatchActions attempts to execute the provided messages via authorization
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
@@ -84,18 +185,24 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 
 		// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
 		if !granter.Equals(grantee) {
-			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+			msgType := sdk.MsgTypeURL(msg)
+			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, msgType)
 			if authorization == nil {
 				return nil, sdkerrors.ErrUnauthorized.Wrap("authorization not found")
 			}
+			msgTypes := k.sharedMsgTypes(ctx, grantee, granter, msgType, authorization)
 			resp, err := authorization.Accept(ctx, msg)
 			if err != nil {
 				return nil, err
 			}
 			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+				for _, t := range msgTypes {
+					if err = k.DeleteGrant(ctx, grantee, granter, t); err != nil {
+						break
+					}
+				}
 			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
+				err = k.update(ctx, grantee, granter, msgTypes, resp.Updated)
 			}
 			if err != nil {
 				return nil, err
@@ -129,24 +236,57 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 }
 
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
-// with the provided expiration time. If there is an existing authorization grant for the
-// same `sdk.Msg` type, this grant overwrites that.
-func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration time.Time) error {
+// with the provided expiration time. A nil expiration means the grant never expires. If there
+// is an existing authorization grant for the same `sdk.Msg` type, this grant overwrites that.
+// A MultiMsgAuthorization is saved under each of its `sdk.Msg` types, and the call history
+// of its rate limit, if any, is saved once.
+func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
 	store := ctx.KVStore(k.storeKey)
 
+	if expiration != nil && expiration.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(authz.ErrInvalidExpirationTime, "expiration %s is before the current block time", expiration)
+	}
+
+	authorization, calls := splitCallHistory(authorization)
+
 	grant, err := authz.NewGrant(authorization, expiration)
 	if err != nil {
 		return err
 	}
 
 	bz := k.cdc.MustMarshal(&grant)
-	skey := grantStoreKey(grantee, granter, authorization.MsgTypeURL())
-	store.Set(skey, bz)
-	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
-		MsgTypeUrl: authorization.MsgTypeURL(),
-		Granter:    granter.String(),
-		Grantee:    grantee.String(),
-	})
+	for _, msgType := range authz.MsgTypeURLs(authorization) {
+		skey := grantStoreKey(grantee, granter, msgType)
+
+		// keep the expiration queue in sync with the expiration of the stored grant
+		var oldExp *time.Time
+		oldGrant, overwritten := k.getGrant(ctx, skey)
+		if overwritten {
+			oldExp = oldGrant.Expiration
+		}
+		if oldExp != nil && (expiration == nil || !oldExp.Equal(*expiration)) {
+			k.removeFromGrantQueue(ctx, *oldExp, granter, grantee, msgType)
+		}
+		if expiration != nil && (oldExp == nil || !oldExp.Equal(*expiration)) {
+			k.insertIntoGrantQueue(ctx, *expiration, granter, grantee, msgType)
+		}
+
+		store.Set(skey, bz)
+		store.Set(granteeStoreKey(grantee, granter, msgType), []byte{0x01})
+		if overwritten {
+			k.releaseCallHistory(ctx, grantee, granter, oldGrant)
+		}
+		err = ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
+			MsgTypeUrl: msgType,
+			Granter:    granter.String(),
+			Grantee:    grantee.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	k.setCallHistory(ctx, grantee, granter, authorization, calls)
+	return nil
 }
 
 // DeleteGrant revokes any authorization for the provided message type granted to the grantee
@@ -154,11 +294,16 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
 	store := ctx.KVStore(k.storeKey)
 	skey := grantStoreKey(grantee, granter, msgType)
-	_, found := k.getGrant(ctx, skey)
+	grant, found := k.getGrant(ctx, skey)
 	if !found {
 		return sdkerrors.ErrNotFound.Wrap("authorization not found")
 	}
+	if grant.Expiration != nil {
+		k.removeFromGrantQueue(ctx, *grant.Expiration, granter, grantee, msgType)
+	}
 	store.Delete(skey)
+	store.Delete(granteeStoreKey(grantee, granter, msgType))
+	k.releaseCallHistory(ctx, grantee, granter, grant)
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
@@ -175,25 +320,26 @@ func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, grant
 	var authorization authz.Grant
 	for ; iter.Valid(); iter.Next() {
 		k.cdc.MustUnmarshal(iter.Value(), &authorization)
-		authorizations = append(authorizations, authorization.GetAuthorization())
+		authorizations = append(authorizations, k.withCallHistory(ctx, grantee, granter, authorization.GetAuthorization()))
 	}
 	return authorizations
 }
 
 // GetCleanAuthorization returns an `Authorization` and it's expiration time for
 // (grantee, granter, message name) grant. If there is no grant `nil` is returned.
+// The returned expiration is nil if the grant never expires.
 // If the grant is expired, the grant is revoked, removed from the storage, and `nil` is returned.
-func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (cap authz.Authorization, expiration time.Time) {
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (cap authz.Authorization, expiration *time.Time) {
 	grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
 	if !found {
-		return nil, time.Time{}
+		return nil, nil
 	}
-	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+	if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockHeader().Time) {
 		k.DeleteGrant(ctx, grantee, granter, msgType)
-		return nil, time.Time{}
+		return nil, nil
 	}
 
-	return grant.GetAuthorization(), grant.Expiration
+	return k.withCallHistory(ctx, grantee, granter, grant.GetAuthorization()), grant.Expiration
 }
 
 // IterateGrants iterates over all authorization grants
@@ -218,12 +364,15 @@ func (k Keeper) IterateGrants(ctx sdk.Context,
 func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 	var entries []authz.GrantAuthorization
 	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
-		exp := grant.Expiration
+		any, err := codectypes.NewAnyWithValue(k.withCallHistory(ctx, grantee, granter, grant.GetAuthorization()))
+		if err != nil {
+			panic(err)
+		}
 		entries = append(entries, authz.GrantAuthorization{
 			Granter:       granter.String(),
 			Grantee:       grantee.String(),
-			Expiration:    exp,
-			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+			Authorization: any,
 		})
 		return false
 	})
@@ -231,9 +380,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 	return authz.NewGenesisState(entries)
 }
 
-// InitGenesis new authz genesis
+// InitGenesis new authz genesis. Grants which are already expired at the genesis block
+// time are skipped, and their number is logged.
 func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
+	skipped := 0
 	for _, entry := range data.Authorization {
+		if entry.Expiration != nil && entry.Expiration.Before(ctx.BlockTime()) {
+			skipped++
+			continue
+		}
+
 		grantee, err := sdk.AccAddressFromBech32(entry.Grantee)
 		if err != nil {
 			panic(err)
@@ -252,4 +408,124 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 			panic(err)
 		}
 	}
+
+	if skipped > 0 {
+		k.Logger(ctx).Info("skipped expired grants in genesis", "count", skipped, "block_time", ctx.BlockTime())
+	}
+}
+
+// insertIntoGrantQueue adds the message type to the queue item of the (granter, grantee) pair
+// expiring at the given time.
+func (k Keeper) insertIntoGrantQueue(ctx sdk.Context, expiration time.Time, granter, grantee sdk.AccAddress, msgType string) {
+	store := ctx.KVStore(k.storeKey)
+	key := GrantQueueKey(expiration, granter, grantee)
+
+	var queueItem authz.GrantQueueItem
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshal(bz, &queueItem)
+	}
+	queueItem.MsgTypeUrls = append(queueItem.MsgTypeUrls, msgType)
+	store.Set(key, k.cdc.MustMarshal(&queueItem))
+}
+
+// removeFromGrantQueue removes the message type from the queue item of the (granter, grantee)
+// pair expiring at the given time, deleting the item once it is empty.
+func (k Keeper) removeFromGrantQueue(ctx sdk.Context, expiration time.Time, granter, grantee sdk.AccAddress, msgType string) {
+	store := ctx.KVStore(k.storeKey)
+	key := GrantQueueKey(expiration, granter, grantee)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var queueItem authz.GrantQueueItem
+	k.cdc.MustUnmarshal(bz, &queueItem)
+	for i, typeURL := range queueItem.MsgTypeUrls {
+		if typeURL == msgType {
+			queueItem.MsgTypeUrls = append(queueItem.MsgTypeUrls[:i], queueItem.MsgTypeUrls[i+1:]...)
+			break
+		}
+	}
+
+	if len(queueItem.MsgTypeUrls) == 0 {
+		store.Delete(key)
+	} else {
+		store.Set(key, k.cdc.MustMarshal(&queueItem))
+	}
+}
+
+// expiredQueueItem is a grant queue entry selected for pruning.
+type expiredQueueItem struct {
+	key              []byte
+	granter, grantee sdk.AccAddress
+	item             authz.GrantQueueItem
+	// pruned is the number of leading message types of item to delete.
+	pruned int
+}
+
+// DequeueAndDeleteExpiredGrants deletes at most limit grants whose expiration is before the
+// current block time, oldest first, and returns the number of deleted grants. Grants left over
+// once the limit is reached are pruned in subsequent calls.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) (int, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the expired entries first, the store must not be written while iterating
+	var expired []expiredQueueItem
+	removed := 0
+	iter := store.Iterator(GrantQueuePrefix, GrantQueueTimePrefix(ctx.BlockTime()))
+	for ; iter.Valid() && removed < limit; iter.Next() {
+		_, granter, grantee, err := parseGrantQueueKey(iter.Key())
+		if err != nil {
+			iter.Close()
+			return removed, err
+		}
+
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(iter.Value(), &queueItem); err != nil {
+			iter.Close()
+			return removed, err
+		}
+
+		pruned := len(queueItem.MsgTypeUrls)
+		if removed+pruned > limit {
+			pruned = limit - removed
+		}
+		expired = append(expired, expiredQueueItem{
+			key:     append([]byte{}, iter.Key()...),
+			granter: granter,
+			grantee: grantee,
+			item:    queueItem,
+			pruned:  pruned,
+		})
+		removed += pruned
+	}
+	iter.Close()
+
+	for _, e := range expired {
+		for _, typeURL := range e.item.MsgTypeUrls[:e.pruned] {
+			skey := grantStoreKey(e.grantee, e.granter, typeURL)
+			grant, found := k.getGrant(ctx, skey)
+			store.Delete(skey)
+			store.Delete(granteeStoreKey(e.grantee, e.granter, typeURL))
+			if found {
+				k.releaseCallHistory(ctx, e.grantee, e.granter, grant)
+			}
+			if err := ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
+				MsgTypeUrl: typeURL,
+				Granter:    e.granter.String(),
+				Grantee:    e.grantee.String(),
+			}); err != nil {
+				return removed, err
+			}
+		}
+
+		if e.pruned == len(e.item.MsgTypeUrls) {
+			store.Delete(e.key)
+		} else {
+			e.item.MsgTypeUrls = e.item.MsgTypeUrls[e.pruned:]
+			store.Set(e.key, k.cdc.MustMarshal(&e.item))
+		}
+	}
+
+	return removed, nil
 }
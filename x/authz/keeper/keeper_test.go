@@ -11,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -53,21 +55,23 @@ func (s *TestSuite) TestKeeper() {
 	s.T().Log("verify that no authorization returns nil")
 	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
 	s.Require().Nil(authorization)
-	s.Require().Equal(expiration, time.Time{})
+	s.Require().Nil(expiration)
 	now := s.ctx.BlockHeader().Time
 	s.Require().NotNil(now)
 
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
 	s.T().Log("verify if expired authorization is rejected")
 	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
-	err := app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, x, now.Add(-1*time.Hour))
-	s.Require().NoError(err)
+	expired := now.Add(-1 * time.Hour)
+	err := app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, x, &expired)
+	s.Require().ErrorIs(err, authz.ErrInvalidExpirationTime)
 	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
 	s.Require().Nil(authorization)
 
 	s.T().Log("verify if authorization is accepted")
 	x = &banktypes.SendAuthorization{SpendLimit: newCoins}
-	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(time.Hour))
+	oneHour := now.Add(time.Hour)
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, &oneHour)
 	s.Require().NoError(err)
 	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
 	s.Require().NotNil(authorization)
@@ -104,14 +108,15 @@ func (s *TestSuite) TestKeeperIter() {
 	s.T().Log("verify that no authorization returns nil")
 	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, "Abcd")
 	s.Require().Nil(authorization)
-	s.Require().Equal(time.Time{}, expiration)
+	s.Require().Nil(expiration)
 	now := s.ctx.BlockHeader().Time
 	s.Require().NotNil(now)
 
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
-	s.T().Log("verify if expired authorization is rejected")
+	s.T().Log("verify fetching authorization with wrong msg type fails")
 	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
-	err := app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(-1*time.Hour))
+	oneHour := now.Add(time.Hour)
+	err := app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, &oneHour)
 	s.Require().NoError(err)
 	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, "abcd")
 	s.Require().Nil(authorization)
@@ -157,7 +162,7 @@ func (s *TestSuite) TestKeeperFees() {
 
 	s.T().Log("verify dispatch executes with correct information")
 	// grant authorization
-	err = app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, now)
+	err = app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, &now)
 	s.Require().NoError(err)
 	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
 	s.Require().NotNil(authorization)
@@ -219,7 +224,7 @@ func (s *TestSuite) TestDispatchedEvents() {
 	})
 
 	// grant authorization
-	err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, now)
+	err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, &now)
 	require.NoError(err)
 	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
 	require.NotNil(authorization)
@@ -248,6 +253,189 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDequeueExpiredGrants() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr := addrs[0]
+	grantee1, grantee2 := addrs[1], addrs[2]
+	now := ctx.BlockTime()
+	oneHour, twoHours := now.Add(time.Hour), now.Add(2*time.Hour)
+
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+	genericAuthz := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee1, granterAddr, sendAuthz, &oneHour))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee1, granterAddr, genericAuthz, &oneHour))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee2, granterAddr, sendAuthz, &twoHours))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, grantee2, granterAddr, genericAuthz, nil))
+
+	countGrants := func(ctx sdk.Context) int {
+		n := 0
+		app.AuthzKeeper.IterateGrants(ctx, func(_, _ sdk.AccAddress, _ authz.Grant) bool {
+			n++
+			return false
+		})
+		return n
+	}
+
+	s.T().Log("verify nothing is pruned before the expiration")
+	removed, err := app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(oneHour), 10)
+	s.Require().NoError(err)
+	s.Require().Zero(removed)
+	s.Require().Equal(4, countGrants(ctx))
+
+	s.T().Log("verify pruning is bounded by the limit")
+	ctx = ctx.WithBlockTime(twoHours.Add(time.Second))
+	removed, err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(1, removed)
+	s.Require().Equal(3, countGrants(ctx))
+
+	removed, err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(2, removed)
+
+	s.T().Log("verify grants without expiration are kept")
+	s.Require().Equal(1, countGrants(ctx))
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, grantee2, granterAddr, genericAuthz.MsgTypeURL())
+	s.Require().NotNil(authorization)
+	s.Require().Nil(expiration)
+
+	removed, err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(now.AddDate(100, 0, 0)), 10)
+	s.Require().NoError(err)
+	s.Require().Zero(removed)
+}
+
+func (s *TestSuite) TestGrantQueueUpdates() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+	now := ctx.BlockTime()
+	oneHour, twoHours := now.Add(time.Hour), now.Add(2*time.Hour)
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+
+	s.T().Log("verify overwriting a grant moves it in the queue")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, sendAuthz, &oneHour))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, sendAuthz, &twoHours))
+	removed, err := app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(oneHour.Add(time.Second)), 10)
+	s.Require().NoError(err)
+	s.Require().Zero(removed)
+
+	s.T().Log("verify removing the expiration removes the grant from the queue")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, sendAuthz, nil))
+	removed, err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(twoHours.Add(time.Second)), 10)
+	s.Require().NoError(err)
+	s.Require().Zero(removed)
+
+	s.T().Log("verify revoking a grant removes it from the queue")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, sendAuthz, &oneHour))
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, sendAuthz.MsgTypeURL()))
+	store := ctx.KVStore(app.GetKey(authz.ModuleName))
+	s.Require().False(store.Has(keeper.GrantQueueKey(oneHour, granterAddr, granteeAddr)))
+}
+
+func (s *TestSuite) TestMaxCallsAuthorizationSharedBudget() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("steak", 2))
+	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 100))))
+
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	maxCalls := authz.NewMaxCallsAuthorization([]string{bankSendAuthMsgType, multiSendMsgType}, 2, nil)
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, maxCalls, nil))
+
+	s.T().Log("verify the grant is saved under every msg type")
+	for _, msgType := range maxCalls.MsgTypeURLs() {
+		authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, msgType)
+		s.Require().Equal(maxCalls, authorization)
+	}
+
+	send := banktypes.NewMsgSend(granterAddr, recipientAddr, coins)
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(granterAddr, coins)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(recipientAddr, coins)},
+	}
+
+	s.T().Log("verify a call through one msg type updates the budget of all of them")
+	_, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{send})
+	s.Require().NoError(err)
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, multiSendMsgType)
+	s.Require().Equal(uint64(1), authorization.(*authz.MaxCallsAuthorization).RemainingCalls)
+
+	s.T().Log("verify the last call revokes the grant for all msg types")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{multiSend})
+	s.Require().NoError(err)
+	s.Require().Empty(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr))
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{send})
+	s.Require().EqualError(err, "authorization not found: unauthorized")
+}
+
+func (s *TestSuite) TestMaxCallsAuthorizationOverwrittenCopy() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 100))))
+
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	maxCalls := authz.NewMaxCallsAuthorization([]string{bankSendAuthMsgType, multiSendMsgType}, 1, nil)
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, maxCalls, nil))
+
+	s.T().Log("verify a copy overwritten by another grant is left untouched")
+	generic := authz.NewGenericAuthorization(multiSendMsgType)
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, generic, nil))
+	send := banktypes.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 2)))
+	_, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{send})
+	s.Require().NoError(err)
+
+	authorizations := app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	s.Require().Len(authorizations, 1)
+	s.Require().Equal(generic, authorizations[0])
+}
+
+func (s *TestSuite) TestMaxCallsAuthorizationCallHistory() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr, recipientAddr := addrs[0], addrs[1], addrs[2]
+	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 100))))
+
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	maxCalls := authz.NewMaxCallsAuthorization([]string{bankSendAuthMsgType, multiSendMsgType}, 0, authz.NewRateLimit(time.Hour, 2))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, maxCalls, nil))
+
+	send := banktypes.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 2)))
+	_, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{send})
+	s.Require().NoError(err)
+
+	s.T().Log("verify the call history is stored once, outside the copies of the grant")
+	app.AuthzKeeper.IterateGrants(ctx, func(_, _ sdk.AccAddress, grant authz.Grant) bool {
+		s.Require().Empty(grant.GetAuthorization().(*authz.MaxCallsAuthorization).RateLimit.RecentCalls)
+		return false
+	})
+	for _, msgType := range maxCalls.MsgTypeURLs() {
+		authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, msgType)
+		s.Require().Equal([]time.Time{ctx.BlockTime()}, authorization.(*authz.MaxCallsAuthorization).RateLimit.RecentCalls)
+	}
+	exported := app.AuthzKeeper.ExportGenesis(ctx).Authorization
+	s.Require().Len(exported, 2)
+	for _, entry := range exported {
+		s.Require().Len(entry.Authorization.GetCachedValue().(*authz.MaxCallsAuthorization).RateLimit.RecentCalls, 1)
+	}
+
+	s.T().Log("verify the call history is deleted with the last copy of the grant")
+	store := ctx.KVStore(app.GetKey(keeper.StoreKey))
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+	s.Require().True(sdk.KVStorePrefixIterator(store, keeper.CallHistoryKey).Valid())
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, multiSendMsgType))
+	s.Require().False(sdk.KVStorePrefixIterator(store, keeper.CallHistoryKey).Valid())
+}
+
+func (s *TestSuite) TestGrantUnknownMsgType() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+
+	maxCalls := authz.NewMaxCallsAuthorization([]string{bankSendAuthMsgType, "/cosmos.unknown.v1beta1.MsgUnknown"}, 1, nil)
+	msg, err := authz.NewMsgGrant(granterAddr, granteeAddr, maxCalls, nil)
+	s.Require().NoError(err)
+	_, err = app.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidType)
+	s.Require().Empty(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr))
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
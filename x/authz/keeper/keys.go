@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -10,7 +14,10 @@ import (
 
 // Keys for store prefixes
 var (
-	GrantKey = []byte{0x01} // prefix for each key
+	GrantKey         = []byte{0x01} // prefix for each key
+	GrantQueuePrefix = []byte{0x02} // prefix for the grant expiration queue
+	GranteeKey       = []byte{0x03} // prefix for the grantee index of grants
+	CallHistoryKey   = []byte{0x04} // prefix for the rate limit call history of grants
 )
 
 // StoreKey is the store key string for authz
@@ -49,3 +56,93 @@ func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAdd
 
 	return granterAddr, granteeAddr
 }
+
+// GrantQueueKey - return grant queue store key. If a given grant doesn't have a defined
+// expiration, then it should not be used in the pruning queue.
+// Key format is:
+//
+// - 0x02<expiration><granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes>: GrantQueueItem
+func GrantQueueKey(expiration time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	exp := sdk.FormatTimeBytes(expiration)
+	granter = address.MustLengthPrefix(granter)
+	grantee = address.MustLengthPrefix(grantee)
+
+	l := 1 + len(exp) + len(granter) + len(grantee)
+	var key = make([]byte, l)
+	copy(key, GrantQueuePrefix)
+	copy(key[1:], exp)
+	copy(key[1+len(exp):], granter)
+	copy(key[1+len(exp)+len(granter):], grantee)
+	return key
+}
+
+// GrantQueueTimePrefix - return grant queue time prefix
+func GrantQueueTimePrefix(expiration time.Time) []byte {
+	return append(GrantQueuePrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// parseGrantQueueKey - split expiration, granter & grantee address from the grant queue key
+func parseGrantQueueKey(key []byte) (time.Time, sdk.AccAddress, sdk.AccAddress, error) {
+	// key is of format:
+	// 0x02<expiration><granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes>
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, 2+timeLen)
+	exp, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		return exp, nil, nil, err
+	}
+
+	granterAddrLen := int(key[1+timeLen])
+	kv.AssertKeyAtLeastLength(key, 3+timeLen+granterAddrLen)
+	granter := sdk.AccAddress(key[2+timeLen : 2+timeLen+granterAddrLen])
+
+	granteeAddrLen := int(key[2+timeLen+granterAddrLen])
+	kv.AssertKeyAtLeastLength(key, 3+timeLen+granterAddrLen+granteeAddrLen)
+	grantee := sdk.AccAddress(key[3+timeLen+granterAddrLen : 3+timeLen+granterAddrLen+granteeAddrLen])
+
+	return exp, granter, grantee, nil
+}
+
+// granteeStoreKey - return the grantee index key of a grant
+// Items are stored with the following key: values
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{0x01}
+func granteeStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	l := 1 + len(grantee) + len(granter) + len(m)
+	var key = make([]byte, l)
+	copy(key, GranteeKey)
+	copy(key[1:], grantee)
+	copy(key[1+len(grantee):], granter)
+	copy(key[l-len(m):], m)
+	return key
+}
+
+// splitAddressAndMsgType - split a length prefixed address and the msg type following it,
+// as found in grant and grantee index keys once the leading prefix and address are removed.
+func splitAddressAndMsgType(key []byte) (sdk.AccAddress, string) {
+	kv.AssertKeyAtLeastLength(key, 1)
+	addrLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, 1+addrLen)
+	return sdk.AccAddress(key[1 : 1+addrLen]), string(key[1+addrLen:])
+}
+
+// callHistoryStoreKey - return the key of the rate limit call history of a grant, shared by
+// the copies of the grant stored under each of its msg types.
+// Items are stored with the following key: values
+//
+// - 0x04<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><sha256(msgTypes)>: RateLimit
+func callHistoryStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgTypes []string) []byte {
+	h := sha256.Sum256([]byte(strings.Join(msgTypes, "\x00")))
+	granter = address.MustLengthPrefix(granter)
+	grantee = address.MustLengthPrefix(grantee)
+
+	key := make([]byte, 0, 1+len(granter)+len(grantee)+len(h))
+	key = append(key, CallHistoryKey...)
+	key = append(key, granter...)
+	key = append(key, grantee...)
+	return append(key, h[:]...)
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,3 +26,29 @@ func TestGrantkey(t *testing.T) {
 	require.Equal(granter, granter1)
 	require.Equal(grantee, grantee1)
 }
+
+func TestGrantQueueKey(t *testing.T) {
+	require := require.New(t)
+	expiration := time.Date(2021, 11, 5, 10, 30, 0, 0, time.UTC)
+	key := GrantQueueKey(expiration, granter, grantee)
+	require.True(bytes.HasPrefix(key, GrantQueueTimePrefix(expiration)))
+
+	expiration1, granter1, grantee1, err := parseGrantQueueKey(key)
+	require.NoError(err)
+	require.Equal(expiration, expiration1)
+	require.Equal(granter, granter1)
+	require.Equal(grantee, grantee1)
+
+	// keys are ordered by expiration
+	require.Equal(-1, bytes.Compare(key, GrantQueueKey(expiration.Add(time.Nanosecond), grantee, granter)))
+}
+
+func TestGranteeKey(t *testing.T) {
+	require := require.New(t)
+	key := granteeStoreKey(grantee, granter, msgType)
+	require.True(bytes.HasPrefix(key, granteeStoreKey(grantee, nil, "")))
+
+	granter1, msgType1 := splitAddressAndMsgType(key[len(granteeStoreKey(grantee, nil, "")):])
+	require.Equal(granter, granter1)
+	require.Equal(msgType, msgType1)
+}
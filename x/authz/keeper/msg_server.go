@@ -26,9 +26,10 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 	if authorization == nil {
 		return nil, sdkerrors.ErrUnpackAny.Wrap("Authorization is not present in the msg")
 	}
-	t := authorization.MsgTypeURL()
-	if k.router.HandlerByTypeURL(t) == nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s doesn't exist.", t)
+	for _, t := range authz.MsgTypeURLs(authorization) {
+		if k.router.HandlerByTypeURL(t) == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s doesn't exist.", t)
+		}
 	}
 
 	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
@@ -0,0 +1,158 @@
+package authz
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization         = &MaxCallsAuthorization{}
+	_ MultiMsgAuthorization = &MaxCallsAuthorization{}
+)
+
+// MaxRateLimitCalls is the maximum number of calls a RateLimit may allow per period, which
+// bounds the number of recent calls stored with the grant.
+const MaxRateLimitCalls uint64 = 100
+
+// NewMaxCallsAuthorization creates a new MaxCallsAuthorization object. A nil rateLimit
+// means the calls are only bounded by remainingCalls.
+func NewMaxCallsAuthorization(msgTypeURLs []string, remainingCalls uint64, rateLimit *RateLimit) *MaxCallsAuthorization {
+	return &MaxCallsAuthorization{
+		Msgs:           msgTypeURLs,
+		RemainingCalls: remainingCalls,
+		RateLimit:      rateLimit,
+	}
+}
+
+// NewRateLimit creates a new RateLimit allowing maxCalls calls in any window of the given period.
+func NewRateLimit(period time.Duration, maxCalls uint64) *RateLimit {
+	return &RateLimit{
+		Period:   period,
+		MaxCalls: maxCalls,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL. It returns the first of the
+// authorized Msg type URLs, use MsgTypeURLs to get all of them.
+func (a MaxCallsAuthorization) MsgTypeURL() string {
+	if len(a.Msgs) == 0 {
+		return ""
+	}
+	return a.Msgs[0]
+}
+
+// MsgTypeURLs implements MultiMsgAuthorization.MsgTypeURLs.
+func (a MaxCallsAuthorization) MsgTypeURLs() []string {
+	return a.Msgs
+}
+
+// Accept implements Authorization.Accept. Every accepted call is counted against
+// the remaining calls and the rate limit, and the updated authorization is returned.
+func (a MaxCallsAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if !a.allows(msgTypeURL) {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s is not authorized", msgTypeURL)
+	}
+
+	updated := MaxCallsAuthorization{
+		Msgs:           a.Msgs,
+		RemainingCalls: a.RemainingCalls,
+	}
+
+	if a.RateLimit != nil {
+		rateLimit, err := a.RateLimit.accept(ctx.BlockTime())
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+		updated.RateLimit = rateLimit
+	}
+
+	if a.RemainingCalls > 0 {
+		updated.RemainingCalls--
+		if updated.RemainingCalls == 0 {
+			return AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MaxCallsAuthorization) ValidateBasic() error {
+	if len(a.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one msg type URL is required")
+	}
+	seen := make(map[string]bool, len(a.Msgs))
+	for _, msgTypeURL := range a.Msgs {
+		if msgTypeURL == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg type URL cannot be empty")
+		}
+		if seen[msgTypeURL] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate msg type URL %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+
+	if a.RateLimit == nil {
+		if a.RemainingCalls == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "remaining calls or a rate limit is required")
+		}
+		return nil
+	}
+	return a.RateLimit.ValidateBasic()
+}
+
+func (a MaxCallsAuthorization) allows(msgTypeURL string) bool {
+	for _, m := range a.Msgs {
+		if m == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic performs a stateless validation of the RateLimit.
+func (r RateLimit) ValidateBasic() error {
+	if r.Period <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit period must be positive")
+	}
+	if r.MaxCalls == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit max calls must be positive")
+	}
+	if r.MaxCalls > MaxRateLimitCalls {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "rate limit max calls cannot be greater than %d", MaxRateLimitCalls)
+	}
+	if uint64(len(r.RecentCalls)) > r.MaxCalls {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit records more recent calls than max calls")
+	}
+	for i := 1; i < len(r.RecentCalls); i++ {
+		if r.RecentCalls[i].Before(r.RecentCalls[i-1]) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit recent calls must be in increasing order")
+		}
+	}
+	return nil
+}
+
+// accept records a call at the given time and returns the updated RateLimit, or an
+// error if max calls were already made in the window ending at that time. Only the
+// calls still in the window are kept, so the state never holds more than max calls.
+func (r RateLimit) accept(now time.Time) (*RateLimit, error) {
+	windowStart := now.Add(-r.Period)
+	recent := make([]time.Time, 0, len(r.RecentCalls)+1)
+	for _, call := range r.RecentCalls {
+		if call.After(windowStart) {
+			recent = append(recent, call)
+		}
+	}
+	if uint64(len(recent)) >= r.MaxCalls {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "rate limit of %d calls per %s exceeded", r.MaxCalls, r.Period)
+	}
+
+	return &RateLimit{
+		Period:      r.Period,
+		MaxCalls:    r.MaxCalls,
+		RecentCalls: append(recent, now),
+	}, nil
+}
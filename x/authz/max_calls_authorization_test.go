@@ -0,0 +1,99 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	sendMsgType      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	multiSendMsgType = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+func TestMaxCallsAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		msg    string
+		auth   *authz.MaxCallsAuthorization
+		expErr bool
+	}{
+		{"remaining calls", authz.NewMaxCallsAuthorization([]string{sendMsgType}, 1, nil), false},
+		{"rate limit", authz.NewMaxCallsAuthorization([]string{sendMsgType, multiSendMsgType}, 0, authz.NewRateLimit(time.Hour, 1)), false},
+		{"no msg types", authz.NewMaxCallsAuthorization(nil, 1, nil), true},
+		{"empty msg type", authz.NewMaxCallsAuthorization([]string{""}, 1, nil), true},
+		{"duplicate msg type", authz.NewMaxCallsAuthorization([]string{sendMsgType, sendMsgType}, 1, nil), true},
+		{"no limit", authz.NewMaxCallsAuthorization([]string{sendMsgType}, 0, nil), true},
+		{"zero period", authz.NewMaxCallsAuthorization([]string{sendMsgType}, 0, authz.NewRateLimit(0, 1)), true},
+		{"zero rate limit calls", authz.NewMaxCallsAuthorization([]string{sendMsgType}, 0, authz.NewRateLimit(time.Hour, 0)), true},
+		{"too many rate limit calls", authz.NewMaxCallsAuthorization([]string{sendMsgType}, 0, authz.NewRateLimit(time.Hour, authz.MaxRateLimitCalls+1)), true},
+		{
+			"too many recent calls",
+			authz.NewMaxCallsAuthorization([]string{sendMsgType}, 0, &authz.RateLimit{
+				Period:      time.Hour,
+				MaxCalls:    1,
+				RecentCalls: []time.Time{time.Unix(1, 0), time.Unix(2, 0)},
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.auth.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.msg)
+		} else {
+			require.NoError(t, err, tc.msg)
+		}
+	}
+}
+
+func TestMaxCallsAuthorizationAccept(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	addr := sdk.AccAddress("_______addr________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	t.Log("verify msg types outside of the authorization are rejected")
+	a := authz.NewMaxCallsAuthorization([]string{multiSendMsgType}, 2, nil)
+	_, err := a.Accept(ctx, send)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	t.Log("verify the remaining calls are counted down")
+	a = authz.NewMaxCallsAuthorization([]string{sendMsgType, multiSendMsgType}, 2, nil)
+	resp, err := a.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, authz.NewMaxCallsAuthorization([]string{sendMsgType, multiSendMsgType}, 1, nil), resp.Updated)
+	resp, err = resp.Updated.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify the rate limit rejects calls above its rate")
+	a = authz.NewMaxCallsAuthorization([]string{sendMsgType}, 0, authz.NewRateLimit(time.Hour, 2))
+	resp, err = a.Accept(ctx, send)
+	require.NoError(t, err)
+	resp, err = resp.Updated.Accept(ctx.WithBlockTime(now.Add(time.Minute)), send)
+	require.NoError(t, err)
+	updated := resp.Updated
+	require.Equal(t, []time.Time{now, now.Add(time.Minute)}, updated.(*authz.MaxCallsAuthorization).RateLimit.RecentCalls)
+	_, err = updated.Accept(ctx.WithBlockTime(now.Add(time.Hour-time.Second)), send)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify calls which left the window are forgotten")
+	resp, err = updated.Accept(ctx.WithBlockTime(now.Add(time.Hour)), send)
+	require.NoError(t, err)
+	require.False(t, resp.Delete)
+	require.Equal(t, []time.Time{now.Add(time.Minute), now.Add(time.Hour)}, resp.Updated.(*authz.MaxCallsAuthorization).RateLimit.RecentCalls)
+	require.NoError(t, resp.Updated.ValidateBasic())
+}
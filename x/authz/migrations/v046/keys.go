@@ -0,0 +1,63 @@
+package v046
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/internal/conv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	GrantKey         = []byte{0x01}
+	GrantQueuePrefix = []byte{0x02}
+	GranteeKey       = []byte{0x03}
+)
+
+// GrantStoreKey returns the store key of the grant given by the granter to the grantee for
+// the msgType:
+//
+// - 0x01<granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes><msgTypeBytes>
+func GrantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	key := append([]byte{}, GrantKey...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	return append(key, conv.UnsafeStrToBytes(msgType)...)
+}
+
+// GranteeStoreKey returns the grantee index key of the grant given by the granter to the
+// grantee for the msgType:
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddressBytes><granterAddressLen (1 Byte)><granterAddressBytes><msgTypeBytes>
+func GranteeStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	key := append([]byte{}, GranteeKey...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, conv.UnsafeStrToBytes(msgType)...)
+}
+
+// GrantQueueKey returns the key of the grant queue item of the (granter, grantee) pair
+// expiring at the given time:
+//
+// - 0x02<expiration><granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes>
+func GrantQueueKey(expiration time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append([]byte{}, GrantQueuePrefix...)
+	key = append(key, sdk.FormatTimeBytes(expiration)...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, address.MustLengthPrefix(grantee)...)
+}
+
+// parseGrantStoreKey splits the granter, grantee and msgType from the grant store key.
+func parseGrantStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgType string) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	granterAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+granterAddrLen)
+	granter = sdk.AccAddress(key[2 : 2+granterAddrLen])
+
+	granteeAddrLen := int(key[2+granterAddrLen])
+	kv.AssertKeyAtLeastLength(key, 3+granterAddrLen+granteeAddrLen)
+	grantee = sdk.AccAddress(key[3+granterAddrLen : 3+granterAddrLen+granteeAddrLen])
+
+	return granter, grantee, string(key[3+granterAddrLen+granteeAddrLen:])
+}
@@ -0,0 +1,82 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Delete the grants which are already expired.
+// - Add the remaining grants to the grant expiration queue.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var expired [][]byte
+	queue := make(map[string]*authz.GrantQueueItem)
+	var queueKeys []string // preserve the insertion order of the queue items
+
+	iter := sdk.KVStorePrefixIterator(store, GrantKey)
+	for ; iter.Valid(); iter.Next() {
+		var grant authz.Grant
+		if err := cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			iter.Close()
+			return err
+		}
+		if grant.Expiration == nil {
+			continue
+		}
+		if grant.Expiration.Before(ctx.BlockTime()) {
+			expired = append(expired, append([]byte{}, iter.Key()...))
+			continue
+		}
+
+		granter, grantee, msgType := parseGrantStoreKey(iter.Key())
+		key := string(GrantQueueKey(*grant.Expiration, granter, grantee))
+		item, ok := queue[key]
+		if !ok {
+			item = &authz.GrantQueueItem{}
+			queue[key] = item
+			queueKeys = append(queueKeys, key)
+		}
+		item.MsgTypeUrls = append(item.MsgTypeUrls, msgType)
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	for _, key := range queueKeys {
+		bz, err := cdc.Marshal(queue[key])
+		if err != nil {
+			return err
+		}
+		store.Set([]byte(key), bz)
+	}
+
+	return nil
+}
+
+// MigrateGranteeIndex adds the grantee index of every grant, which lets the grants held by a
+// grantee be listed without iterating over all grants.
+func MigrateGranteeIndex(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	var indexKeys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, GrantKey)
+	for ; iter.Valid(); iter.Next() {
+		granter, grantee, msgType := parseGrantStoreKey(iter.Key())
+		indexKeys = append(indexKeys, GranteeStoreKey(grantee, granter, msgType))
+	}
+	iter.Close()
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{0x01})
+	}
+
+	return nil
+}
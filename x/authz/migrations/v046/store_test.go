@@ -0,0 +1,86 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	now := time.Date(2021, 11, 5, 10, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	store := ctx.KVStore(authzKey)
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	oneHour, oneHourAgo := now.Add(time.Hour), now.Add(-time.Hour)
+
+	grants := []struct {
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expiration       time.Time
+	}{
+		{granter, grantee, sendMsgType, oneHour},
+		{granter, grantee, multiSendMsgType, oneHour},
+		{grantee, granter, sendMsgType, oneHourAgo},
+	}
+	for _, g := range grants {
+		grant, err := authz.NewGrant(authz.NewGenericAuthorization(g.msgType), &g.expiration)
+		require.NoError(t, err)
+		bz, err := encCfg.Codec.Marshal(&grant)
+		require.NoError(t, err)
+		store.Set(v046.GrantStoreKey(g.grantee, g.granter, g.msgType), bz)
+	}
+
+	require.NoError(t, v046.MigrateStore(ctx, authzKey, encCfg.Codec))
+
+	// expired grants are deleted
+	require.False(t, store.Has(v046.GrantStoreKey(granter, grantee, sendMsgType)))
+	require.False(t, store.Has(v046.GrantQueueKey(oneHourAgo, grantee, granter)))
+
+	// the other grants are queued by expiration
+	require.True(t, store.Has(v046.GrantStoreKey(grantee, granter, sendMsgType)))
+	bz := store.Get(v046.GrantQueueKey(oneHour, granter, grantee))
+	require.NotNil(t, bz)
+	var queueItem authz.GrantQueueItem
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &queueItem))
+	require.ElementsMatch(t, []string{sendMsgType, multiSendMsgType}, queueItem.MsgTypeUrls)
+}
+
+func TestMigrateGranteeIndex(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(authzKey)
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	msgTypes := []string{banktypes.SendAuthorization{}.MsgTypeURL(), sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}
+	for _, msgType := range msgTypes {
+		grant, err := authz.NewGrant(authz.NewGenericAuthorization(msgType), nil)
+		require.NoError(t, err)
+		bz, err := encCfg.Codec.Marshal(&grant)
+		require.NoError(t, err)
+		store.Set(v046.GrantStoreKey(grantee, granter, msgType), bz)
+	}
+
+	require.NoError(t, v046.MigrateGranteeIndex(ctx, authzKey))
+
+	for _, msgType := range msgTypes {
+		require.True(t, store.Has(v046.GranteeStoreKey(grantee, granter, msgType)))
+		require.False(t, store.Has(v046.GranteeStoreKey(granter, grantee, msgType)))
+	}
+}
@@ -0,0 +1,28 @@
+package authz
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+// MaxPrunedGrantsPerBlock bounds the number of expired grants deleted in a single block, so
+// that a large number of grants expiring at once cannot stall block production. Any
+// remaining expired grants are pruned in the following blocks.
+const MaxPrunedGrantsPerBlock = 200
+
+// BeginBlocker is called at the beginning of every block and prunes expired grants.
+func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(authz.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	removed, err := keeper.DequeueAndDeleteExpiredGrants(ctx, MaxPrunedGrantsPerBlock)
+	if err != nil {
+		panic(err)
+	}
+	if removed > 0 {
+		keeper.Logger(ctx).Debug("pruned expired grants", "count", removed)
+	}
+}
@@ -26,9 +26,9 @@ var (
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
 )
 
-// NewMsgGrant creates a new MsgGrant
+// NewMsgGrant creates a new MsgGrant. A nil expiration grants an authorization which never expires.
 //nolint:interfacer
-func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a Authorization, expiration time.Time) (*MsgGrant, error) {
+func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a Authorization, expiration *time.Time) (*MsgGrant, error) {
 	m := &MsgGrant{
 		Granter: granter.String(),
 		Grantee: grantee.String(),
@@ -67,20 +67,25 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 }
 
 func TestMsgGrantAuthorization(t *testing.T) {
+	now := time.Now()
+	oneMonth := now.AddDate(0, 1, 0)
+	oneDayAgo := now.AddDate(0, 0, -1)
+
 	tests := []struct {
 		title            string
 		granter, grantee sdk.AccAddress
 		authorization    authz.Authorization
-		expiration       time.Time
+		expiration       *time.Time
 		expectErr        bool
 		expectPass       bool
 	}{
-		{"nil granter address", nil, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
-		{"nil grantee address", granter, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
-		{"nil granter and grantee address", nil, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
-		{"nil authorization", granter, grantee, nil, time.Now(), true, false},
-		{"valid test case", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, true},
-		{"past time", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 0, -1), false, false},
+		{"nil granter address", nil, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, &now, false, false},
+		{"nil grantee address", granter, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, &now, false, false},
+		{"nil granter and grantee address", nil, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, &now, false, false},
+		{"nil authorization", granter, grantee, nil, &now, true, false},
+		{"valid test case", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, &oneMonth, false, true},
+		{"no expiration", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, nil, false, true},
+		{"past time", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, &oneDayAgo, false, false},
 	}
 	for i, tc := range tests {
 		msg, err := authz.NewMsgGrant(
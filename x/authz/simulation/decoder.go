@@ -20,6 +20,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], keeper.GrantQueuePrefix):
+			var queueItemA, queueItemB authz.GrantQueueItem
+			cdc.MustUnmarshal(kvA.Value, &queueItemA)
+			cdc.MustUnmarshal(kvB.Value, &queueItemB)
+			return fmt.Sprintf("%v\n%v", queueItemA, queueItemB)
+		case bytes.Equal(kvA.Key[:1], keeper.GranteeKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid authz key %X", kvA.Key))
 		}
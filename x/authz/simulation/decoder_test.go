@@ -20,12 +20,18 @@ func TestDecodeStore(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	dec := simulation.NewDecodeStore(cdc)
 
-	grant, _ := authz.NewGrant(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 123))), time.Now().UTC())
+	now := time.Now().UTC()
+	grant, _ := authz.NewGrant(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 123)), nil), &now)
 	grantBz, err := cdc.Marshal(&grant)
 	require.NoError(t, err)
+	queueItem := authz.GrantQueueItem{MsgTypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}}
+	queueItemBz, err := cdc.Marshal(&queueItem)
+	require.NoError(t, err)
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(keeper.GrantKey), Value: grantBz},
+			{Key: keeper.GrantQueueKey(now, sdk.AccAddress("granter"), sdk.AccAddress("grantee")), Value: queueItemBz},
+			{Key: keeper.GranteeKey, Value: []byte{0x01}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -36,6 +42,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", false, fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GrantQueueItem", false, fmt.Sprintf("%v\n%v", queueItem, queueItem)},
+		{"GranteeIndex", false, fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
 		{"other", true, ""},
 	}
 
@@ -3,6 +3,7 @@ package simulation
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -111,7 +112,11 @@ func SimulateMsgGrant(ak authz.AccountKeeper, bk authz.BankKeeper, _ keeper.Keep
 			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, "spend limit is nil"), nil, nil
 		}
 
-		expiration := ctx.BlockTime().AddDate(1, 0, 0)
+		var expiration *time.Time
+		if r.Intn(2) == 0 {
+			t := ctx.BlockTime().AddDate(1, 0, 0)
+			expiration = &t
+		}
 		msg, err := authz.NewMsgGrant(granter.Address, grantee.Address, generateRandomAuthorization(r, spendLimit), expiration)
 		if err != nil {
 			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, err.Error()), nil, err
@@ -141,7 +146,7 @@ func SimulateMsgGrant(ak authz.AccountKeeper, bk authz.BankKeeper, _ keeper.Keep
 
 func generateRandomAuthorization(r *rand.Rand, spendLimit sdk.Coins) authz.Authorization {
 	authorizations := make([]authz.Authorization, 2)
-	authorizations[0] = banktype.NewSendAuthorization(spendLimit)
+	authorizations[0] = banktype.NewSendAuthorization(spendLimit, nil)
 	authorizations[1] = authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktype.MsgSend{}))
 
 	return authorizations[r.Intn(len(authorizations))]
@@ -236,10 +241,15 @@ func SimulateMsgExec(ak authz.AccountKeeper, bk authz.BankKeeper, k keeper.Keepe
 			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgRevoke, "Account not found"), nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "granter account not found")
 		}
 
-		if targetGrant.Expiration.Before(ctx.BlockHeader().Time) {
+		if targetGrant.Expiration != nil && targetGrant.Expiration.Before(ctx.BlockHeader().Time) {
 			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgExec, "grant expired"), nil, nil
 		}
 
+		// the generated MsgExec only wraps a MsgSend
+		if a := targetGrant.GetAuthorization(); a == nil || a.MsgTypeURL() != sdk.MsgTypeURL(&banktype.MsgSend{}) {
+			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgExec, "grant is not for MsgSend"), nil, nil
+		}
+
 		coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(int64(simtypes.RandIntBetween(r, 100, 1000000)))))
 
 		// Check send_enabled status of each sent coin denom
@@ -132,9 +132,10 @@ func (suite *SimTestSuite) TestSimulateRevoke() {
 
 	granter := accounts[0]
 	grantee := accounts[1]
-	authorization := banktypes.NewSendAuthorization(initCoins)
+	authorization := banktypes.NewSendAuthorization(initCoins, nil)
 
-	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee.Address, granter.Address, authorization, time.Now().Add(30*time.Hour))
+	expiration := time.Now().Add(30 * time.Hour)
+	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee.Address, granter.Address, authorization, &expiration)
 	suite.Require().NoError(err)
 
 	// execute operation
@@ -167,9 +168,10 @@ func (suite *SimTestSuite) TestSimulateExec() {
 
 	granter := accounts[0]
 	grantee := accounts[1]
-	authorization := banktypes.NewSendAuthorization(initCoins)
+	authorization := banktypes.NewSendAuthorization(initCoins, nil)
 
-	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee.Address, granter.Address, authorization, time.Now().Add(30*time.Hour))
+	expiration := time.Now().Add(30 * time.Hour)
+	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee.Address, granter.Address, authorization, &expiration)
 	suite.Require().NoError(err)
 
 	// execute operation
@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom   = "denom"
+	FlagAddress = "address"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -33,6 +34,9 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQueryFrozen(),
+		GetCmdQueryDenomsFromCreator(),
+		GetCmdQueryBalanceHistory(),
 	)
 
 	return cmd
@@ -212,3 +216,172 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdQueryFrozen defines the cobra command to query the accounts frozen for
+// a denomination.
+func GetCmdQueryFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen [denom]",
+		Short: "Query the accounts frozen for a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts which cannot send a coin denomination.
+
+Example:
+  $ %s query %s frozen [denom]
+
+To query whether a specific account is frozen for the denomination use:
+  $ %s query %s frozen [denom] --address=[address]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			if address == "" {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.FrozenAddresses(ctx, &types.QueryFrozenAddressesRequest{Denom: args[0], Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Frozen(ctx, &types.QueryFrozenRequest{Address: address, Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "The specific account to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen accounts")
+
+	return cmd
+}
+
+// GetCmdQueryDenomsFromCreator returns a CLI command handler for querying the
+// factory denoms created by an account.
+func GetCmdQueryDenomsFromCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms-from-creator [creator]",
+		Short: "Query the factory denoms created by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the factory/{creator}/{subdenom} denoms created by an account.
+
+Example:
+  $ %s query %s denoms-from-creator [creator]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomsFromCreator(cmd.Context(), &types.QueryDenomsFromCreatorRequest{Creator: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms")
+
+	return cmd
+}
+
+// GetCmdQueryBalanceHistory returns a CLI command handler for querying the
+// balance changes of an account recorded by the balance history index.
+func GetCmdQueryBalanceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-history [address]",
+		Short: "Query the balance changes of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance changes of an account, ordered by denom and height. The
+balance changes are only indexed by the nodes started with the --x-bank-balance-history flag.
+
+Example:
+  $ %s query %s balance-history [address]
+  $ %s query %s balance-history [address] --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BalanceHistory(cmd.Context(), &types.QueryBalanceHistoryRequest{
+				Address:    args[0],
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific denomination to query the balance changes for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "balance changes")
+
+	return cmd
+}
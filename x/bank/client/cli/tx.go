@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+// FlagMintTo is the flag of the recipient of minted factory coins.
+const FlagMintTo = "mint-to"
+
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -20,7 +25,16 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewSendTxCmd())
+	txCmd.AddCommand(
+		NewSendTxCmd(),
+		NewFreezeTxCmd(),
+		NewUnfreezeTxCmd(),
+		NewSetSendEnabledTxCmd(),
+		NewCreateDenomTxCmd(),
+		NewMintTxCmd(),
+		NewBurnTxCmd(),
+		NewChangeAdminTxCmd(),
+	)
 
 	return txCmd
 }
@@ -58,3 +72,218 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// NewFreezeTxCmd returns a CLI command handler for creating a MsgFreeze transaction.
+func NewFreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "freeze [admin_key_or_address] [address] [denom]",
+		Short: `Prevent an account from sending a denom. Note, the'--from' flag is
+ignored as it is implied from [admin_key_or_address].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreeze(clientCtx.GetFromAddress(), addr, args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnfreezeTxCmd returns a CLI command handler for creating a MsgUnfreeze transaction.
+func NewUnfreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unfreeze [admin_key_or_address] [address] [denom]",
+		Short: `Allow a frozen account to send a denom again. Note, the'--from' flag is
+ignored as it is implied from [admin_key_or_address].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreeze(clientCtx.GetFromAddress(), addr, args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetSendEnabledTxCmd returns a CLI command handler for creating a
+// MsgSetSendEnabled transaction.
+func NewSetSendEnabledTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-send-enabled [admin_key_or_address] [denom] [true|false]",
+		Short: `Enable or disable the sends of a denom. Note, the'--from' flag is
+ignored as it is implied from [admin_key_or_address].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSendEnabled(clientCtx.GetFromAddress(), args[1], enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateDenomTxCmd returns a CLI command handler for creating a
+// MsgCreateDenom transaction.
+func NewCreateDenomTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-denom [sender_key_or_address] [subdenom]",
+		Short: `Create the factory/{sender}/{subdenom} denom, paying the denom creation fee.
+Note, the'--from' flag is ignored as it is implied from [sender_key_or_address].`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress(), args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintTxCmd returns a CLI command handler for creating a MsgMint transaction.
+func NewMintTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "mint [admin_key_or_address] [amount]",
+		Short: `Mint coins of a factory denom, to the admin or to the --mint-to address.
+Note, the'--from' flag is ignored as it is implied from [admin_key_or_address].`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			var mintTo sdk.AccAddress
+			mintToStr, err := cmd.Flags().GetString(FlagMintTo)
+			if err != nil {
+				return err
+			}
+			if mintToStr != "" {
+				mintTo, err = sdk.AccAddressFromBech32(mintToStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), coin, mintTo)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintTo, "", "The address to mint the coins to, defaults to the admin")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "burn [admin_key_or_address] [amount]",
+		Short: `Burn coins of a factory denom from the admin balance. Note, the'--from'
+flag is ignored as it is implied from [admin_key_or_address].`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), coin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeAdminTxCmd returns a CLI command handler for creating a
+// MsgChangeAdmin transaction.
+func NewChangeAdminTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "change-admin [admin_key_or_address] [denom] [new_admin]",
+		Short: `Transfer the admin role of a denom. Note, the'--from' flag is ignored as
+it is implied from [admin_key_or_address].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress(), args[1], newAdmin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+const frozenAddr = "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
@@ -75,6 +78,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.FrozenAddresses = []types.FrozenAddress{
+		{Address: frozenAddr, Denom: "uatom"},
+	}
+
 	bankGenesisBz, err := s.cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -362,6 +369,22 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryBalanceHistory() {
+	val := s.network.Validators[0]
+
+	_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryBalanceHistory(), []string{"foo"})
+	s.Require().Error(err)
+
+	// the balance history index is not enabled on the validators of the network
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryBalanceHistory(), []string{
+		val.Address.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, s.cfg.BondDenom),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "balance history is not enabled")
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	val := s.network.Validators[0]
 
@@ -471,6 +494,252 @@ func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryFrozen() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{
+			name: "frozen addresses",
+			args: []string{
+				"uatom",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			respType: &types.QueryFrozenAddressesResponse{},
+			expected: &types.QueryFrozenAddressesResponse{
+				Addresses:  []string{frozenAddr},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name: "frozen address",
+			args: []string{
+				"uatom",
+				fmt.Sprintf("--%s=%s", cli.FlagAddress, frozenAddr),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			respType: &types.QueryFrozenResponse{},
+			expected: &types.QueryFrozenResponse{Frozen: true},
+		},
+		{
+			name: "not frozen address",
+			args: []string{
+				"wei",
+				fmt.Sprintf("--%s=%s", cli.FlagAddress, frozenAddr),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			respType: &types.QueryFrozenResponse{},
+			expected: &types.QueryFrozenResponse{Frozen: false},
+		},
+		{
+			name: "invalid address",
+			args: []string{
+				"uatom",
+				fmt.Sprintf("--%s=foo", cli.FlagAddress),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFrozen()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected, tc.respType)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewFreezeTxCmd() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"invalid address",
+			cli.NewFreezeTxCmd(),
+			[]string{val.Address.String(), "foo", "uatom"},
+			true, 0,
+		},
+		{
+			"freeze without denom admin",
+			cli.NewFreezeTxCmd(),
+			[]string{val.Address.String(), frozenAddr, "uatom"},
+			false, sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			"unfreeze without denom metadata",
+			cli.NewUnfreezeTxCmd(),
+			[]string{val.Address.String(), frozenAddr, "foo"},
+			false, types.ErrDenomMetadataNotFound.ABCICode(),
+		},
+		{
+			"invalid send enabled flag",
+			cli.NewSetSendEnabledTxCmd(),
+			[]string{val.Address.String(), "uatom", "foo"},
+			true, 0,
+		},
+		{
+			"set send enabled without denom admin",
+			cli.NewSetSendEnabledTxCmd(),
+			[]string{val.Address.String(), "uatom", "false"},
+			false, sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, append(tc.args, commonArgs...))
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var txResp sdk.TxResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewFactoryTxCmd() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	denom := fmt.Sprintf("factory/%s/bitcoin", val.Address)
+
+	// the steps run in order, the coins minted are all burned so that the
+	// total supply is left unchanged for the other tests.
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"create denom",
+			cli.NewCreateDenomTxCmd(),
+			[]string{val.Address.String(), "bitcoin"},
+			false, 0,
+		},
+		{
+			"create existing denom",
+			cli.NewCreateDenomTxCmd(),
+			[]string{val.Address.String(), "bitcoin"},
+			false, types.ErrDenomExists.ABCICode(),
+		},
+		{
+			"invalid mint amount",
+			cli.NewMintTxCmd(),
+			[]string{val.Address.String(), "foo"},
+			true, 0,
+		},
+		{
+			"mint non factory denom",
+			cli.NewMintTxCmd(),
+			[]string{val.Address.String(), "100uatom"},
+			false, types.ErrInvalidFactoryDenom.ABCICode(),
+		},
+		{
+			"mint",
+			cli.NewMintTxCmd(),
+			[]string{val.Address.String(), "100" + denom},
+			false, 0,
+		},
+		{
+			"burn",
+			cli.NewBurnTxCmd(),
+			[]string{val.Address.String(), "100" + denom},
+			false, 0,
+		},
+		{
+			"invalid new admin",
+			cli.NewChangeAdminTxCmd(),
+			[]string{val.Address.String(), denom, "foo"},
+			true, 0,
+		},
+		{
+			"change admin",
+			cli.NewChangeAdminTxCmd(),
+			[]string{val.Address.String(), denom, frozenAddr},
+			false, 0,
+		},
+		{
+			"mint without denom admin",
+			cli.NewMintTxCmd(),
+			[]string{val.Address.String(), "100" + denom, fmt.Sprintf("--%s=%s", cli.FlagMintTo, frozenAddr)},
+			false, sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, append(tc.args, commonArgs...))
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var txResp sdk.TxResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryDenomsFromCreator(), []string{
+		val.Address.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var res types.QueryDenomsFromCreatorResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Equal([]string{denom}, res.Denoms)
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryDenomsFromCreator(), []string{"foo"})
+	s.Require().Error(err)
+}
+
 func NewCoin(denom string, amount sdk.Int) *sdk.Coin {
 	coin := sdk.NewCoin(denom, amount)
 	return &coin
@@ -0,0 +1,379 @@
+package history
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	_ baseapp.StreamingService = &Index{}
+	_ types.BalanceHistory     = &Index{}
+
+	// ChangesPrefix is the prefix of the balance changes in the index database.
+	ChangesPrefix = []byte{0x01}
+)
+
+// Index is a node-local index of every balance change, built from the writes
+// to the bank store and the ABCI messages streamed by the BaseApp.
+//
+// The values streamed by the WriteListener are not used, as the writes of
+// CheckTx and simulations are streamed as well. The index only records which
+// balances were written, and reads the balances from the DeliverTx state once
+// the ABCI message has been processed. Only the writes made by the messages
+// of a tx are streamed before its ABCI message, the other writes are streamed
+// at commit. The balances they change are taken from the coin_spent and
+// coin_received events instead, and from the fees and tips of the tx, which
+// are paid even if the tx fails and emits no events.
+type Index struct {
+	db        dbm.DB
+	cdc       codec.BinaryCodec
+	txDecoder sdk.TxDecoder
+	storeKey  storetypes.StoreKey
+	cms       storetypes.CommitMultiStore
+	vk        keeper.ViewKeeper
+
+	touchedLock *sync.Mutex
+	touched     map[string]struct{} // balance store keys written since the last ABCI message
+
+	height    int64
+	committed sdk.Context        // context on the state of the last committed block
+	balances  map[string]sdk.Int // balances changed in the block, by balance store key
+	changes   []pendingChange    // changes of the block, written at commit
+}
+
+type pendingChange struct {
+	addr   sdk.AccAddress
+	change types.BalanceChange
+}
+
+// NewIndex creates a new Index storing the balance changes in db. The
+// balances are read with vk from the store of storeKey, and cms is the root
+// multistore of the app.
+func NewIndex(
+	db dbm.DB, cdc codec.BinaryCodec, txDecoder sdk.TxDecoder, storeKey storetypes.StoreKey,
+	cms storetypes.CommitMultiStore, vk keeper.ViewKeeper,
+) *Index {
+	return &Index{
+		db:          db,
+		cdc:         cdc,
+		txDecoder:   txDecoder,
+		storeKey:    storeKey,
+		cms:         cms,
+		vk:          vk,
+		touchedLock: new(sync.Mutex),
+		touched:     make(map[string]struct{}),
+	}
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+func (idx *Index) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		idx.storeKey: {idx},
+	}
+}
+
+// OnWrite satisfies the storetypes.WriteListener interface. It records the
+// balance store keys which are written.
+func (idx *Index) OnWrite(_ storetypes.StoreKey, key []byte, _ []byte, _ bool) error {
+	if !bytes.HasPrefix(key, types.BalancesPrefix) {
+		return nil
+	}
+
+	idx.touchedLock.Lock()
+	defer idx.touchedLock.Unlock()
+
+	idx.touched[string(key)] = struct{}{}
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface. It records
+// the balance changes made by BeginBlock. At the initial height, the genesis
+// balances are recorded as well.
+func (idx *Index) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	idx.height = req.Header.Height
+	idx.committed = sdk.NewContext(idx.cms.CacheMultiStore(), req.Header, false, log.NewNopLogger())
+	idx.balances = make(map[string]sdk.Int)
+	idx.changes = nil
+
+	keys := idx.takeTouched()
+	if err := addEventKeys(keys, res.Events); err != nil {
+		return err
+	}
+
+	// the genesis state is not committed until the end of the initial block
+	if idx.cms.LastCommitID().Version == 0 {
+		idx.vk.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+			keys[string(balanceKey(addr, coin.Denom))] = struct{}{}
+			return false
+		})
+	}
+
+	return idx.record(ctx, keys, "")
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface. It records
+// the balance changes made by the tx, including the fees of failed txs.
+func (idx *Index) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	keys := idx.takeTouched()
+	if err := addEventKeys(keys, res.Events); err != nil {
+		return err
+	}
+
+	// a tx which cannot be decoded is rejected before paying any fee
+	if tx, err := idx.txDecoder(req.Tx); err == nil {
+		addTxKeys(keys, tx)
+	}
+
+	return idx.record(ctx, keys, fmt.Sprintf("%X", tmhash.Sum(req.Tx)))
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface. It records the
+// balance changes made by EndBlock.
+func (idx *Index) ListenEndBlock(ctx sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	keys := idx.takeTouched()
+	if err := addEventKeys(keys, res.Events); err != nil {
+		return err
+	}
+
+	return idx.record(ctx, keys, "")
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface. It writes the
+// balance changes of the block to the index database. The keys of the
+// changes only depend on the block, so a replayed block overwrites them.
+func (idx *Index) ListenCommit(_ sdk.Context) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for i, pending := range idx.changes {
+		key := changeKey(pending.addr, pending.change.Denom, pending.change.Height, uint64(i))
+		if err := batch.Set(key, idx.cdc.MustMarshal(&pending.change)); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	idx.changes = nil
+	return nil
+}
+
+// Stream satisfies the baseapp.StreamingService interface. The index is
+// written synchronously by the ABCI listening hooks, so it is a no-op.
+func (idx *Index) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close satisfies the io.Closer interface, closing the index database.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+// GetBalanceChanges implements the types.BalanceHistory interface.
+func (idx *Index) GetBalanceChanges(
+	addr sdk.AccAddress, denom string, pagination *query.PageRequest,
+) ([]types.BalanceChange, *query.PageResponse, error) {
+	changesPrefix := CreateAccountChangesPrefix(addr)
+	if denom != "" {
+		changesPrefix = CreateDenomChangesPrefix(addr, denom)
+	}
+
+	store := prefix.NewStore(dbadapter.Store{DB: idx.db}, changesPrefix)
+
+	var changes []types.BalanceChange
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var change types.BalanceChange
+		if err := idx.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changes, pageRes, nil
+}
+
+// takeTouched returns the balance store keys written since the last call and
+// resets them.
+func (idx *Index) takeTouched() map[string]struct{} {
+	idx.touchedLock.Lock()
+	defer idx.touchedLock.Unlock()
+
+	keys := idx.touched
+	idx.touched = make(map[string]struct{})
+	return keys
+}
+
+// record compares the balances of keys in ctx with their previous balances in
+// the block, and records a change for each balance which differs.
+func (idx *Index) record(ctx sdk.Context, keys map[string]struct{}, txHash string) error {
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		addr, denom, err := types.AddressAndDenomFromBalancesStore([]byte(key)[len(types.BalancesPrefix):])
+		if err != nil {
+			return err
+		}
+
+		balance := idx.vk.GetBalance(ctx, addr, denom).Amount
+		previous, ok := idx.balances[key]
+		if !ok {
+			previous = idx.vk.GetBalance(idx.committed, addr, denom).Amount
+		}
+
+		if balance.Equal(previous) {
+			continue
+		}
+
+		idx.balances[key] = balance
+		idx.changes = append(idx.changes, pendingChange{
+			addr: addr,
+			change: types.BalanceChange{
+				Address: addr.String(),
+				Denom:   denom,
+				Height:  idx.height,
+				Delta:   balance.Sub(previous),
+				Balance: balance,
+				TxHash:  txHash,
+			},
+		})
+	}
+
+	return nil
+}
+
+// addEventKeys adds to keys the balance store keys of the spenders and
+// receivers of the coin_spent and coin_received events.
+func addEventKeys(keys map[string]struct{}, events []abci.Event) error {
+	for _, event := range events {
+		var addrKey string
+		switch event.Type {
+		case types.EventTypeCoinSpent:
+			addrKey = types.AttributeKeySpender
+		case types.EventTypeCoinReceived:
+			addrKey = types.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		var (
+			addr  sdk.AccAddress
+			coins sdk.Coins
+			err   error
+		)
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case addrKey:
+				addr, err = sdk.AccAddressFromBech32(string(attr.Value))
+			case sdk.AttributeKeyAmount:
+				coins, err = sdk.ParseCoinsNormalized(string(attr.Value))
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if addr.Empty() {
+			continue
+		}
+
+		for _, coin := range coins {
+			keys[string(balanceKey(addr, coin.Denom))] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// addTxKeys adds to keys the balance store keys of the accounts paying and
+// receiving the fees and tips of tx.
+func addTxKeys(keys map[string]struct{}, tx sdk.Tx) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return
+	}
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	for _, addr := range []sdk.AccAddress{feeTx.FeePayer(), feeTx.FeeGranter(), feeCollector} {
+		if addr.Empty() {
+			continue
+		}
+
+		for _, coin := range feeTx.GetFee() {
+			keys[string(balanceKey(addr, coin.Denom))] = struct{}{}
+		}
+	}
+
+	tipTx, ok := tx.(txtypes.TipTx)
+	if !ok || tipTx.GetTip() == nil {
+		return
+	}
+
+	tipper, err := sdk.AccAddressFromBech32(tipTx.GetTip().Tipper)
+	if err != nil {
+		return
+	}
+
+	for _, coin := range tipTx.GetTip().Amount {
+		keys[string(balanceKey(tipper, coin.Denom))] = struct{}{}
+		keys[string(balanceKey(feeTx.FeePayer(), coin.Denom))] = struct{}{}
+	}
+}
+
+// balanceKey returns the bank store key of the balance of addr for denom.
+func balanceKey(addr sdk.AccAddress, denom string) []byte {
+	return append(types.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+}
+
+// CreateAccountChangesPrefix creates the prefix of the balance changes of an
+// account in the index database.
+func CreateAccountChangesPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, ChangesPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// CreateDenomChangesPrefix creates the prefix of the balance changes of an
+// account for a denom in the index database. The denom is terminated by a
+// zero byte, so that the prefix does not match the longer denoms starting with
+// denom.
+func CreateDenomChangesPrefix(addr sdk.AccAddress, denom string) []byte {
+	key := append(CreateAccountChangesPrefix(addr), []byte(denom)...)
+	return append(key, 0)
+}
+
+// changeKey returns the index database key of the seq-th balance change of
+// the block at height.
+func changeKey(addr sdk.AccAddress, denom string, height int64, seq uint64) []byte {
+	key := append(CreateDenomChangesPrefix(addr, denom), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sdk.Uint64ToBigEndian(seq)...)
+}
@@ -0,0 +1,126 @@
+package history_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/history"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	priv1 = secp256k1.GenPrivKey()
+	addr1 = sdk.AccAddress(priv1.PubKey().Address())
+	addr2 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+)
+
+func TestIndex(t *testing.T) {
+	acc := &authtypes.BaseAccount{Address: addr1.String()}
+	balance := types.Balance{
+		Address: addr1.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+	}
+
+	app := simapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{acc}, balance)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	txCfg := simapp.MakeTestEncodingConfig().TxConfig
+	index := history.NewIndex(
+		dbm.NewMemDB(), app.AppCodec(), txCfg.TxDecoder(), app.GetKey(types.StoreKey), app.CommitMultiStore(), app.BankKeeper,
+	)
+	app.SetStreamingService(index)
+
+	accNum := app.AccountKeeper.GetAccount(app.NewContext(true, tmproto.Header{}), addr1).GetAccountNumber()
+	genTx := func(amount int64, seq uint64) []byte {
+		tx, err := helpers.GenTx(
+			txCfg,
+			[]sdk.Msg{types.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", amount)))},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+			helpers.DefaultGenTxGas,
+			"",
+			[]uint64{accNum},
+			[]uint64{seq},
+			priv1,
+		)
+		require.NoError(t, err)
+		txBytes, err := txCfg.TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// the second tx fails, but its fee is still paid
+	height := app.LastBlockHeight() + 1
+	tx1, tx2 := genTx(30, 0), genTx(1000, 1)
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: tx1}).IsOK())
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: tx2}).IsOK())
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the writes of CheckTx must not be recorded
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: genTx(10, 2)}).IsOK())
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height + 1}})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	hash1, hash2 := fmt.Sprintf("%X", tmhash.Sum(tx1)), fmt.Sprintf("%X", tmhash.Sum(tx2))
+	newChange := func(addr sdk.AccAddress, denom string, delta, balance int64, txHash string) types.BalanceChange {
+		return types.BalanceChange{
+			Address: addr.String(),
+			Denom:   denom,
+			Height:  height,
+			Delta:   sdk.NewInt(delta),
+			Balance: sdk.NewInt(balance),
+			TxHash:  txHash,
+		}
+	}
+
+	changes, _, err := index.GetBalanceChanges(addr1, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.BalanceChange{
+		newChange(addr1, "foocoin", -30, 70, hash1),
+		newChange(addr1, sdk.DefaultBondDenom, -1, 9, hash1),
+		newChange(addr1, sdk.DefaultBondDenom, -1, 8, hash2),
+	}, changes)
+
+	changes, pageRes, err := index.GetBalanceChanges(addr1, sdk.DefaultBondDenom, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.BalanceChange{newChange(addr1, sdk.DefaultBondDenom, -1, 9, hash1)}, changes)
+	require.Equal(t, uint64(2), pageRes.Total)
+	require.NotNil(t, pageRes.NextKey)
+
+	changes, _, err = index.GetBalanceChanges(addr1, "stak", nil)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	changes, _, err = index.GetBalanceChanges(addr2, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.BalanceChange{newChange(addr2, "foocoin", 30, 30, hash1)}, changes)
+
+	// the fees are distributed by the BeginBlock of the next block
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	changes, _, err = index.GetBalanceChanges(feeCollector, sdk.DefaultBondDenom, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.BalanceChange{
+		newChange(feeCollector, sdk.DefaultBondDenom, 1, 1, hash1),
+		newChange(feeCollector, sdk.DefaultBondDenom, 1, 2, hash2),
+	}, changes[:2])
+	require.Len(t, changes, 3)
+	require.Equal(t, height+1, changes[2].Height)
+	require.Equal(t, int64(-2), changes[2].Delta.Int64())
+	require.True(t, changes[2].Balance.IsZero())
+	require.Empty(t, changes[2].TxHash)
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom, charging the
+// creator the DenomCreationFee param, and sets its metadata with the creator
+// as admin. It returns the new denom.
+func (k BaseKeeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetFactoryDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if k.HasDenomMetaData(ctx, denom) || k.HasSupply(ctx, denom) {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	fee := k.GetParams(ctx).DenomCreationFee
+	if !fee.IsZero() {
+		if err := k.SendCoinsFromAccountToModule(ctx, creator, authtypes.FeeCollectorName, fee); err != nil {
+			return "", err
+		}
+	}
+
+	k.SetDenomMetaData(ctx, types.NewFactoryDenomMetadata(denom, subdenom, creator))
+
+	return denom, nil
+}
+
+// MintFactoryCoins mints coin, which must be of a factory denom, to the given
+// recipient. Only the admin of the denom is allowed to mint.
+func (k BaseKeeper) MintFactoryCoins(ctx sdk.Context, admin, recipientAddr sdk.AccAddress, coin sdk.Coin) error {
+	if err := k.assertFactoryDenomAdmin(ctx, coin.Denom, admin); err != nil {
+		return err
+	}
+
+	if k.BlockedAddr(recipientAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+
+	amounts := sdk.NewCoins(coin)
+	if err := k.addCoins(ctx, recipientAddr, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, coin.Denom)
+	k.setSupply(ctx, supply.Add(coin))
+
+	// Create account if recipient does not exist.
+	if !k.ak.HasAccount(ctx, recipientAddr) {
+		defer telemetry.IncrCounter(1, "new", "account")
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, recipientAddr))
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("minted factory coins", "amount", amounts.String(), "to", recipientAddr.String())
+
+	ctx.EventManager().EmitEvent(
+		types.NewCoinMintEvent(recipientAddr, amounts),
+	)
+
+	return nil
+}
+
+// BurnFactoryCoins burns coin, which must be of a factory denom, from the
+// balance of its admin.
+func (k BaseKeeper) BurnFactoryCoins(ctx sdk.Context, admin sdk.AccAddress, coin sdk.Coin) error {
+	if err := k.assertFactoryDenomAdmin(ctx, coin.Denom, admin); err != nil {
+		return err
+	}
+
+	amounts := sdk.NewCoins(coin)
+	if err := k.subUnlockedCoins(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, coin.Denom)
+	k.setSupply(ctx, supply.Sub(coin))
+
+	logger := k.Logger(ctx)
+	logger.Info("burned factory coins", "amount", amounts.String(), "from", admin.String())
+
+	ctx.EventManager().EmitEvent(
+		types.NewCoinBurnEvent(admin, amounts),
+	)
+
+	return nil
+}
+
+// ChangeDenomAdmin transfers the admin role of denom from admin to newAdmin.
+func (k BaseKeeper) ChangeDenomAdmin(ctx sdk.Context, admin, newAdmin sdk.AccAddress, denom string) error {
+	metadata, found := k.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", denom)
+	}
+
+	if metadata.Admin == "" || metadata.Admin != admin.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	metadata.Admin = newAdmin.String()
+	k.SetDenomMetaData(ctx, metadata)
+
+	return nil
+}
+
+// assertFactoryDenomAdmin returns an error if denom is not a factory denom or
+// if admin is not the admin set in its metadata.
+func (k BaseKeeper) assertFactoryDenomAdmin(ctx sdk.Context, denom string, admin sdk.AccAddress) error {
+	if _, _, err := types.DeconstructFactoryDenom(denom); err != nil {
+		return err
+	}
+
+	metadata, found := k.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", denom)
+	}
+
+	if metadata.Admin != admin.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	return nil
+}
@@ -39,6 +39,15 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, frozen := range genState.FrozenAddresses {
+		addr, err := sdk.AccAddressFromBech32(frozen.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		k.FreezeAddress(ctx, addr, frozen.Denom)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -48,10 +57,13 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+	genState.FrozenAddresses = k.GetAllFrozenAddresses(ctx)
+
+	return genState
 }
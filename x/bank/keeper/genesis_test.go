@@ -70,6 +70,25 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *IntegrationTestSuite) TestGenesisFrozenAddresses() {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	frozen := []types.FrozenAddress{
+		{Address: addr1.String(), Denom: "bar"},
+		{Address: addr2.String(), Denom: "bar"},
+		{Address: addr1.String(), Denom: "foo"},
+	}
+
+	g := types.DefaultGenesisState()
+	g.FrozenAddresses = frozen
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().True(bk.IsFrozen(suite.ctx, addr1, "foo"))
+	suite.Require().False(bk.IsFrozen(suite.ctx, addr2, "foo"))
+	suite.Require().ElementsMatch(frozen, bk.ExportGenesis(suite.ctx).FrozenAddresses)
+}
+
 func (suite *IntegrationTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
@@ -210,3 +210,116 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// Frozen implements the Query/Frozen gRPC method
+func (k BaseKeeper) Frozen(goCtx context.Context, req *types.QueryFrozenRequest) (*types.QueryFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryFrozenResponse{Frozen: k.IsFrozen(ctx, address, req.Denom)}, nil
+}
+
+// FrozenAddresses implements the Query/FrozenAddresses gRPC method
+func (k BaseKeeper) FrozenAddresses(goCtx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	frozenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateFrozenDenomPrefix(req.Denom))
+
+	var addresses []string
+	pageRes, err := query.Paginate(frozenStore, req.Pagination, func(key []byte, _ []byte) error {
+		address, err := types.AddressFromFrozenStore(key)
+		if err != nil {
+			return err
+		}
+
+		addresses = append(addresses, address.String())
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// DenomsFromCreator implements Query/DenomsFromCreator gRPC method.
+func (k BaseKeeper) DenomsFromCreator(goCtx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	factoryPrefix := []byte(types.FactoryDenomPrefix + "/" + creator.String() + "/")
+	metadataStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataPrefix)
+	denomStore := prefix.NewStore(metadataStore, factoryPrefix)
+
+	var denoms []string
+	pageRes, err := query.Paginate(denomStore, req.Pagination, func(key []byte, _ []byte) error {
+		denoms = append(denoms, string(factoryPrefix)+string(key))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+// BalanceHistory implements Query/BalanceHistory gRPC method.
+func (k BaseKeeper) BalanceHistory(_ context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.history.index == nil {
+		return nil, status.Error(codes.Unavailable, "balance history is not enabled on this node")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	changes, pageRes, err := k.history.index.GetBalanceChanges(address, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBalanceHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
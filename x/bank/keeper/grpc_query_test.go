@@ -4,6 +4,9 @@ import (
 	gocontext "context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -400,3 +403,129 @@ func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 
 	suite.Require().True(true)
 }
+
+func (suite *IntegrationTestSuite) TestGRPCFrozen() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	app.BankKeeper.FreezeAddress(ctx, addr1, fooDenom)
+	app.BankKeeper.FreezeAddress(ctx, addr2, fooDenom)
+
+	_, err := suite.queryClient.Frozen(gocontext.Background(), &types.QueryFrozenRequest{})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.Frozen(gocontext.Background(), &types.QueryFrozenRequest{Address: addr1.String()})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.Frozen(gocontext.Background(), &types.QueryFrozenRequest{Address: addr1.String(), Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Frozen)
+
+	res, err = suite.queryClient.Frozen(gocontext.Background(), &types.QueryFrozenRequest{Address: addr1.String(), Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Frozen)
+
+	_, err = suite.queryClient.FrozenAddresses(gocontext.Background(), &types.QueryFrozenAddressesRequest{})
+	suite.Require().Error(err)
+
+	addrsRes, err := suite.queryClient.FrozenAddresses(gocontext.Background(), &types.QueryFrozenAddressesRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(addrsRes.Addresses, 1)
+	suite.Require().Equal(uint64(2), addrsRes.Pagination.Total)
+	suite.Require().NotNil(addrsRes.Pagination.NextKey)
+
+	addrsRes, err = suite.queryClient.FrozenAddresses(gocontext.Background(), &types.QueryFrozenAddressesRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(addrsRes.Addresses)
+}
+
+func (suite *IntegrationTestSuite) TestGRPCDenomsFromCreator() {
+	app, ctx := suite.app, suite.ctx
+	_, _, creator := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	for _, subdenom := range []string{"bitcoin", "ether"} {
+		_, err := app.BankKeeper.CreateDenom(ctx, creator, subdenom)
+		suite.Require().NoError(err)
+	}
+	_, err := app.BankKeeper.CreateDenom(ctx, other, "bitcoin")
+	suite.Require().NoError(err)
+
+	_, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator:    creator.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"factory/" + creator.String() + "/bitcoin"}, res.Denoms)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator:    creator.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"factory/" + creator.String() + "/ether"}, res.Denoms)
+
+	res, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Denoms)
+}
+
+// mockBalanceHistory returns the changes of a single account.
+type mockBalanceHistory struct {
+	addr    sdk.AccAddress
+	changes []types.BalanceChange
+}
+
+func (m mockBalanceHistory) GetBalanceChanges(addr sdk.AccAddress, denom string, _ *query.PageRequest) ([]types.BalanceChange, *query.PageResponse, error) {
+	var changes []types.BalanceChange
+	for _, change := range m.changes {
+		if addr.Equals(m.addr) && (denom == "" || change.Denom == denom) {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, &query.PageResponse{Total: uint64(len(changes))}, nil
+}
+
+func (suite *IntegrationTestSuite) TestGRPCBalanceHistory() {
+	app := suite.app
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+
+	_, err := suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: addr.String()})
+	suite.Require().Equal(codes.Unavailable, status.Code(err))
+
+	changes := []types.BalanceChange{
+		{Address: addr.String(), Denom: "bar", Height: 2, Delta: sdk.NewInt(10), Balance: sdk.NewInt(10), TxHash: "AB"},
+		{Address: addr.String(), Denom: "foo", Height: 1, Delta: sdk.NewInt(5), Balance: sdk.NewInt(5)},
+		{Address: addr.String(), Denom: "foo", Height: 3, Delta: sdk.NewInt(-2), Balance: sdk.NewInt(3), TxHash: "CD"},
+	}
+	app.BankKeeper.SetBalanceHistory(mockBalanceHistory{addr: addr, changes: changes})
+
+	_, err = suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: addr.String(), Denom: "0foo"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	res, err := suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes, res.Changes)
+
+	res, err = suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: addr.String(), Denom: "foo"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes[1:], res.Changes)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: other.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Changes)
+}
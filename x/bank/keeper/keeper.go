@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -42,6 +43,13 @@ type Keeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 
+	CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	MintFactoryCoins(ctx sdk.Context, admin, recipientAddr sdk.AccAddress, coin sdk.Coin) error
+	BurnFactoryCoins(ctx sdk.Context, admin sdk.AccAddress, coin sdk.Coin) error
+	ChangeDenomAdmin(ctx sdk.Context, admin, newAdmin sdk.AccAddress, denom string) error
+
+	SetBalanceHistory(index types.BalanceHistory)
+
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -56,6 +64,15 @@ type BaseKeeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
 	paramSpace paramtypes.Subspace
+
+	history *balanceHistoryHolder
+}
+
+// balanceHistoryHolder houses the optional balance history index. It is kept
+// behind a pointer so that the index can be set after the keeper has been
+// copied into the module.
+type balanceHistoryHolder struct {
+	index types.BalanceHistory
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -108,7 +125,18 @@ func NewBaseKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
+		history:        &balanceHistoryHolder{},
+	}
+}
+
+// SetBalanceHistory sets the node-local balance history index served by the
+// BalanceHistory query.
+func (k BaseKeeper) SetBalanceHistory(index types.BalanceHistory) {
+	if k.history.index != nil {
+		panic("cannot set balance history twice")
 	}
+
+	k.history.index = index
 }
 
 // DelegateCoins performs delegation by deducting amt coins from an account with
@@ -126,6 +154,10 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.checkNotFrozen(ctx, delegatorAddr, amt); err != nil {
+		return err
+	}
+
 	balances := sdk.NewCoins()
 
 	for _, coin := range amt {
@@ -174,6 +206,10 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.checkNotFrozen(ctx, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt)
 	if err != nil {
 		return err
@@ -247,6 +283,33 @@ func (k BaseKeeper) HasDenomMetaData(ctx sdk.Context, denom string) bool {
 	return store.Has([]byte(denom))
 }
 
+// GetAllFrozenAddresses returns the accounts frozen for every denom.
+func (k BaseKeeper) GetAllFrozenAddresses(ctx sdk.Context) []types.FrozenAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	frozen := make([]types.FrozenAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the null terminated denom followed by the length prefixed address
+		key := iterator.Key()
+		sep := bytes.IndexByte(key, 0)
+		if sep < 0 {
+			panic(types.ErrInvalidKey)
+		}
+
+		addr, err := types.AddressFromFrozenStore(key[sep+1:])
+		if err != nil {
+			panic(err)
+		}
+
+		frozen = append(frozen, types.FrozenAddress{Address: addr.String(), Denom: string(key[:sep])})
+	}
+
+	return frozen
+}
+
 // GetAllDenomMetaData retrieves all denominations metadata
 func (k BaseKeeper) GetAllDenomMetaData(ctx sdk.Context) []types.Metadata {
 	denomMetaData := make([]types.Metadata, 0)
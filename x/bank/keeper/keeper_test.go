@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -458,6 +460,432 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	// reject bar transfers and redirect transfers to addr2 to addr3
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if !amt.AmountOf(barDenom).IsZero() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s transfers are restricted", barDenom)
+		}
+		return toAddr, nil
+	})
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	inputs = []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newBarCoin(20))}}
+	outputs = []types.Output{{Address: addr3.String(), Coins: sdk.NewCoins(newBarCoin(20))}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+
+	// module to account transfers are restricted too
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(5), newBarCoin(5))))
+	suite.Require().ErrorIs(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sdk.NewCoins(newBarCoin(5))), sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sdk.NewCoins(newFooCoin(5))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(35)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// transfers cannot be redirected to a blocked address
+	app.BankKeeper.ClearSendRestriction()
+	blockedAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blockedAddr))
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return blockedAddr, nil
+	})
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestSendRestrictionMultiSend() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	addr4 := sdk.AccAddress("addr4_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	// record the transfers seen by the restriction, and redirect the ones of
+	// addr2 to addr4
+	var seen []string
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		seen = append(seen, fromAddr.String()+"->"+toAddr.String()+":"+amt.String())
+		if fromAddr.Equals(addr2) {
+			return addr4, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	inputs := []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newBarCoin(10))},
+	}
+	outputs := []types.Output{{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10), newBarCoin(10))}}
+
+	// the restriction runs once per (input, output) pair, for the coins the
+	// input sends, and the pairs cannot redirect an output to different recipients
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrInvalidRequest)
+	suite.Require().Equal([]string{
+		addr1.String() + "->" + addr3.String() + ":" + sdk.NewCoins(newFooCoin(10)).String(),
+		addr2.String() + "->" + addr3.String() + ":" + sdk.NewCoins(newBarCoin(10)).String(),
+	}, seen)
+
+	seen = nil
+	inputs[0].Address = addr2.String()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Len(seen, 2)
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr4))
+}
+
+func (suite *IntegrationTestSuite) TestMultiSendHooksAmounts() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	addr4 := sdk.AccAddress("addr4_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, sdk.NewCoins(newFooCoin(100), newBarCoin(30))))
+
+	var restricted sdk.Coins
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		restricted = restricted.Add(amt...)
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	hooks := &mockBankHooks{}
+	app.BankKeeper.SetHooks(hooks)
+
+	inputs := []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(100))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(100), newBarCoin(30))},
+	}
+	outputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(60), newBarCoin(10))},
+		{Address: addr4.String(), Coins: sdk.NewCoins(newFooCoin(140), newBarCoin(20))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	// the restriction and hooks see exactly the coins which are moved
+	moved := sdk.NewCoins(newFooCoin(200), newBarCoin(30))
+	suite.Require().Equal(moved, restricted)
+	transfer := func(from, to sdk.AccAddress, amt sdk.Coins) string {
+		return from.String() + "->" + to.String() + ":" + amt.String()
+	}
+	expected := []string{
+		transfer(addr1, addr3, sdk.NewCoins(newFooCoin(60))),
+		transfer(addr2, addr3, sdk.NewCoins(newBarCoin(10))),
+		transfer(addr1, addr4, sdk.NewCoins(newFooCoin(40))),
+		transfer(addr2, addr4, sdk.NewCoins(newFooCoin(100), newBarCoin(20))),
+	}
+	suite.Require().Equal(expected, hooks.before)
+	suite.Require().Equal(expected, hooks.after)
+}
+
+type mockBankHooks struct {
+	before, after []string
+	beforeErr     error
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if h.beforeErr != nil {
+		return h.beforeErr
+	}
+	h.before = append(h.before, fromAddr.String()+"->"+toAddr.String()+":"+amt.String())
+	return nil
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	h.after = append(h.after, fromAddr.String()+"->"+toAddr.String()+":"+amt.String())
+	return nil
+}
+
+func (suite *IntegrationTestSuite) TestBankHooks() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	// the hooks are shared with the copies of the keeper held by other modules
+	bankKeeper := app.BankKeeper
+	hooks := &mockBankHooks{}
+	app.BankKeeper.SetHooks(hooks)
+	suite.Require().Panics(func() { app.BankKeeper.SetHooks(hooks) })
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	transfer := addr1.String() + "->" + addr2.String() + ":" + sendAmt.String()
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Equal([]string{transfer}, hooks.before)
+	suite.Require().Equal([]string{transfer}, hooks.after)
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sendAmt}}
+	outputs := []types.Output{{Address: addr2.String(), Coins: sendAmt}}
+	suite.Require().NoError(bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]string{transfer, transfer}, hooks.before)
+	suite.Require().Equal([]string{transfer, transfer}, hooks.after)
+
+	hooks.beforeErr = sdkerrors.ErrUnauthorized
+	suite.Require().ErrorIs(bankKeeper.SendCoins(ctx, addr1, addr2, sendAmt), sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorIs(bankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Len(hooks.after, 2)
+}
+
+func (suite *IntegrationTestSuite) TestFrozenAddresses() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+
+	app.BankKeeper.FreezeAddress(ctx, addr1, fooDenom)
+	suite.Require().True(app.BankKeeper.IsFrozen(ctx, addr1, fooDenom))
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, addr1, barDenom))
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, addr2, fooDenom))
+
+	// the frozen denom cannot be sent, the other ones can
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs := []types.Output{{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrFrozen)
+	suite.Require().ErrorIs(app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10))), types.ErrFrozen)
+
+	// frozen accounts can still receive the denom
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr1, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(110), newBarCoin(40)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	app.BankKeeper.FreezeAddress(ctx, addr2, fooDenom)
+	var frozen []sdk.AccAddress
+	app.BankKeeper.IterateFrozenAddresses(ctx, fooDenom, func(addr sdk.AccAddress) bool {
+		frozen = append(frozen, addr)
+		return false
+	})
+	suite.Require().ElementsMatch([]sdk.AccAddress{addr1, addr2}, frozen)
+
+	app.BankKeeper.UnfreezeAddress(ctx, addr1, fooDenom)
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, addr1, fooDenom))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *IntegrationTestSuite) TestDelegateFrozenCoins() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addrModule := sdk.AccAddress("moduleAcc___________")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrModule))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	// a frozen account cannot delegate the frozen denom, the other ones can
+	app.BankKeeper.FreezeAddress(ctx, addr1, fooDenom)
+	err := app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(newBarCoin(10))))
+	app.BankKeeper.UnfreezeAddress(ctx, addr1, fooDenom)
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(newFooCoin(10))))
+
+	// frozen accounts can still receive undelegated coins, but a frozen module
+	// account cannot undelegate the frozen denom
+	app.BankKeeper.FreezeAddress(ctx, addr1, fooDenom)
+	suite.Require().NoError(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, sdk.NewCoins(newFooCoin(5))))
+	app.BankKeeper.FreezeAddress(ctx, addrModule, fooDenom)
+	err = app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, sdk.NewCoins(newFooCoin(5)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(5), newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addrModule))
+}
+
+func (suite *IntegrationTestSuite) TestMsgFreeze() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	metadata := suite.getTestMetadata()[0]
+	denom := metadata.Base
+
+	// the denom has no metadata
+	_, err := msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(admin, addr1, denom))
+	suite.Require().ErrorIs(err, types.ErrDenomMetadataNotFound)
+
+	// the denom has no admin
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(admin, addr1, denom))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	metadata.Admin = admin.String()
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(addr1, addr1, denom))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Unfreeze(sdk.WrapSDKContext(ctx), types.NewMsgUnfreeze(admin, addr1, denom))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(admin, addr1, denom))
+	suite.Require().NoError(err)
+	suite.Require().True(app.BankKeeper.IsFrozen(ctx, addr1, denom))
+
+	// module accounts cannot be frozen
+	moduleAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(admin, moduleAddr, denom))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, moduleAddr, denom))
+
+	// even if they are not blocked from receiving funds
+	openModule := authtypes.NewEmptyModuleAccount("open")
+	app.AccountKeeper.SetModuleAccount(ctx, app.AccountKeeper.NewAccount(ctx, openModule).(authtypes.ModuleAccountI))
+	suite.Require().False(app.BankKeeper.BlockedAddr(openModule.GetAddress()))
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(admin, openModule.GetAddress(), denom))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, openModule.GetAddress(), denom))
+
+	_, err = msgServer.Unfreeze(sdk.WrapSDKContext(ctx), types.NewMsgUnfreeze(addr1, addr1, denom))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Unfreeze(sdk.WrapSDKContext(ctx), types.NewMsgUnfreeze(admin, addr1, denom))
+	suite.Require().NoError(err)
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, addr1, denom))
+
+	// the admin toggles the sends of the denom
+	_, err = msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), types.NewMsgSetSendEnabled(addr1, denom, false))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), types.NewMsgSetSendEnabled(admin, denom, false))
+	suite.Require().NoError(err)
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin(denom, 1)))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	_, err = msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), types.NewMsgSetSendEnabled(admin, denom, true))
+	suite.Require().NoError(err)
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, sdk.NewInt64Coin(denom, 1)))
+	suite.Require().Len(app.BankKeeper.GetParams(ctx).SendEnabled, 1)
+}
+
+func (suite *IntegrationTestSuite) TestCreateDenom() {
+	app, ctx := suite.app, suite.ctx
+
+	creator := sdk.AccAddress("creator_____________")
+	fee := sdk.NewCoins(newFooCoin(100))
+	params := app.BankKeeper.GetParams(ctx)
+	params.DenomCreationFee = fee
+	app.BankKeeper.SetParams(ctx, params)
+
+	// the creator cannot pay the fee
+	_, err := app.BankKeeper.CreateDenom(ctx, creator, "bitcoin")
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, creator, fee))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+	denom, err := app.BankKeeper.CreateDenom(ctx, creator, "bitcoin")
+	suite.Require().NoError(err)
+	suite.Require().Equal("factory/"+creator.String()+"/bitcoin", denom)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, creator).IsZero())
+	suite.Require().Equal(feesBefore.Add(fee...), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(creator.String(), metadata.Admin)
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, denom))
+
+	// a denom cannot be created twice
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, creator, fee))
+	_, err = app.BankKeeper.CreateDenom(ctx, creator, "bitcoin")
+	suite.Require().ErrorIs(err, types.ErrDenomExists)
+
+	_, err = app.BankKeeper.CreateDenom(ctx, creator, strings.Repeat("a", types.MaxSubdenomLength+1))
+	suite.Require().ErrorIs(err, types.ErrInvalidFactoryDenom)
+}
+
+func (suite *IntegrationTestSuite) TestMsgFactory() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	addr1 := sdk.AccAddress("addr1_______________")
+
+	res, err := msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	suite.Require().NoError(err)
+	denom := res.NewTokenDenom
+
+	// only the admin can mint factory coins
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(addr1, sdk.NewInt64Coin(denom, 100), nil))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, newFooCoin(100), nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidFactoryDenom)
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100), feeCollector))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100), nil))
+	suite.Require().NoError(err)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 50), addr1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, admin, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 50), app.BankKeeper.GetBalance(ctx, addr1, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 150), app.BankKeeper.GetSupply(ctx, denom))
+	suite.Require().NotNil(app.AccountKeeper.GetAccount(ctx, addr1))
+
+	// only the admin can burn, and only from its own balance
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr1, sdk.NewInt64Coin(denom, 50)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 101)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetBalance(ctx, admin, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 110), app.BankKeeper.GetSupply(ctx, denom))
+
+	// the admin role moves to addr1
+	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(addr1, denom, addr1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(admin, denom, addr1))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 1), nil))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr1, sdk.NewInt64Coin(denom, 50)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetSupply(ctx, denom))
+
+	// the new admin also administers the denom for freezes
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(addr1, admin, denom))
+	suite.Require().NoError(err)
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
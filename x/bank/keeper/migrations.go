@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v043"
 	v045 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v045"
+	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +26,8 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v045.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates x/bank params from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v046.MigrateParams(ctx, m.keeper.paramSpace)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
@@ -104,3 +105,222 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+func (k msgServer) Freeze(goCtx context.Context, msg *types.MsgFreeze) (*types.MsgFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertDenomAdmin(ctx, msg.Denom, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// freezing a module account would block the module's transfers and
+	// (un)delegations of the denom, including the module accounts allowed to
+	// receive funds
+	if k.BlockedAddr(addr) || k.IsModuleAccount(ctx, addr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot be frozen", msg.Address)
+	}
+
+	k.FreezeAddress(ctx, addr, msg.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreeze,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgFreezeResponse{}, nil
+}
+
+func (k msgServer) Unfreeze(goCtx context.Context, msg *types.MsgUnfreeze) (*types.MsgUnfreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertDenomAdmin(ctx, msg.Denom, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsFrozen(ctx, addr, msg.Denom) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not frozen for %s", msg.Address, msg.Denom)
+	}
+
+	k.UnfreezeAddress(ctx, addr, msg.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreeze,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgUnfreezeResponse{}, nil
+}
+
+func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertDenomAdmin(ctx, msg.Denom, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetSendEnabled(ctx, msg.Denom, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetSendEnabled,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
+
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	mintTo := sender
+	if msg.MintToAddress != "" {
+		mintTo, err = sdk.AccAddressFromBech32(msg.MintToAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.MintFactoryCoins(ctx, sender, mintTo, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.BurnFactoryCoins(ctx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	newAdmin, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ChangeDenomAdmin(ctx, sender, newAdmin, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, msg.NewAdmin),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// assertDenomAdmin returns an error if the given address is not the admin set
+// in the metadata of the denom.
+func (k msgServer) assertDenomAdmin(ctx sdk.Context, denom, admin string) error {
+	metadata, found := k.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", denom)
+	}
+
+	if metadata.Admin == "" || metadata.Admin != admin {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -25,8 +26,20 @@ type SendKeeper interface {
 
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	SetSendEnabled(ctx sdk.Context, denom string, enabled bool)
+
+	IsFrozen(ctx sdk.Context, addr sdk.AccAddress, denom string) bool
+	FreezeAddress(ctx sdk.Context, addr sdk.AccAddress, denom string)
+	UnfreezeAddress(ctx sdk.Context, addr sdk.AccAddress, denom string)
+	IterateFrozenAddresses(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool))
 
 	BlockedAddr(addr sdk.AccAddress) bool
+	IsModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	SetHooks(bh types.BankHooks)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,6 +56,18 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// registered send restriction and hooks, shared between the copies of
+	// the keeper handed to other modules
+	transfer *transferExtensions
+}
+
+// transferExtensions houses the send restriction and hooks run on every
+// transfer. It is kept behind a pointer so that they can be registered
+// after the keeper has been copied into other keepers.
+type transferExtensions struct {
+	restriction types.SendRestrictionFn
+	hooks       types.BankHooks
 }
 
 func NewBaseSendKeeper(
@@ -56,9 +81,75 @@ func NewBaseSendKeeper(
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
 		blockedAddrs:   blockedAddrs,
+		transfer:       &transferExtensions{},
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously registered restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.transfer.restriction = types.ComposeSendRestrictions(k.transfer.restriction, restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before
+// the previously registered restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.transfer.restriction = types.ComposeSendRestrictions(restriction, k.transfer.restriction)
+}
+
+// ClearSendRestriction removes the registered send restrictions.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.transfer.restriction = nil
+}
+
+// SetHooks sets the hooks run before and after every transfer. The hooks are
+// shared with the copies of the keeper held by other modules.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if k.transfer.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.transfer.hooks = bh
+}
+
+// beforeSend checks that the sender is not frozen for the sent denoms, then
+// runs the registered send restriction and BeforeSend hook of a transfer and
+// returns the address that must receive the coins. A transfer redirected by
+// the send restriction to a blocked address is rejected.
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if err := k.checkNotFrozen(ctx, fromAddr, amt); err != nil {
+		return nil, err
+	}
+
+	if k.transfer.restriction != nil {
+		newToAddr, err := k.transfer.restriction(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return nil, err
+		}
+		if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newToAddr)
+		}
+		toAddr = newToAddr
+	}
+
+	if k.transfer.hooks != nil {
+		if err := k.transfer.hooks.BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// afterSend runs the registered AfterSend hook of a transfer.
+func (k BaseSendKeeper) afterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.transfer.hooks != nil {
+		return k.transfer.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+
+	return nil
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -73,6 +164,9 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+//
+// The send restriction and hooks are run for every output with each distinct
+// input address as sender, in the order of the inputs.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -80,13 +174,55 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	senders := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
+		senders[i] = inAddress
+	}
+
+	// the send restriction and hooks run once per (input, output) pair, for
+	// the coins of the output allocated to the input
+	allocations := allocateMultiSend(inputs, outputs)
+	type pairTransfer struct {
+		from   sdk.AccAddress
+		output int
+		amt    sdk.Coins
+	}
+	var transfers []pairTransfer
+	recipients := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		for j := range inputs {
+			amt := allocations[j][i]
+			if amt.Empty() {
+				continue
+			}
+
+			toAddr, err := k.beforeSend(ctx, senders[j], outAddress, amt)
+			if err != nil {
+				return err
+			}
+			if recipients[i] != nil && !recipients[i].Equals(toAddr) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "send restrictions redirect output %s to different recipients", out.Address)
+			}
+			recipients[i] = toAddr
+			transfers = append(transfers, pairTransfer{from: senders[j], output: i, amt: amt})
+		}
+
+		if recipients[i] == nil {
+			recipients[i] = outAddress
+		}
+	}
 
-		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
+	for i, in := range inputs {
+		err := k.subUnlockedCoins(ctx, senders[i], in.Coins)
 		if err != nil {
 			return err
 		}
@@ -99,12 +235,9 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
-		if err != nil {
-			return err
-		}
-		err = k.addCoins(ctx, outAddress, out.Coins)
+	for i, out := range outputs {
+		outAddress := recipients[i]
+		err := k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
 		}
@@ -112,7 +245,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -128,13 +261,60 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
+	for _, t := range transfers {
+		if err := k.afterSend(ctx, t.from, recipients[t.output], t.amt); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// allocateMultiSend allocates the coins of the inputs of a multi-send to its
+// outputs, filling each output from the inputs in order. It returns the coins
+// sent by input i to output j at index [i][j], which add up to the coins of
+// each input and of each output, as the inputs and outputs must balance.
+func allocateMultiSend(inputs []types.Input, outputs []types.Output) [][]sdk.Coins {
+	remaining := make([]map[string]sdk.Int, len(inputs))
+	allocations := make([][]sdk.Coins, len(inputs))
+	for i, in := range inputs {
+		remaining[i] = make(map[string]sdk.Int, len(in.Coins))
+		for _, coin := range in.Coins {
+			remaining[i][coin.Denom] = coin.Amount
+		}
+		allocations[i] = make([]sdk.Coins, len(outputs))
+	}
+
+	for j, out := range outputs {
+		for _, coin := range out.Coins {
+			need := coin.Amount
+			for i := 0; i < len(inputs) && need.IsPositive(); i++ {
+				avail, ok := remaining[i][coin.Denom]
+				if !ok || !avail.IsPositive() {
+					continue
+				}
+
+				amt := sdk.MinInt(avail, need)
+				remaining[i][coin.Denom] = avail.Sub(amt)
+				need = need.Sub(amt)
+				allocations[i][j] = allocations[i][j].Add(sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+	}
+
+	return allocations
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The registered send restriction may reject the transfer or redirect it to
+// another recipient. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.beforeSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -167,7 +347,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	return nil
+	return k.afterSend(ctx, fromAddr, toAddr, amt)
 }
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
@@ -316,8 +496,71 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
 }
 
+// SetSendEnabled enables or disables the sends of the given denom by updating
+// the SendEnabled params.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, enabled bool) {
+	k.SetParams(ctx, k.GetParams(ctx).SetSendEnabledParam(denom, enabled))
+}
+
+// IsFrozen returns true if the given account cannot send the given denom.
+func (k BaseSendKeeper) IsFrozen(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.CreateFrozenKey(denom, addr))
+}
+
+// checkNotFrozen returns ErrFrozen if addr is frozen for any of the denoms of
+// amt.
+func (k BaseSendKeeper) checkNotFrozen(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if k.IsFrozen(ctx, addr, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrFrozen, "%s cannot send %s", addr, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// FreezeAddress prevents the given account from sending the given denom.
+func (k BaseSendKeeper) FreezeAddress(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateFrozenKey(denom, addr), []byte{0})
+}
+
+// UnfreezeAddress allows a frozen account to send the given denom again.
+func (k BaseSendKeeper) UnfreezeAddress(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateFrozenKey(denom, addr))
+}
+
+// IterateFrozenAddresses iterates over the accounts frozen for the given denom
+// and provides their address to a callback. If true is returned from the
+// callback, iteration is halted.
+func (k BaseSendKeeper) IterateFrozenAddresses(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	frozenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateFrozenDenomPrefix(denom))
+
+	iterator := frozenStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, err := types.AddressFromFrozenStore(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(addr) {
+			break
+		}
+	}
+}
+
 // BlockedAddr checks if a given address is restricted from
 // receiving funds.
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// IsModuleAccount checks if the account of a given address is a module
+// account, whether or not it is blocked from receiving funds.
+func (k BaseSendKeeper) IsModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.ak.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
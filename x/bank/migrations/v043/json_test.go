@@ -73,8 +73,10 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"frozen_addresses": [],
 	"params": {
 		"default_send_enabled": false,
+		"denom_creation_fee": [],
 		"send_enabled": []
 	},
 	"supply": [
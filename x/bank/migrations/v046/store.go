@@ -0,0 +1,16 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Set the DenomCreationFee param to its default value.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyDenomCreationFee, types.DefaultDenomCreationFee)
+	return nil
+}
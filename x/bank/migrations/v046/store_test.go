@@ -0,0 +1,31 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	require.False(t, paramSpace.Has(ctx, types.KeyDenomCreationFee))
+
+	require.NoError(t, v046.MigrateParams(ctx, paramSpace))
+
+	var fee sdk.Coins
+	paramSpace.Get(ctx, types.KeyDenomCreationFee, &fee)
+	require.True(t, fee.IsEqual(types.DefaultDenomCreationFee))
+}
@@ -72,6 +72,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		Params: types.Params{
 			SendEnabled:        sendEnabledParams,
 			DefaultSendEnabled: defaultSendEnabledParam,
+			DenomCreationFee:   types.DefaultDenomCreationFee,
 		},
 		Balances: RandomGenesisBalances(simState),
 		Supply:   supply,
@@ -30,6 +30,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denom_creation_fee is the fee charged to create a denom with
+	// MsgCreateDenom.
+	//
+	// Since: cosmos-sdk 0.46
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +83,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -349,6 +361,11 @@ type Metadata struct {
 	//
 	// Since: cosmos-sdk 0.45
 	URIHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// admin is the address allowed to freeze and unfreeze accounts for the base
+	// denom and to enable or disable its sends. Optional.
+	//
+	// Since: cosmos-sdk 0.46
+	Admin string `protobuf:"bytes,9,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -440,6 +457,93 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+func (m *Metadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// BalanceChange is a change of the balance of an account for a denom, as
+// recorded by the node-local balance history index.
+//
+// Since: cosmos-sdk 0.46
+type BalanceChange struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the height of the block of the change. The genesis balances are
+	// recorded at the initial height of the chain.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// delta is the amount added to the balance, negative when coins are removed.
+	Delta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=delta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delta"`
+	// balance is the balance after the change.
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// tx_hash is the hash of the tx which made the change, empty for the changes
+	// made by BeginBlock and EndBlock.
+	TxHash string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceChange) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -448,52 +552,60 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*BalanceChange)(nil), "cosmos.bank.v1beta1.BalanceChange")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x32, 0xed, 0x61, 0x13, 0x72, 0x90, 0x28,
-	0x34, 0x49, 0xab, 0xa7, 0x20, 0x88, 0xad, 0xa2, 0x11, 0x44, 0xd9, 0x52, 0x04, 0x2f, 0x61, 0x92,
-	0x19, 0x93, 0xa1, 0xbb, 0x33, 0xcb, 0xce, 0x6c, 0x69, 0x7e, 0x80, 0x20, 0x9e, 0x3c, 0x7a, 0xec,
-	0x51, 0x3d, 0x17, 0xfc, 0x0b, 0xc5, 0x53, 0xf1, 0xe4, 0xa9, 0x4a, 0x7a, 0xf1, 0x67, 0xc8, 0xcc,
-	0xec, 0xa6, 0x29, 0x54, 0xf1, 0xe0, 0xc5, 0xd3, 0xbe, 0xef, 0x7d, 0xef, 0x7d, 0xef, 0xbd, 0x99,
-	0x37, 0x0b, 0xfd, 0x91, 0x54, 0x91, 0x54, 0x9d, 0x21, 0x11, 0x7b, 0x9d, 0xfd, 0x8d, 0x21, 0xd3,
-	0x64, 0xc3, 0x82, 0x76, 0x9c, 0x48, 0x2d, 0xd1, 0x35, 0xc7, 0xb7, 0xad, 0x2b, 0xe3, 0xd7, 0x6a,
-	0x63, 0x39, 0x96, 0x96, 0xef, 0x18, 0xcb, 0x85, 0xae, 0xad, 0xba, 0xd0, 0x81, 0x23, 0xb2, 0x3c,
-	0x47, 0x9d, 0x57, 0x51, 0x6c, 0x5e, 0x65, 0x24, 0xb9, 0x70, 0x7c, 0xf3, 0x35, 0x80, 0xe5, 0xe7,
-	0x24, 0x21, 0x91, 0x42, 0xdb, 0xf0, 0x8a, 0x62, 0x82, 0x0e, 0x98, 0x20, 0xc3, 0x90, 0x51, 0x0c,
-	0x1a, 0xc5, 0x56, 0x75, 0xb3, 0xd1, 0xbe, 0xa4, 0x8f, 0xf6, 0x0e, 0x13, 0xf4, 0xa1, 0x8b, 0x0b,
-	0xaa, 0xea, 0x1c, 0xa0, 0x2e, 0xac, 0x51, 0xf6, 0x8a, 0xa4, 0xa1, 0x1e, 0x5c, 0x10, 0x5b, 0x6a,
-	0x80, 0x96, 0x17, 0xa0, 0x8c, 0x5b, 0x48, 0xef, 0x2d, 0xbf, 0x3f, 0xac, 0x17, 0x9a, 0x8f, 0x60,
-	0x75, 0xc1, 0x89, 0x6a, 0xb0, 0x44, 0x99, 0x90, 0x11, 0x06, 0x0d, 0xd0, 0x5a, 0x09, 0x1c, 0x40,
-	0x18, 0x56, 0x2e, 0xea, 0xe5, 0xb0, 0xe7, 0x19, 0x91, 0x9f, 0x87, 0x75, 0xd0, 0xfc, 0x00, 0x60,
-	0xa9, 0x2f, 0xe2, 0x54, 0xa3, 0x4d, 0x58, 0x21, 0x94, 0x26, 0x4c, 0x29, 0xa7, 0xb2, 0x85, 0xbf,
-	0x1e, 0xad, 0xd7, 0xb2, 0x69, 0xee, 0x3b, 0x66, 0x47, 0x27, 0x5c, 0x8c, 0x83, 0x3c, 0x10, 0x11,
-	0x58, 0x32, 0x87, 0xa3, 0xf0, 0x92, 0x1d, 0x7e, 0xf5, 0x7c, 0x78, 0xc5, 0xe6, 0xc3, 0x6f, 0x4b,
-	0x2e, 0xb6, 0xba, 0xc7, 0xa7, 0xf5, 0xc2, 0xa7, 0xef, 0xf5, 0xd6, 0x98, 0xeb, 0x49, 0x3a, 0x6c,
-	0x8f, 0x64, 0x94, 0x9d, 0x7c, 0xf6, 0x59, 0x57, 0x74, 0xaf, 0xa3, 0xa7, 0x31, 0x53, 0x36, 0x41,
-	0x05, 0x4e, 0xb9, 0xe7, 0xbd, 0x71, 0xad, 0x16, 0x9a, 0x1f, 0x01, 0x2c, 0x3f, 0x4b, 0xf5, 0x7f,
-	0xd1, 0xeb, 0x67, 0x00, 0xcb, 0x3b, 0x69, 0x1c, 0x87, 0x53, 0x53, 0x57, 0x4b, 0x4d, 0xc2, 0x6c,
-	0x41, 0xfe, 0x6d, 0x5d, 0xab, 0xdc, 0x7b, 0x92, 0xd5, 0x05, 0x5f, 0x8e, 0xd6, 0xef, 0xde, 0xfa,
-	0x63, 0xf6, 0x81, 0x7b, 0x40, 0x11, 0x1f, 0x27, 0x44, 0x73, 0x29, 0x54, 0x67, 0xbf, 0x7b, 0xa7,
-	0xdb, 0x76, 0xbd, 0xf6, 0x31, 0x68, 0xbe, 0x80, 0x2b, 0x0f, 0xcc, 0xf6, 0xec, 0x0a, 0xae, 0x7f,
-	0xb3, 0x57, 0x6b, 0xd0, 0x63, 0x07, 0xb1, 0x14, 0x4c, 0x68, 0xbb, 0x58, 0x57, 0x83, 0x39, 0x36,
-	0x3b, 0x47, 0x42, 0x4e, 0x14, 0x53, 0xb8, 0xd8, 0x28, 0xb6, 0x56, 0x82, 0x1c, 0x36, 0xdf, 0x2e,
-	0x41, 0xef, 0x29, 0xd3, 0x84, 0x12, 0x4d, 0x50, 0x03, 0x56, 0x29, 0x53, 0xa3, 0x84, 0xc7, 0xa6,
-	0x89, 0x4c, 0x7e, 0xd1, 0x85, 0xee, 0x99, 0x08, 0x21, 0xa3, 0x41, 0x2a, 0xb8, 0xce, 0x2f, 0xcd,
-	0xbf, 0xf4, 0x75, 0xcd, 0xfb, 0x0d, 0x20, 0xcd, 0x4d, 0x85, 0x10, 0x5c, 0x36, 0x47, 0x8c, 0x8b,
-	0x56, 0xdb, 0xda, 0xa6, 0x3b, 0xca, 0x55, 0x1c, 0x92, 0x29, 0x5e, 0xb6, 0xee, 0x1c, 0x9a, 0x68,
-	0x41, 0x22, 0x86, 0x4b, 0x2e, 0xda, 0xd8, 0xe8, 0x3a, 0x2c, 0xab, 0x69, 0x34, 0x94, 0x21, 0x2e,
-	0x5b, 0x6f, 0x86, 0xd0, 0x2a, 0x2c, 0xa6, 0x09, 0xc7, 0x15, 0xbb, 0x79, 0x95, 0xd9, 0x69, 0xbd,
-	0xb8, 0x1b, 0xf4, 0x03, 0xe3, 0x43, 0x37, 0xa0, 0x97, 0x26, 0x7c, 0x30, 0x21, 0x6a, 0x82, 0x3d,
-	0xcb, 0x57, 0x67, 0xa7, 0xf5, 0xca, 0x6e, 0xd0, 0x7f, 0x4c, 0xd4, 0x24, 0xa8, 0xa4, 0x09, 0x37,
-	0xc6, 0xd6, 0xf6, 0xf1, 0xcc, 0x07, 0x27, 0x33, 0x1f, 0xfc, 0x98, 0xf9, 0xe0, 0xdd, 0x99, 0x5f,
-	0x38, 0x39, 0xf3, 0x0b, 0xdf, 0xce, 0xfc, 0xc2, 0xcb, 0x9b, 0x7f, 0x73, 0x7d, 0x76, 0x07, 0x86,
-	0x65, 0xfb, 0x4f, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x80, 0x89, 0x32, 0x1b, 0x05,
-	0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6b, 0x13, 0x4b,
+	0x1c, 0xcf, 0x26, 0x4d, 0x36, 0x99, 0xbc, 0xc2, 0x63, 0x5e, 0xe8, 0x9b, 0xf6, 0xb0, 0x09, 0x39,
+	0x94, 0xbc, 0x07, 0xf9, 0xd1, 0xea, 0x29, 0x14, 0xc4, 0xc4, 0x5f, 0x11, 0x44, 0xd9, 0x52, 0x05,
+	0x2f, 0x61, 0x92, 0x9d, 0x26, 0x43, 0x77, 0x67, 0x97, 0x9d, 0xd9, 0x92, 0xdc, 0x3c, 0x7a, 0xf4,
+	0xe8, 0xb1, 0x47, 0xf5, 0x5c, 0x14, 0xfc, 0x0b, 0x8a, 0xa7, 0xd2, 0x93, 0x78, 0x88, 0x92, 0x5e,
+	0xfc, 0x33, 0x64, 0x66, 0x36, 0x69, 0x0a, 0x55, 0x8b, 0xd4, 0x83, 0xa7, 0xfd, 0xfe, 0x9a, 0xcf,
+	0xe7, 0xfb, 0x93, 0x05, 0x56, 0xdf, 0xe7, 0x9e, 0xcf, 0xeb, 0x3d, 0xcc, 0xf6, 0xea, 0xfb, 0x1b,
+	0x3d, 0x22, 0xf0, 0x86, 0x52, 0x6a, 0x41, 0xe8, 0x0b, 0x1f, 0xfe, 0xa3, 0xfd, 0x35, 0x65, 0x8a,
+	0xfd, 0x6b, 0x85, 0x81, 0x3f, 0xf0, 0x95, 0xbf, 0x2e, 0x25, 0x1d, 0xba, 0xb6, 0xaa, 0x43, 0xbb,
+	0xda, 0x11, 0xbf, 0xd3, 0xae, 0x33, 0x16, 0x4e, 0xe6, 0x2c, 0x7d, 0x9f, 0x32, 0xed, 0x2f, 0x3f,
+	0x4b, 0x82, 0xcc, 0x23, 0x1c, 0x62, 0x8f, 0xc3, 0x36, 0xf8, 0x8b, 0x13, 0xe6, 0x74, 0x09, 0xc3,
+	0x3d, 0x97, 0x38, 0xc8, 0x28, 0xa5, 0x2a, 0xf9, 0xcd, 0x52, 0xed, 0x82, 0x3c, 0x6a, 0xdb, 0x84,
+	0x39, 0xb7, 0x75, 0x9c, 0x9d, 0xe7, 0x67, 0x0a, 0x6c, 0x80, 0x82, 0x43, 0x76, 0x71, 0xe4, 0x8a,
+	0xee, 0x39, 0xb0, 0x64, 0xc9, 0xa8, 0x64, 0x6d, 0x18, 0xfb, 0x16, 0x9e, 0xc3, 0x31, 0x80, 0x0e,
+	0x61, 0xbe, 0xd7, 0xed, 0x87, 0x04, 0x0b, 0xea, 0xb3, 0xee, 0x2e, 0x21, 0x28, 0xa5, 0xc8, 0x57,
+	0xcf, 0xc8, 0x39, 0x99, 0x93, 0xb7, 0x7d, 0xca, 0x5a, 0x8d, 0xa3, 0x49, 0x31, 0xf1, 0xe6, 0x73,
+	0xb1, 0x32, 0xa0, 0x62, 0x18, 0xf5, 0x6a, 0x7d, 0xdf, 0x8b, 0x2b, 0x8f, 0x3f, 0x55, 0xee, 0xec,
+	0xd5, 0xc5, 0x38, 0x20, 0x5c, 0x3d, 0xe0, 0xf6, 0xdf, 0x8a, 0xa6, 0x1d, 0xb3, 0xdc, 0x21, 0xa4,
+	0xb9, 0xf4, 0xf2, 0xa0, 0x98, 0x28, 0xdf, 0x05, 0xf9, 0xc5, 0x7c, 0x0a, 0x20, 0xad, 0x02, 0x91,
+	0x51, 0x32, 0x2a, 0x39, 0x5b, 0x2b, 0x10, 0x01, 0xf3, 0x7c, 0x29, 0x33, 0xb5, 0x99, 0x95, 0x20,
+	0x5f, 0x0f, 0x8a, 0x46, 0xf9, 0x95, 0x01, 0xd2, 0x1d, 0x16, 0x44, 0x02, 0x6e, 0x02, 0x13, 0x3b,
+	0x4e, 0x48, 0x38, 0xd7, 0x28, 0x2d, 0x74, 0x72, 0x58, 0x2d, 0xc4, 0xb5, 0xdc, 0xd4, 0x9e, 0x6d,
+	0x11, 0x52, 0x36, 0xb0, 0x67, 0x81, 0x10, 0x83, 0xb4, 0x9c, 0x0b, 0x47, 0xc9, 0xab, 0x2f, 0x5d,
+	0x23, 0x37, 0xb3, 0xcf, 0x75, 0xaa, 0x89, 0xf2, 0x6b, 0x03, 0x64, 0x1e, 0x46, 0xe2, 0x8f, 0xc8,
+	0xf5, 0x9d, 0x01, 0x32, 0xdb, 0x51, 0x10, 0xb8, 0x63, 0xc9, 0x2b, 0x7c, 0x81, 0x5d, 0x64, 0xfc,
+	0x06, 0x5e, 0x85, 0xdc, 0xbc, 0x1f, 0xf3, 0x1a, 0x1f, 0x0e, 0xab, 0x5b, 0xff, 0xff, 0xf0, 0xf5,
+	0x48, 0xdf, 0xae, 0x47, 0x07, 0xa1, 0x5a, 0x29, 0x5e, 0xdf, 0x6f, 0x5c, 0x6f, 0xd4, 0x74, 0xae,
+	0x1d, 0x64, 0x94, 0x9f, 0x80, 0xdc, 0x2d, 0xb9, 0x3d, 0x3b, 0x8c, 0x8a, 0xef, 0xec, 0xd5, 0x1a,
+	0xc8, 0x92, 0x51, 0xe0, 0x33, 0xc2, 0x84, 0x5a, 0xac, 0x65, 0x7b, 0xae, 0xcb, 0x9d, 0xc3, 0x2e,
+	0xc5, 0x9c, 0x70, 0x75, 0x0e, 0x39, 0x7b, 0xa6, 0x96, 0xdf, 0x27, 0x41, 0xf6, 0x01, 0x11, 0xd8,
+	0xc1, 0x02, 0xc3, 0x12, 0xc8, 0x3b, 0x84, 0xf7, 0x43, 0x1a, 0xc8, 0x24, 0x62, 0xf8, 0x45, 0x13,
+	0xbc, 0x01, 0xf2, 0xfa, 0xc4, 0x22, 0x46, 0xc5, 0x6c, 0x68, 0xd6, 0x85, 0x87, 0x3d, 0xcf, 0xd7,
+	0x06, 0xce, 0x4c, 0xe4, 0x10, 0x82, 0x25, 0xd9, 0x62, 0x94, 0x52, 0xd8, 0x4a, 0x96, 0xd9, 0x39,
+	0x94, 0x07, 0x2e, 0x1e, 0xa3, 0x25, 0x65, 0x9e, 0xa9, 0x32, 0x9a, 0x61, 0x8f, 0xa0, 0xb4, 0x8e,
+	0x96, 0x32, 0x5c, 0x01, 0x19, 0x3e, 0xf6, 0x7a, 0xbe, 0x8b, 0x32, 0xca, 0x1a, 0x6b, 0x70, 0x15,
+	0xa4, 0xa2, 0x90, 0x22, 0x53, 0x6d, 0x9e, 0x39, 0x9d, 0x14, 0x53, 0x3b, 0x76, 0xc7, 0x96, 0x36,
+	0xb8, 0x0e, 0xb2, 0x51, 0x48, 0xbb, 0x43, 0xcc, 0x87, 0x28, 0xab, 0xfc, 0xf9, 0xe9, 0xa4, 0x68,
+	0xee, 0xd8, 0x9d, 0x7b, 0x98, 0x0f, 0x6d, 0x33, 0x0a, 0xa9, 0x14, 0x60, 0x0d, 0xa4, 0xb1, 0xe3,
+	0x51, 0x86, 0x72, 0x3f, 0x59, 0x5f, 0x1d, 0x56, 0x7e, 0x9b, 0x04, 0xcb, 0x2d, 0xec, 0x62, 0xd6,
+	0x27, 0xed, 0x21, 0x66, 0x03, 0xf2, 0x4b, 0x27, 0x30, 0x1f, 0x67, 0x72, 0x71, 0x9c, 0x2b, 0x20,
+	0x33, 0x24, 0x74, 0x30, 0x14, 0xaa, 0x55, 0x29, 0x3b, 0xd6, 0xa0, 0x2d, 0xa3, 0x5d, 0x81, 0x75,
+	0xab, 0x5a, 0x5b, 0x72, 0x3b, 0x3f, 0x4d, 0x8a, 0xeb, 0x97, 0xd8, 0xce, 0x0e, 0x13, 0x27, 0x87,
+	0x55, 0x10, 0x67, 0xd3, 0x61, 0xc2, 0xd6, 0x50, 0xf0, 0x31, 0x30, 0x7b, 0xba, 0x0c, 0x94, 0xbe,
+	0x02, 0xd4, 0x19, 0x18, 0xfc, 0x17, 0x98, 0x62, 0xa4, 0xdb, 0x1e, 0xcf, 0x4a, 0x8c, 0x64, 0xa3,
+	0x5b, 0xed, 0xa3, 0xa9, 0x65, 0x1c, 0x4f, 0x2d, 0xe3, 0xcb, 0xd4, 0x32, 0x5e, 0x9c, 0x5a, 0x89,
+	0xe3, 0x53, 0x2b, 0xf1, 0xf1, 0xd4, 0x4a, 0x3c, 0xfd, 0xef, 0x32, 0x77, 0xa2, 0x88, 0x7b, 0x19,
+	0xf5, 0xdf, 0xb9, 0xf6, 0x6d, 0x00, 0xcd, 0xec, 0x74, 0x59, 0xff, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -572,6 +684,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -828,6 +954,13 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.URIHash) > 0 {
 		i -= len(m.URIHash)
 		copy(dAtA[i:], m.URIHash)
@@ -894,6 +1027,75 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BalanceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -920,6 +1122,12 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1054,6 +1262,38 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBank(uint64(l))
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = m.Delta.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
 	return n
 }
 
@@ -1146,6 +1386,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2005,6 +2279,271 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
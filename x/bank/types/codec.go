@@ -14,12 +14,26 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&MsgFreeze{}, "cosmos-sdk/MsgFreeze", nil)
+	cdc.RegisterConcrete(&MsgUnfreeze{}, "cosmos-sdk/MsgUnfreeze", nil)
+	cdc.RegisterConcrete(&MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled", nil)
+	cdc.RegisterConcrete(&MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom", nil)
+	cdc.RegisterConcrete(&MsgMint{}, "cosmos-sdk/bank/MsgMint", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "cosmos-sdk/bank/MsgBurn", nil)
+	cdc.RegisterConcrete(&MsgChangeAdmin{}, "cosmos-sdk/MsgChangeAdmin", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
+		&MsgSetSendEnabled{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
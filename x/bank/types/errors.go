@@ -12,4 +12,7 @@ var (
 	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
 	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 6, "client denom metadata not found")
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
+	ErrFrozen                = sdkerrors.Register(ModuleName, 8, "account is frozen for the denom")
+	ErrDenomExists           = sdkerrors.Register(ModuleName, 9, "denom already exists")
+	ErrInvalidFactoryDenom   = sdkerrors.Register(ModuleName, 10, "invalid factory denom")
 )
@@ -23,6 +23,22 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// frozen accounts and denom sends events name and attributes
+	EventTypeFreeze         = "freeze"
+	EventTypeUnfreeze       = "unfreeze"
+	EventTypeSetSendEnabled = "set_send_enabled"
+
+	// factory denoms events name and attributes
+	EventTypeCreateDenom = "create_denom"
+	EventTypeChangeAdmin = "change_admin"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyNewAdmin = "new_admin"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyDenom   = "denom"
+	AttributeKeyEnabled = "enabled"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
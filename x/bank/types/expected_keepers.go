@@ -26,3 +26,13 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
 }
+
+// Event Hooks
+// These can be utilized to communicate between a bank keeper and another
+// keepers.
+
+// BankHooks event hooks for bank transfers (noalias)
+type BankHooks interface {
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error // Must be called before coins are transferred, an error aborts the transfer
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error  // Must be called after coins are transferred
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// FactoryDenomPrefix is the first part of the denoms created with
+	// MsgCreateDenom.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the factory/{creator}/{subdenom} denom created by the
+// given creator.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidFactoryDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidFactoryDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructFactoryDenom returns the creator address and the subdenom of a
+// factory denom.
+func DeconstructFactoryDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", sdkerrors.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidFactoryDenom, "%s is not a %s denom", denom, FactoryDenomPrefix)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidFactoryDenom, "invalid creator %s: %s", parts[1], err)
+	}
+
+	return creator, parts[2], nil
+}
+
+// NewFactoryDenomMetadata returns the metadata of a new factory denom.
+func NewFactoryDenomMetadata(denom, subdenom string, admin sdk.AccAddress) Metadata {
+	return Metadata{
+		DenomUnits: []*DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+		Admin:      admin.String(),
+	}
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+
+	tests := []struct {
+		name     string
+		subdenom string
+		expErr   bool
+	}{
+		{"valid", "bitcoin", false},
+		{"valid with slashes", "bitcoin/wrapped", false},
+		{"valid max length", strings.Repeat("a", types.MaxSubdenomLength), false},
+		{"too long", strings.Repeat("a", types.MaxSubdenomLength+1), true},
+		{"invalid characters", "bit coin", true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetFactoryDenom(creator.String(), tc.subdenom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+creator.String()+"/"+tc.subdenom, denom)
+
+			gotCreator, gotSubdenom, err := types.DeconstructFactoryDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+}
+
+func TestDeconstructFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+
+	for _, denom := range []string{
+		"atom",
+		"ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2",
+		"factory/" + creator.String(),
+		"factory/cosmos1invalid/bitcoin",
+		"1factory/" + creator.String() + "/bitcoin",
+	} {
+		_, _, err := types.DeconstructFactoryDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, denom)
+	}
+}
+
+func TestNewFactoryDenomMetadata(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	denom, err := types.GetFactoryDenom(creator.String(), "bitcoin")
+	require.NoError(t, err)
+
+	metadata := types.NewFactoryDenomMetadata(denom, "bitcoin", creator)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, denom, metadata.Base)
+	require.Equal(t, creator.String(), metadata.Admin)
+}
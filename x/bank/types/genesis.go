@@ -17,6 +17,7 @@ func (gs GenesisState) Validate() error {
 
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenFrozen := make(map[string]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -46,6 +47,19 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	for _, frozen := range gs.FrozenAddresses {
+		key := frozen.Denom + "/" + frozen.Address
+		if seenFrozen[key] {
+			return fmt.Errorf("duplicate frozen address %s for denom %s", frozen.Address, frozen.Denom)
+		}
+
+		if err := frozen.Validate(); err != nil {
+			return err
+		}
+
+		seenFrozen[key] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -87,3 +101,12 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 
 	return &genesisState
 }
+
+// Validate checks that the address and the denom of a frozen account are valid.
+func (f FrozenAddress) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Address); err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(f.Denom)
+}
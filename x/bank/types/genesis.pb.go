@@ -37,6 +37,10 @@ type GenesisState struct {
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
 	// denom_metadata defines the metadata of the differents coins.
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// frozen_addresses defines the accounts frozen for a denom.
+	//
+	// Since: cosmos-sdk 0.46
+	FrozenAddresses []FrozenAddress `protobuf:"bytes,5,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +104,13 @@ func (m *GenesisState) GetDenomMetadata() []Metadata {
 	return nil
 }
 
+func (m *GenesisState) GetFrozenAddresses() []FrozenAddress {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -142,40 +153,89 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// FrozenAddress defines an account address frozen for a denom used in the bank
+// module's genesis state.
+//
+// Since: cosmos-sdk 0.46
+type FrozenAddress struct {
+	// address is the address of the frozen account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denom the account cannot send.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FrozenAddress) Reset()         { *m = FrozenAddress{} }
+func (m *FrozenAddress) String() string { return proto.CompactTextString(m) }
+func (*FrozenAddress) ProtoMessage()    {}
+func (*FrozenAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *FrozenAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAddress.Merge(m, src)
+}
+func (m *FrozenAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAddress proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*FrozenAddress)(nil), "cosmos.bank.v1beta1.FrozenAddress")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x18, 0xc7, 0x5b, 0xe0, 0x07, 0xfc, 0x0e, 0x75, 0xa8, 0x0c, 0x05, 0xb5, 0x45, 0x26, 0x1c, 0x68,
-	0x05, 0x27, 0x1d, 0x4c, 0x2c, 0x83, 0x89, 0x89, 0x89, 0x81, 0xcd, 0x85, 0x5c, 0xdb, 0x4b, 0x6d,
-	0xa0, 0xbd, 0xa6, 0x77, 0x18, 0x79, 0x07, 0x8e, 0xbe, 0x04, 0x66, 0x66, 0x27, 0x5f, 0x01, 0x23,
-	0x71, 0x72, 0x52, 0x03, 0x8b, 0x2f, 0xc3, 0xf4, 0xee, 0xa8, 0x26, 0x12, 0x27, 0xa7, 0xfe, 0xf9,
-	0x7e, 0x3f, 0xdf, 0xe7, 0x79, 0xee, 0x1e, 0xb0, 0xef, 0x60, 0x12, 0x60, 0x62, 0xda, 0x30, 0x1c,
-	0x98, 0xb7, 0x2d, 0x1b, 0x51, 0xd8, 0x32, 0x3d, 0x14, 0x22, 0xe2, 0x13, 0x23, 0x8a, 0x31, 0xc5,
-	0xca, 0x36, 0xb7, 0x18, 0x89, 0xc5, 0x10, 0x96, 0x6a, 0xd9, 0xc3, 0x1e, 0x66, 0xba, 0x99, 0xbc,
-	0x71, 0x6b, 0x55, 0x4b, 0xd3, 0x08, 0x4a, 0xd3, 0x1c, 0xec, 0x87, 0x3f, 0xf4, 0x6f, 0xd5, 0x58,
-	0x2e, 0xd7, 0x2b, 0x5c, 0xef, 0xf3, 0x60, 0x51, 0x97, 0x7d, 0xd4, 0x9f, 0x32, 0x60, 0xe3, 0x9c,
-	0xf7, 0xd5, 0xa3, 0x90, 0x22, 0xe5, 0x18, 0xe4, 0x23, 0x18, 0xc3, 0x80, 0xa8, 0x72, 0x4d, 0x6e,
-	0x94, 0xda, 0x3b, 0xc6, 0x9a, 0x3e, 0x8d, 0x2b, 0x66, 0xb1, 0x72, 0xb3, 0x57, 0x5d, 0xea, 0x0a,
-	0x40, 0x39, 0x05, 0x45, 0x1b, 0x0e, 0x61, 0xe8, 0x20, 0xa2, 0x66, 0x6a, 0xd9, 0x46, 0xa9, 0xbd,
-	0xbb, 0x16, 0xb6, 0xb8, 0x49, 0xd0, 0x29, 0xa3, 0x38, 0x20, 0x4f, 0x46, 0x51, 0x34, 0x1c, 0xab,
-	0x59, 0x46, 0x57, 0xbe, 0x68, 0x82, 0x52, 0xba, 0x83, 0xfd, 0xd0, 0x3a, 0x4c, 0xd0, 0xe9, 0x9b,
-	0xde, 0xf0, 0x7c, 0x7a, 0x33, 0xb2, 0x0d, 0x07, 0x07, 0x62, 0x2e, 0xf1, 0x68, 0x12, 0x77, 0x60,
-	0xd2, 0x71, 0x84, 0x08, 0x03, 0x48, 0x57, 0x44, 0x2b, 0x17, 0x60, 0xcb, 0x45, 0x21, 0x0e, 0xfa,
-	0x01, 0xa2, 0xd0, 0x85, 0x14, 0xaa, 0x39, 0x56, 0x6c, 0x6f, 0x6d, 0xab, 0x97, 0xc2, 0x24, 0x7a,
-	0xdd, 0x64, 0xe8, 0xea, 0x67, 0x7d, 0x2a, 0x83, 0x82, 0x18, 0x46, 0x69, 0x83, 0x02, 0x74, 0xdd,
-	0x18, 0x11, 0x7e, 0x70, 0xff, 0x2d, 0xf5, 0xf9, 0xb1, 0x59, 0x16, 0x99, 0x67, 0x5c, 0xe9, 0xd1,
-	0xd8, 0x0f, 0xbd, 0xee, 0xca, 0xa8, 0x40, 0xf0, 0x2f, 0xb9, 0xc5, 0xd5, 0x69, 0xfd, 0xe9, 0xbc,
-	0x3c, 0xf9, 0xa4, 0x78, 0x3f, 0xd1, 0xa5, 0x8f, 0x89, 0x2e, 0x59, 0x9d, 0xd9, 0x42, 0x93, 0xe7,
-	0x0b, 0x4d, 0x7e, 0x5f, 0x68, 0xf2, 0xc3, 0x52, 0x93, 0xe6, 0x4b, 0x4d, 0x7a, 0x59, 0x6a, 0xd2,
-	0xf5, 0xc1, 0xaf, 0xa1, 0x77, 0x7c, 0xad, 0x58, 0xb6, 0x9d, 0x67, 0x5b, 0x73, 0xf4, 0x19, 0x00,
-	0x00, 0xff, 0xff, 0x78, 0xd8, 0xf3, 0x7f, 0xe0, 0x02, 0x00, 0x00,
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0xed, 0xa6, 0x49, 0xcb, 0x95, 0x02, 0x3a, 0x32, 0xb8, 0x05, 0xec, 0xe2, 0x29, 0x0c,
+	0xb5, 0x69, 0x98, 0x60, 0x40, 0xc2, 0x95, 0x40, 0x42, 0x42, 0x42, 0xce, 0xc6, 0x12, 0x9d, 0xed,
+	0xab, 0xb1, 0x1a, 0xdf, 0x59, 0xfe, 0x2e, 0x88, 0xf0, 0x04, 0x6c, 0xf0, 0x08, 0x99, 0x33, 0xf3,
+	0x10, 0x19, 0x23, 0x26, 0x26, 0x40, 0xc9, 0xc2, 0x63, 0x20, 0xdf, 0x5d, 0x9c, 0x20, 0x2c, 0x06,
+	0xd4, 0x29, 0xb9, 0xfb, 0xfe, 0xbf, 0xff, 0xf7, 0xbf, 0xef, 0xce, 0xe8, 0x7e, 0xcc, 0x21, 0xe7,
+	0xe0, 0x47, 0x84, 0x5d, 0xfa, 0xef, 0xce, 0x22, 0x2a, 0xc8, 0x99, 0x9f, 0x52, 0x46, 0x21, 0x03,
+	0xaf, 0x28, 0xb9, 0xe0, 0xf8, 0xb6, 0x92, 0x78, 0x95, 0xc4, 0xd3, 0x92, 0xe3, 0x6e, 0xca, 0x53,
+	0x2e, 0xeb, 0x7e, 0xf5, 0x4f, 0x49, 0x8f, 0xed, 0xda, 0x0d, 0x68, 0xed, 0x16, 0xf3, 0x8c, 0xfd,
+	0x55, 0xdf, 0xea, 0x26, 0x7d, 0x55, 0xfd, 0x48, 0xd5, 0x87, 0xca, 0x58, 0xf7, 0x95, 0x0b, 0xf7,
+	0x53, 0x0b, 0x5d, 0x7f, 0xa1, 0x72, 0x0d, 0x04, 0x11, 0x14, 0x3f, 0x46, 0x9d, 0x82, 0x94, 0x24,
+	0x07, 0xcb, 0x3c, 0x31, 0x7b, 0x07, 0xfd, 0x3b, 0x5e, 0x43, 0x4e, 0xef, 0xb5, 0x94, 0x04, 0xbb,
+	0xf3, 0xef, 0x8e, 0x11, 0x6a, 0x00, 0x3f, 0x45, 0xfb, 0x11, 0x19, 0x11, 0x16, 0x53, 0xb0, 0x76,
+	0x4e, 0x5a, 0xbd, 0x83, 0xfe, 0xdd, 0x46, 0x38, 0x50, 0x22, 0x4d, 0xd7, 0x0c, 0x8e, 0x51, 0x07,
+	0xc6, 0x45, 0x31, 0x9a, 0x58, 0x2d, 0x49, 0x1f, 0x6d, 0x68, 0xa0, 0x35, 0x7d, 0xce, 0x33, 0x16,
+	0x3c, 0xac, 0xd0, 0xd9, 0x0f, 0xa7, 0x97, 0x66, 0xe2, 0xed, 0x38, 0xf2, 0x62, 0x9e, 0xeb, 0x73,
+	0xe9, 0x9f, 0x53, 0x48, 0x2e, 0x7d, 0x31, 0x29, 0x28, 0x48, 0x00, 0x42, 0x6d, 0x8d, 0x5f, 0xa2,
+	0x1b, 0x09, 0x65, 0x3c, 0x1f, 0xe6, 0x54, 0x90, 0x84, 0x08, 0x62, 0xed, 0xca, 0x66, 0xf7, 0x1a,
+	0xa3, 0xbe, 0xd2, 0x22, 0x9d, 0xf5, 0x50, 0xa2, 0xeb, 0x4d, 0x3c, 0x40, 0xb7, 0x2e, 0x4a, 0xfe,
+	0x81, 0xb2, 0x21, 0x49, 0x92, 0x92, 0x02, 0x50, 0xb0, 0xda, 0xd2, 0xcd, 0x6d, 0x74, 0x7b, 0x2e,
+	0xc5, 0xcf, 0x94, 0x56, 0x5b, 0xde, 0xbc, 0xd8, 0xde, 0xa4, 0xe0, 0xce, 0x4c, 0xb4, 0xa7, 0x27,
+	0x84, 0xfb, 0x68, 0x4f, 0x3b, 0xcb, 0xdb, 0xb8, 0x16, 0x58, 0x5f, 0xbf, 0x9c, 0x76, 0xb5, 0xb5,
+	0x46, 0x06, 0xa2, 0xcc, 0x58, 0x1a, 0xae, 0x85, 0x98, 0xa0, 0x76, 0xf5, 0x34, 0xd6, 0x57, 0x70,
+	0xa5, 0x43, 0x54, 0xce, 0x4f, 0xf6, 0x3f, 0x4e, 0x1d, 0xe3, 0xd7, 0xd4, 0x31, 0xdc, 0x18, 0x1d,
+	0xfe, 0x71, 0xa8, 0xff, 0x4a, 0xdc, 0x45, 0x6d, 0x39, 0x57, 0x6b, 0xa7, 0x22, 0x42, 0xb5, 0xd8,
+	0x34, 0x09, 0xce, 0xe7, 0x4b, 0xdb, 0x5c, 0x2c, 0x6d, 0xf3, 0xe7, 0xd2, 0x36, 0x3f, 0xaf, 0x6c,
+	0x63, 0xb1, 0xb2, 0x8d, 0x6f, 0x2b, 0xdb, 0x78, 0xf3, 0xe0, 0x9f, 0xc9, 0xdf, 0xab, 0x0f, 0x42,
+	0x1e, 0x20, 0xea, 0xc8, 0xf7, 0xfe, 0xe8, 0xf7, 0x00, 0x0e, 0xb1, 0xfe, 0x00, 0x9a, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +258,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenomMetadata) > 0 {
 		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -297,6 +371,43 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrozenAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -334,6 +445,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, e := range m.FrozenAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -356,6 +473,23 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *FrozenAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -526,6 +660,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, FrozenAddress{})
+			if err := m.FrozenAddresses[len(m.FrozenAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -663,6 +831,120 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FrozenAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -141,6 +141,40 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid frozen addresses",
+			GenesisState{
+				FrozenAddresses: []FrozenAddress{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom"},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "ustake"},
+				},
+			},
+			false,
+		},
+		{
+			"dup frozen addresses",
+			GenesisState{
+				FrozenAddresses: []FrozenAddress{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom"},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid frozen address",
+			GenesisState{
+				FrozenAddresses: []FrozenAddress{{Address: "cosmos1", Denom: "uatom"}},
+			},
+			true,
+		},
+		{
+			"invalid frozen denom",
+			GenesisState{
+				FrozenAddresses: []FrozenAddress{{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: ""}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
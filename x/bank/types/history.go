@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// BalanceHistory defines the node-local index of balance changes served by the
+// BalanceHistory gRPC method.
+type BalanceHistory interface {
+	// GetBalanceChanges returns the balance changes of addr, ordered by denom
+	// and then by height, restricted to denom if it is not empty.
+	GetBalanceChanges(addr sdk.AccAddress, denom string, pagination *query.PageRequest) ([]BalanceChange, *query.PageResponse, error)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple bank hooks, all hook functions are run in array sequence
+var _ BankHooks = &MultiBankHooks{}
+
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
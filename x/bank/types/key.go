@@ -25,6 +25,7 @@ var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
+	FrozenPrefix        = []byte{0x04}
 
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
@@ -67,3 +68,31 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateFrozenDenomPrefix creates the prefix of the accounts frozen for a
+// denomination.
+func CreateFrozenDenomPrefix(denom string) []byte {
+	// a null byte terminates the denom, like in the denom to address index
+	key := make([]byte, len(FrozenPrefix)+len(denom)+1)
+	copy(key, FrozenPrefix)
+	copy(key[len(FrozenPrefix):], denom)
+	return key
+}
+
+// CreateFrozenKey creates the key marking an account as frozen for a
+// denomination.
+func CreateFrozenKey(denom string, addr sdk.AccAddress) []byte {
+	return append(CreateFrozenDenomPrefix(denom), address.MustLengthPrefix(addr)...)
+}
+
+// AddressFromFrozenStore returns an account address from a frozen accounts
+// prefix store of a denom. The key must not contain the prefix of the denom.
+//
+// If invalid key is passed, AddressFromFrozenStore returns ErrInvalidKey.
+func AddressFromFrozenStore(key []byte) (sdk.AccAddress, error) {
+	if len(key) == 0 || len(key)-1 != int(key[0]) {
+		return nil, ErrInvalidKey
+	}
+
+	return key[1:], nil
+}
@@ -74,6 +74,12 @@ func (m Metadata) Validate() error {
 		return fmt.Errorf("metadata must contain a denomination unit with display denom '%s'", m.Display)
 	}
 
+	if m.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+			return fmt.Errorf("invalid metadata admin address: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -45,6 +45,34 @@ func TestMetadataValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid admin",
+			types.Metadata{
+				Name:    "Cosmos Hub Atom",
+				Symbol:  "ATOM",
+				Base:    "atom",
+				Display: "atom",
+				DenomUnits: []*types.DenomUnit{
+					{"atom", uint32(0), []string{"ATOM"}},
+				},
+				Admin: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+			},
+			false,
+		},
+		{
+			"invalid admin",
+			types.Metadata{
+				Name:    "Cosmos Hub Atom",
+				Symbol:  "ATOM",
+				Base:    "atom",
+				Display: "atom",
+				DenomUnits: []*types.DenomUnit{
+					{"atom", uint32(0), []string{"ATOM"}},
+				},
+				Admin: "cosmos1",
+			},
+			true,
+		},
 		{"empty metadata", types.Metadata{}, true},
 		{
 			"blank name",
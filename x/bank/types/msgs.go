@@ -1,19 +1,29 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend           = "send"
+	TypeMsgMultiSend      = "multisend"
+	TypeMsgFreeze         = "freeze"
+	TypeMsgUnfreeze       = "unfreeze"
+	TypeMsgSetSendEnabled = "set_send_enabled"
+	TypeMsgCreateDenom    = "create_denom"
+	TypeMsgMint           = "mint"
+	TypeMsgBurn           = "burn"
+	TypeMsgChangeAdmin    = "change_admin"
 )
 
 var _ sdk.Msg = &MsgSend{}
 
 // NewMsgSend - construct a msg to send coins from one account to another.
+//
 //nolint:interfacer
 func NewMsgSend(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) *MsgSend {
 	return &MsgSend{FromAddress: fromAddr.String(), ToAddress: toAddr.String(), Amount: amount}
@@ -57,6 +67,16 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{fromAddress}
 }
 
+// GetRecipients returns the address receiving the funds.
+func (msg MsgSend) GetRecipients() []string {
+	return []string{msg.ToAddress}
+}
+
+// GetTransferredCoins returns the coins sent.
+func (msg MsgSend) GetTransferredCoins() sdk.Coins {
+	return msg.Amount
+}
+
 var _ sdk.Msg = &MsgMultiSend{}
 
 // NewMsgMultiSend - construct arbitrary multi-in, multi-out send msg.
@@ -101,6 +121,315 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// GetRecipients returns the addresses of the outputs.
+func (msg MsgMultiSend) GetRecipients() []string {
+	recipients := make([]string, len(msg.Outputs))
+	for i, out := range msg.Outputs {
+		recipients[i] = out.Address
+	}
+
+	return recipients
+}
+
+// GetTransferredCoins returns the sum of the coins of the outputs.
+func (msg MsgMultiSend) GetTransferredCoins() sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, out := range msg.Outputs {
+		coins = coins.Add(out.Coins...)
+	}
+
+	return coins
+}
+
+var _ sdk.Msg = &MsgFreeze{}
+
+// NewMsgFreeze - construct a msg to prevent an account from sending a denom.
+//
+//nolint:interfacer
+func NewMsgFreeze(admin, addr sdk.AccAddress, denom string) *MsgFreeze {
+	return &MsgFreeze{Admin: admin.String(), Address: addr.String(), Denom: denom}
+}
+
+// Route Implements Msg.
+func (msg MsgFreeze) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgFreeze) Type() string { return TypeMsgFreeze }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFreeze) ValidateBasic() error {
+	return validateFreeze(msg.Admin, msg.Address, msg.Denom)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFreeze) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+var _ sdk.Msg = &MsgUnfreeze{}
+
+// NewMsgUnfreeze - construct a msg to allow a frozen account to send a denom again.
+//
+//nolint:interfacer
+func NewMsgUnfreeze(admin, addr sdk.AccAddress, denom string) *MsgUnfreeze {
+	return &MsgUnfreeze{Admin: admin.String(), Address: addr.String(), Denom: denom}
+}
+
+// Route Implements Msg.
+func (msg MsgUnfreeze) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUnfreeze) Type() string { return TypeMsgUnfreeze }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnfreeze) ValidateBasic() error {
+	return validateFreeze(msg.Admin, msg.Address, msg.Denom)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnfreeze) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+func validateFreeze(admin, addr, denom string) error {
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled - construct a msg to enable or disable the sends of a denom.
+//
+//nolint:interfacer
+func NewMsgSetSendEnabled(admin sdk.AccAddress, denom string, enabled bool) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{Admin: admin.String(), Denom: denom, Enabled: enabled}
+}
+
+// Route Implements Msg.
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+var _ sdk.Msg = &MsgCreateDenom{}
+
+// NewMsgCreateDenom - construct a msg to create a factory denom.
+//
+//nolint:interfacer
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender.String(), Subdenom: subdenom}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if strings.TrimSpace(msg.Subdenom) == "" {
+		return sdkerrors.Wrap(ErrInvalidFactoryDenom, "subdenom cannot be blank")
+	}
+
+	_, err := GetFactoryDenom(msg.Sender, msg.Subdenom)
+	return err
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMint{}
+
+// NewMsgMint - construct a msg to mint coins of a factory denom. An empty
+// mintTo address mints the coins to the sender.
+//
+//nolint:interfacer
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin, mintTo sdk.AccAddress) *MsgMint {
+	msg := &MsgMint{Sender: sender.String(), Amount: amount}
+	if !mintTo.Empty() {
+		msg.MintToAddress = mintTo.String()
+	}
+	return msg
+}
+
+// Route Implements Msg.
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.MintToAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+		}
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgBurn{}
+
+// NewMsgBurn - construct a msg to burn coins of a factory denom.
+//
+//nolint:interfacer
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{Sender: sender.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgChangeAdmin{}
+
+// NewMsgChangeAdmin - construct a msg to transfer the admin role of a denom.
+//
+//nolint:interfacer
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) *MsgChangeAdmin {
+	return &MsgChangeAdmin{Sender: sender.String(), Denom: denom, NewAdmin: newAdmin.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// ValidateBasic Implements Msg.
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
@@ -119,6 +448,7 @@ func (in Input) ValidateBasic() error {
 }
 
 // NewInput - create a transaction input, used with MsgMultiSend
+//
 //nolint:interfacer
 func NewInput(addr sdk.AccAddress, coins sdk.Coins) Input {
 	return Input{
@@ -145,6 +475,7 @@ func (out Output) ValidateBasic() error {
 }
 
 // NewOutput - create a transaction output, used with MsgMultiSend
+//
 //nolint:interfacer
 func NewOutput(addr sdk.AccAddress, coins sdk.Coins) Output {
 	return Output{
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -252,3 +253,80 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgFreezeValidation(t *testing.T) {
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         sdk.Msg
+	}{
+		{"", NewMsgFreeze(admin, addr, "atom")},
+		{"", NewMsgUnfreeze(admin, addr, "atom")},
+		{"", NewMsgSetSendEnabled(admin, "atom", false)},
+		{"invalid admin address: empty address string is not allowed: invalid address", NewMsgFreeze(addrEmpty, addr, "atom")},
+		{"invalid address: empty address string is not allowed: invalid address", NewMsgUnfreeze(admin, addrEmpty, "atom")},
+		{"invalid denom: : invalid request", NewMsgFreeze(admin, addr, "")},
+		{"invalid admin address: empty address string is not allowed: invalid address", NewMsgSetSendEnabled(addrEmpty, "atom", true)},
+		{"invalid denom: 1atom: invalid request", NewMsgSetSendEnabled(admin, "1atom", true)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			require.Equal(t, []sdk.AccAddress{admin}, tc.msg.GetSigners())
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgFactoryValidation(t *testing.T) {
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+	denom, err := GetFactoryDenom(admin.String(), "bitcoin")
+	require.NoError(t, err)
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         sdk.Msg
+	}{
+		{"", NewMsgCreateDenom(admin, "bitcoin")},
+		{"", NewMsgMint(admin, sdk.NewInt64Coin(denom, 10), nil)},
+		{"", NewMsgMint(admin, sdk.NewInt64Coin(denom, 10), addr)},
+		{"", NewMsgBurn(admin, sdk.NewInt64Coin(denom, 10))},
+		{"", NewMsgChangeAdmin(admin, denom, addr)},
+		{"invalid sender address: empty address string is not allowed: invalid address", NewMsgCreateDenom(addrEmpty, "bitcoin")},
+		{"subdenom cannot be blank: invalid factory denom", NewMsgCreateDenom(admin, " ")},
+		{"subdenom too long, max length is 44 bytes: invalid factory denom", NewMsgCreateDenom(admin, strings.Repeat("a", 45))},
+		{"invalid sender address: empty address string is not allowed: invalid address", NewMsgMint(addrEmpty, sdk.NewInt64Coin(denom, 10), nil)},
+		{"0" + denom + ": invalid coins", NewMsgMint(admin, sdk.NewInt64Coin(denom, 0), nil)},
+		{"invalid sender address: empty address string is not allowed: invalid address", NewMsgBurn(addrEmpty, sdk.NewInt64Coin(denom, 10))},
+		{"-10atom: invalid coins", NewMsgBurn(admin, sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-10)})},
+		{"invalid new admin address: empty address string is not allowed: invalid address", NewMsgChangeAdmin(admin, denom, addrEmpty)},
+		{"invalid denom: 1atom: invalid request", NewMsgChangeAdmin(admin, "1atom", addr)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			require.Equal(t, []sdk.AccAddress{admin}, tc.msg.GetSigners())
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgMintToAddress(t *testing.T) {
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+	coin := sdk.NewInt64Coin("atom", 10)
+
+	require.Empty(t, NewMsgMint(admin, coin, nil).MintToAddress)
+	require.Equal(t, addr.String(), NewMsgMint(admin, coin, addr).MintToAddress)
+}
@@ -20,6 +20,12 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeyDenomCreationFee is store's key for the DenomCreationFee param
+	KeyDenomCreationFee = []byte("DenomCreationFee")
+
+	// DefaultDenomCreationFee is the default fee to create a denom, denoms are
+	// free to create by default
+	DefaultDenomCreationFee = sdk.NewCoins()
 )
 
 // ParamKeyTable for bank module.
@@ -32,6 +38,7 @@ func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams) Par
 	return Params{
 		SendEnabled:        sendEnabledParams,
 		DefaultSendEnabled: defaultSendEnabled,
+		DenomCreationFee:   DefaultDenomCreationFee,
 	}
 }
 
@@ -41,6 +48,7 @@ func DefaultParams() Params {
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
 		DefaultSendEnabled: true,
+		DenomCreationFee:   DefaultDenomCreationFee,
 	}
 }
 
@@ -49,7 +57,10 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	return validateDenomCreationFee(p.DenomCreationFee)
 }
 
 // String implements the Stringer interface.
@@ -78,7 +89,8 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	p.SendEnabled = sendParams
+	return p
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -86,6 +98,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
 	}
 }
 
@@ -150,3 +163,11 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateDenomCreationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return fee.Validate()
+}
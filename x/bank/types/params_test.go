@@ -91,12 +91,12 @@ func Test_validateParams(t *testing.T) {
 	require.True(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
 	require.False(t, params.SendEnabledDenom("foodenom2"))
 
-	paramYaml := "default_send_enabled: true\nsend_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
+	paramYaml := "default_send_enabled: true\ndenom_creation_fee: []\nsend_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
 	require.Equal(t, paramYaml, params.String())
 
 	// Ensure proper format of yaml output when false
 	params.DefaultSendEnabled = false
-	paramYaml = "send_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
+	paramYaml = "denom_creation_fee: []\nsend_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
 	require.Equal(t, paramYaml, params.String())
 
 	params = NewParams(true, SendEnabledParams{
@@ -111,4 +111,8 @@ func Test_validateParams(t *testing.T) {
 	require.Error(t, validateSendEnabledParams(NewSendEnabled("foodenom", true)))
 
 	require.Error(t, validateSendEnabledParams(SendEnabledParams{NewSendEnabled("INVALIDDENOM", true)}))
+
+	require.NoError(t, validateDenomCreationFee(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.Error(t, validateDenomCreationFee(sdk.Coins{sdk.NewInt64Coin("stake", 0)}))
+	require.Error(t, validateDenomCreationFee(sdk.NewInt64Coin("stake", 10)))
 }
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn can restrict a transfer of coins and/or redirect it to a
+// new recipient. It is called before any coins are moved; a returned error
+// aborts the transfer, otherwise the coins are sent to the returned address.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+var _ SendRestrictionFn = NoOpSendRestrictionFn
+
+// NoOpSendRestrictionFn is a SendRestrictionFn which allows every transfer
+// without redirecting it.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then creates a composite restriction that runs this one then the provided
+// second one, which receives the recipient returned by the first.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	return ComposeSendRestrictions(r, second)
+}
+
+// ComposeSendRestrictions combines multiple restrictions into one. They are
+// run in order, each receiving the recipient returned by the previous one,
+// and the first error is returned. Nil restrictions are ignored.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	toRun := make([]SendRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		var err error
+		for _, r := range toRun {
+			toAddr, err = r(ctx, fromAddr, toAddr, amt)
+			if err != nil {
+				return toAddr, err
+			}
+		}
+		return toAddr, nil
+	}
+}
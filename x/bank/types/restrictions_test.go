@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+
+	var calls []string
+	redirect := func(name string, to sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, name)
+			return to, nil
+		}
+	}
+	reject := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "reject")
+		return nil, errors.New("rejected")
+	}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	to, err := types.ComposeSendRestrictions(nil, types.NoOpSendRestrictionFn)(sdk.Context{}, addr1, addr2, coins)
+	require.NoError(t, err)
+	require.Equal(t, addr2, to)
+
+	calls = nil
+	to, err = types.ComposeSendRestrictions(redirect("first", addr3), nil, redirect("second", addr1))(sdk.Context{}, addr1, addr2, coins)
+	require.NoError(t, err)
+	require.Equal(t, addr1, to)
+	require.Equal(t, []string{"first", "second"}, calls)
+
+	calls = nil
+	_, err = redirect("first", addr3).Then(reject).Then(redirect("second", addr1))(sdk.Context{}, addr1, addr2, coins)
+	require.EqualError(t, err, "rejected")
+	require.Equal(t, []string{"first", "reject"}, calls)
+}
@@ -10,10 +10,12 @@ var (
 	_ authz.Authorization = &SendAuthorization{}
 )
 
-// NewSendAuthorization creates a new SendAuthorization object.
-func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+// NewSendAuthorization creates a new SendAuthorization object. An empty allowed list
+// lets the grantee send to any address.
+func NewSendAuthorization(spendLimit sdk.Coins, allowed []sdk.AccAddress) *SendAuthorization {
 	return &SendAuthorization{
 		SpendLimit: spendLimit,
+		AllowList:  toBech32Addresses(allowed),
 	}
 }
 
@@ -28,6 +30,9 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 	if !ok {
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
+	if !a.isRecipientAllowed(mSend.ToAddress) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot send to %s address", mSend.ToAddress)
+	}
 	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount)
 	if isNegative {
 		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
@@ -36,7 +41,7 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
 
-	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft, AllowList: a.AllowList}}, nil
 }
 
 // ValidateBasic implements Authorization.ValidateBasic.
@@ -47,5 +52,40 @@ func (a SendAuthorization) ValidateBasic() error {
 	if !a.SpendLimit.IsAllPositive() {
 		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit cannot be negitive")
 	}
+
+	found := make(map[string]bool, len(a.AllowList))
+	for _, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed address %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("duplicate allowed address %s", addr)
+		}
+		found[addr] = true
+	}
 	return nil
 }
+
+// isRecipientAllowed returns true if the grantee may send to the given address.
+func (a SendAuthorization) isRecipientAllowed(addr string) bool {
+	if len(a.AllowList) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowList {
+		if allowed == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func toBech32Addresses(addrs []sdk.AccAddress) []string {
+	if len(addrs) == 0 {
+		return nil
+	}
+	bech32Addrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		bech32Addrs[i] = addr.String()
+	}
+	return bech32Addrs
+}
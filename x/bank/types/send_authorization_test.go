@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -21,7 +22,7 @@ var (
 func TestSendAuthorization(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
-	authorization := types.NewSendAuthorization(coins1000)
+	authorization := types.NewSendAuthorization(coins1000, nil)
 
 	t.Log("verify authorization returns valid method name")
 	require.Equal(t, authorization.MsgTypeURL(), "/cosmos.bank.v1beta1.MsgSend")
@@ -36,7 +37,7 @@ func TestSendAuthorization(t *testing.T) {
 	require.True(t, resp.Delete)
 	require.Nil(t, resp.Updated)
 
-	authorization = types.NewSendAuthorization(coins1000)
+	authorization = types.NewSendAuthorization(coins1000, nil)
 	require.Equal(t, authorization.MsgTypeURL(), "/cosmos.bank.v1beta1.MsgSend")
 	require.NoError(t, authorization.ValidateBasic())
 	send = types.NewMsgSend(fromAddr, toAddr, coins500)
@@ -47,7 +48,7 @@ func TestSendAuthorization(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, resp.Delete)
 	require.NotNil(t, resp.Updated)
-	sendAuth := types.NewSendAuthorization(coins500)
+	sendAuth := types.NewSendAuthorization(coins500, nil)
 	require.Equal(t, sendAuth.String(), resp.Updated.String())
 
 	t.Log("expect updated authorization nil after spending remaining amount")
@@ -56,3 +57,26 @@ func TestSendAuthorization(t *testing.T) {
 	require.True(t, resp.Delete)
 	require.Nil(t, resp.Updated)
 }
+
+func TestSendAuthorizationAllowList(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	otherAddr := sdk.AccAddress("_______other_______")
+	authorization := types.NewSendAuthorization(coins1000, []sdk.AccAddress{toAddr})
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify sending to an address outside of the allow list fails")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, otherAddr, coins500))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify the allow list is kept in the updated authorization")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.Equal(t, types.NewSendAuthorization(coins500, []sdk.AccAddress{toAddr}), resp.Updated)
+
+	t.Log("verify invalid allow lists are rejected")
+	authorization.AllowList = []string{"invalid"}
+	require.Error(t, authorization.ValidateBasic())
+	authorization.AllowList = []string{toAddr.String(), toAddr.String()}
+	require.Error(t, authorization.ValidateBasic())
+}
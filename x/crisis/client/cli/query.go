@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// Flag names and values
+const (
+	FlagConcurrent = "concurrent"
+)
+
+// GetQueryCmd returns the parent command for all x/crisis CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(GetCmdQueryCheckInvariants())
+
+	return cmd
+}
+
+// GetCmdQueryCheckInvariants returns a CLI command handler for checking the
+// registered invariants.
+func GetCmdQueryCheckInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants [route...]",
+		Short: "Check the registered invariants and report the broken ones",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check the registered invariants against the latest state, or the state at
+the --height flag, and report the result of each of them. The invariants are
+given by their full route, {module}/{route}, all of them being checked if none
+is given. The queried node must be started with the --x-crisis-invariants-query
+flag.
+
+Example:
+  $ %s query %s check-invariants
+  $ %s query %s check-invariants bank/total-supply staking/module-accounts --concurrent
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			concurrent, err := cmd.Flags().GetBool(FlagConcurrent)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CheckInvariants(cmd.Context(), &types.QueryCheckInvariantsRequest{
+				Routes:     args,
+				Concurrent: concurrent,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagConcurrent, false, "Run the invariants concurrently")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
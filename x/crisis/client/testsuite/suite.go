@@ -5,12 +5,14 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 type IntegrationTestSuite struct {
@@ -105,3 +107,27 @@ func (s *IntegrationTestSuite) TestNewMsgVerifyInvariantTxCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryCheckInvariants() {
+	val := s.network.Validators[0]
+
+	_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryCheckInvariants(), []string{"bank/unknown"})
+	s.Require().Error(err)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryCheckInvariants(), []string{
+		"bank/total-supply",
+		"staking/module-accounts",
+		fmt.Sprintf("--%s", cli.FlagConcurrent),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var res types.QueryCheckInvariantsResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Len(res.Results, 2)
+	s.Require().Equal("bank", res.Results[0].ModuleName)
+	s.Require().Equal("staking", res.Results[1].ModuleName)
+	for _, result := range res.Results {
+		s.Require().False(result.Broken, result.Message)
+	}
+}
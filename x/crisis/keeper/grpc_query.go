@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// CheckInvariants implements the Query/CheckInvariants gRPC method. It is only
+// enabled by SetInvariantsQuery, and each invariant is bounded by its gas limit.
+func (k Keeper) CheckInvariants(goCtx context.Context, req *types.QueryCheckInvariantsRequest) (*types.QueryCheckInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.invariantsQuery {
+		return nil, status.Error(codes.Unimplemented, "the invariants query is disabled on this node")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	results, err := k.ReportInvariants(ctx, req.Routes, req.Concurrent, k.invariantsQueryGasLimit)
+	if errors.Is(err, sdkerrors.ErrOutOfGas) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCheckInvariantsResponse{Results: results, Height: ctx.BlockHeight()}, nil
+}
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -17,6 +19,15 @@ type Keeper struct {
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
 
+	// logBrokenInvariants makes AssertInvariants log the broken invariants
+	// instead of panicking on the first one
+	logBrokenInvariants bool
+
+	// invariantsQuery enables the CheckInvariants query, whose invariants are
+	// each bounded by invariantsQueryGasLimit
+	invariantsQuery         bool
+	invariantsQueryGasLimit uint64
+
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
@@ -34,11 +45,12 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		routes:           make([]types.InvarRoute, 0),
-		paramSpace:       paramSpace,
-		invCheckPeriod:   invCheckPeriod,
-		supplyKeeper:     supplyKeeper,
-		feeCollectorName: feeCollectorName,
+		routes:                  make([]types.InvarRoute, 0),
+		paramSpace:              paramSpace,
+		invCheckPeriod:          invCheckPeriod,
+		invariantsQueryGasLimit: types.DefaultInvariantsQueryGasLimit,
+		supplyKeeper:            supplyKeeper,
+		feeCollectorName:        feeCollectorName,
 	}
 }
 
@@ -67,8 +79,24 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
+// SetLogBrokenInvariants sets whether AssertInvariants and VerifyInvariant log
+// the broken invariants and continue, instead of panicking.
+func (k *Keeper) SetLogBrokenInvariants(logBroken bool) {
+	k.logBrokenInvariants = logBroken
+}
+
+// SetInvariantsQuery sets whether the CheckInvariants query is enabled, and the
+// gas limit of each invariant it checks. A gasLimit of 0 keeps
+// types.DefaultInvariantsQueryGasLimit.
+func (k *Keeper) SetInvariantsQuery(enabled bool, gasLimit uint64) {
+	k.invariantsQuery = enabled
+	if gasLimit > 0 {
+		k.invariantsQueryGasLimit = gasLimit
+	}
+}
+
 // AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// the method panics, unless the keeper is set to log the broken invariants.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
@@ -78,6 +106,11 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i, "/", n), "name", ir.FullRoute())
 		if res, stop := ir.Invar(ctx); stop {
+			if k.logBrokenInvariants {
+				logger.Error("invariant broken", "name", ir.FullRoute(), "height", ctx.BlockHeight(), "msg", res)
+				continue
+			}
+
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
@@ -90,6 +123,119 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// ReportInvariants checks the invariants of the given full routes, or all the
+// registered invariants if routes is empty, and returns their results in the
+// order in which they are registered. Each invariant runs on its own branch of
+// ctx, whose writes are discarded, and an invariant which panics is reported
+// as broken. If gasLimit is not 0, an invariant consuming more gas fails the
+// whole report with sdkerrors.ErrOutOfGas.
+//
+// If concurrent is true, the invariants run concurrently. The branches then
+// read ctx concurrently, which is only safe if ctx has no pending writes, as
+// for the committed state queried by gRPC.
+func (k Keeper) ReportInvariants(ctx sdk.Context, routes []string, concurrent bool, gasLimit uint64) ([]types.InvariantResult, error) {
+	invarRoutes, err := k.selectRoutes(routes)
+	if err != nil {
+		return nil, err
+	}
+
+	// branch the stores upfront, so that the goroutines only read ctx
+	branches := make([]sdk.CacheMultiStore, len(invarRoutes))
+	for i := range invarRoutes {
+		branches[i] = ctx.MultiStore().CacheMultiStore()
+	}
+
+	results := make([]types.InvariantResult, len(invarRoutes))
+	errs := make([]error, len(invarRoutes))
+	if !concurrent {
+		for i, ir := range invarRoutes {
+			results[i], errs[i] = checkInvariant(ctx.WithMultiStore(branches[i]), ir, gasLimit)
+			if errs[i] != nil {
+				return nil, errs[i]
+			}
+		}
+
+		return results, nil
+	}
+
+	var wg sync.WaitGroup
+	for i, ir := range invarRoutes {
+		wg.Add(1)
+		go func(i int, ir types.InvarRoute) {
+			defer wg.Done()
+			results[i], errs[i] = checkInvariant(ctx.WithMultiStore(branches[i]), ir, gasLimit)
+		}(i, ir)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// selectRoutes returns the registered invariant routes of the given full
+// routes, in the order in which they are registered, or all of them if routes
+// is empty.
+func (k Keeper) selectRoutes(routes []string) ([]types.InvarRoute, error) {
+	if len(routes) == 0 {
+		return k.Routes(), nil
+	}
+
+	selected := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		selected[route] = false
+	}
+
+	var invarRoutes []types.InvarRoute
+	for _, ir := range k.Routes() {
+		if _, ok := selected[ir.FullRoute()]; ok {
+			selected[ir.FullRoute()] = true
+			invarRoutes = append(invarRoutes, ir)
+		}
+	}
+
+	for _, route := range routes {
+		if !selected[route] {
+			return nil, sdkerrors.Wrap(types.ErrUnknownInvariant, route)
+		}
+	}
+
+	return invarRoutes, nil
+}
+
+// checkInvariant runs the invariant of ir with its own gas meter, limited to
+// gasLimit unless it is 0, and event manager, recovering from a panic of the
+// invariant. It returns an error if the invariant runs out of gas.
+func checkInvariant(ctx sdk.Context, ir types.InvarRoute, gasLimit uint64) (res types.InvariantResult, err error) {
+	res = types.InvariantResult{ModuleName: ir.ModuleName, Route: ir.Route}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "invariant %s exceeded the gas limit of %d: %s", ir.FullRoute(), gasLimit, oog.Descriptor)
+				return
+			}
+
+			res.Broken = true
+			res.Message = fmt.Sprintf("panic: %v", r)
+		}
+	}()
+
+	gasMeter := sdk.NewInfiniteGasMeter()
+	if gasLimit > 0 {
+		gasMeter = sdk.NewGasMeter(gasLimit)
+	}
+
+	ctx = ctx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+	res.Message, res.Broken = ir.Invar(ctx)
+
+	return res, nil
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
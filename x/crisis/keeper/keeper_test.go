@@ -1,14 +1,21 @@
 package keeper_test
 
 import (
+	gocontext "context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -44,3 +51,145 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestAssertInvariantsLogBroken(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	checked := false
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { checked = true; return "", false })
+
+	app.CrisisKeeper.SetLogBrokenInvariants(true)
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+	require.True(t, checked)
+
+	app.CrisisKeeper.SetLogBrokenInvariants(false)
+	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+}
+
+func TestReportInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+	storeKey := app.GetKey(banktypes.StoreKey)
+	numRoutes := len(app.CrisisKeeper.Routes())
+
+	app.CrisisKeeper.RegisterRoute("testModule", "broken", func(sdk.Context) (string, bool) { return "broken", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "panic", func(sdk.Context) (string, bool) { panic("oops") })
+	app.CrisisKeeper.RegisterRoute("testModule", "write", func(ctx sdk.Context) (string, bool) {
+		ctx.KVStore(storeKey).Set([]byte("written"), []byte{1})
+		return "", false
+	})
+
+	for _, concurrent := range []bool{false, true} {
+		results, err := app.CrisisKeeper.ReportInvariants(ctx, nil, concurrent, 0)
+		require.NoError(t, err)
+		require.Len(t, results, numRoutes+3)
+		for _, res := range results[:numRoutes] {
+			require.False(t, res.Broken, "%s/%s: %s", res.ModuleName, res.Route, res.Message)
+		}
+		require.Equal(t, []types.InvariantResult{
+			{ModuleName: "testModule", Route: "broken", Broken: true, Message: "broken"},
+			{ModuleName: "testModule", Route: "panic", Broken: true, Message: "panic: oops"},
+			{ModuleName: "testModule", Route: "write"},
+		}, results[numRoutes:])
+
+		// the writes of the invariants are discarded
+		require.False(t, ctx.KVStore(storeKey).Has([]byte("written")))
+	}
+
+	results, err := app.CrisisKeeper.ReportInvariants(ctx, []string{"testModule/write", "bank/total-supply", "testModule/broken"}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, []types.InvariantResult{
+		{ModuleName: "bank", Route: "total-supply", Message: results[0].Message},
+		{ModuleName: "testModule", Route: "broken", Broken: true, Message: "broken"},
+		{ModuleName: "testModule", Route: "write"},
+	}, results)
+
+	_, err = app.CrisisKeeper.ReportInvariants(ctx, []string{"testModule/unknown"}, false, 0)
+	require.ErrorIs(t, err, types.ErrUnknownInvariant)
+
+	app.CrisisKeeper.RegisterRoute("testModule", "gas", func(ctx sdk.Context) (string, bool) {
+		ctx.GasMeter().ConsumeGas(1000, "test")
+		return "", false
+	})
+	for _, concurrent := range []bool{false, true} {
+		_, err = app.CrisisKeeper.ReportInvariants(ctx, []string{"testModule/broken", "testModule/gas"}, concurrent, 999)
+		require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+
+		results, err = app.CrisisKeeper.ReportInvariants(ctx, []string{"testModule/broken", "testModule/gas"}, concurrent, 1000)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+	}
+}
+
+func TestGRPCCheckInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CrisisKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// the query is disabled by default
+	_, err := queryClient.CheckInvariants(gocontext.Background(), &types.QueryCheckInvariantsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	app.CrisisKeeper.SetInvariantsQuery(true, 1)
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CrisisKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	_, err = queryClient.CheckInvariants(gocontext.Background(), &types.QueryCheckInvariantsRequest{Routes: []string{"bank/total-supply"}})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	app.CrisisKeeper.SetInvariantsQuery(true, types.DefaultInvariantsQueryGasLimit)
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CrisisKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	_, err = queryClient.CheckInvariants(gocontext.Background(), &types.QueryCheckInvariantsRequest{Routes: []string{"bank"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := queryClient.CheckInvariants(gocontext.Background(), &types.QueryCheckInvariantsRequest{
+		Routes:     []string{"bank/total-supply", "bank/nonnegative-outstanding"},
+		Concurrent: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, app.LastBlockHeight(), res.Height)
+	require.Len(t, res.Results, 2)
+	for _, result := range res.Results {
+		require.Equal(t, "bank", result.ModuleName)
+		require.False(t, result.Broken)
+	}
+}
+
+func TestVerifyInvariantLogBroken(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(false, tmproto.Header{})
+	sender := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10000))[0]
+	app.CrisisKeeper.RegisterRoute("testModule", "broken", func(sdk.Context) (string, bool) { return "broken", true })
+
+	msg := types.NewMsgVerifyInvariant(sender, "testModule", "broken")
+	require.Panics(t, func() { _, _ = app.CrisisKeeper.VerifyInvariant(sdk.WrapSDKContext(ctx), msg) })
+
+	app.CrisisKeeper.SetLogBrokenInvariants(true)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := app.CrisisKeeper.VerifyInvariant(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	require.Equal(t, types.EventTypeInvariant, events[len(events)-2].Type)
+	require.Contains(t, events[len(events)-2].Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyBroken), Value: []byte("true")})
+}
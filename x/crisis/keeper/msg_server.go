@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -42,7 +43,9 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 		return nil, types.ErrUnknownInvariant
 	}
 
-	if stop {
+	if stop && k.logBrokenInvariants {
+		k.Logger(ctx).Error("invariant broken", "name", msgFullRoute, "height", ctx.BlockHeight(), "msg", res)
+	} else if stop {
 		// Currently, because the chain halts here, this transaction will never be included in the
 		// blockchain thus the constant fee will have never been deducted. Thus no refund is required.
 
@@ -54,6 +57,7 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 		sdk.NewEvent(
 			types.EventTypeInvariant,
 			sdk.NewAttribute(types.AttributeKeyRoute, msg.InvariantRoute),
+			sdk.NewAttribute(types.AttributeKeyBroken, strconv.FormatBool(stop)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -1,6 +1,7 @@
 package crisis
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -29,6 +30,9 @@ var (
 // Module init related flags
 const (
 	FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+	FlagLogBrokenInvariants   = "x-crisis-log-broken-invariants"
+	FlagInvariantsQuery       = "x-crisis-invariants-query"
+	FlagInvariantsQueryGas    = "x-crisis-invariants-query-gas-limit"
 )
 
 // AppModuleBasic defines the basic application module used by the crisis module.
@@ -64,16 +68,22 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 // Deprecated: RegisterRESTRoutes is deprecated.
 func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the capability module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the crisis module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns the root tx command for the crisis module.
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd()
 }
 
-// GetQueryCmd returns no root query command for the crisis module.
-func (AppModuleBasic) GetQueryCmd() *cobra.Command { return nil }
+// GetQueryCmd returns the root query command for the crisis module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
 
 // RegisterInterfaces registers interfaces and implementations of the crisis
 // module.
@@ -109,6 +119,9 @@ func NewAppModule(keeper *keeper.Keeper, skipGenesisInvariants bool) AppModule {
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Bool(FlagSkipGenesisInvariants, false, "Skip x/crisis invariants check on startup")
+	startCmd.Flags().Bool(FlagLogBrokenInvariants, false, "Log the broken x/crisis invariants instead of halting the node")
+	startCmd.Flags().Bool(FlagInvariantsQuery, false, "Enable the x/crisis CheckInvariants query, which runs the invariants against the whole state")
+	startCmd.Flags().Uint64(FlagInvariantsQueryGas, types.DefaultInvariantsQueryGasLimit, "Gas limit of each invariant run by the x/crisis CheckInvariants query")
 }
 
 // Name returns the crisis module's name.
@@ -133,6 +146,7 @@ func (AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier { return n
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
 // InitGenesis performs genesis initialization for the crisis module. It returns
@@ -6,4 +6,5 @@ const (
 
 	AttributeValueCrisis = ModuleName
 	AttributeKeyRoute    = "route"
+	AttributeKeyBroken   = "broken"
 )
@@ -4,3 +4,7 @@ const (
 	// module name
 	ModuleName = "crisis"
 )
+
+// DefaultInvariantsQueryGasLimit is the default gas limit of each invariant
+// checked by the CheckInvariants query.
+const DefaultInvariantsQueryGasLimit uint64 = 100_000_000
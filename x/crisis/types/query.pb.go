@@ -0,0 +1,1020 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryCheckInvariantsRequest is the request type for the Query/CheckInvariants
+// RPC method.
+//
+// Since: cosmos-sdk 0.46
+type QueryCheckInvariantsRequest struct {
+	// routes are the full routes, {module}/{route}, of the invariants to check.
+	// All the registered invariants are checked if it is empty.
+	Routes []string `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// concurrent runs the invariants concurrently, each on its own branch of the
+	// state.
+	Concurrent bool `protobuf:"varint,2,opt,name=concurrent,proto3" json:"concurrent,omitempty"`
+}
+
+func (m *QueryCheckInvariantsRequest) Reset()         { *m = QueryCheckInvariantsRequest{} }
+func (m *QueryCheckInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckInvariantsRequest) ProtoMessage()    {}
+func (*QueryCheckInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{0}
+}
+func (m *QueryCheckInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckInvariantsRequest.Merge(m, src)
+}
+func (m *QueryCheckInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckInvariantsRequest proto.InternalMessageInfo
+
+func (m *QueryCheckInvariantsRequest) GetRoutes() []string {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QueryCheckInvariantsRequest) GetConcurrent() bool {
+	if m != nil {
+		return m.Concurrent
+	}
+	return false
+}
+
+// QueryCheckInvariantsResponse is the response type for the
+// Query/CheckInvariants RPC method.
+//
+// Since: cosmos-sdk 0.46
+type QueryCheckInvariantsResponse struct {
+	// results are the results of the checked invariants, in the order in which
+	// they are registered.
+	Results []InvariantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// height is the height of the checked state.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryCheckInvariantsResponse) Reset()         { *m = QueryCheckInvariantsResponse{} }
+func (m *QueryCheckInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckInvariantsResponse) ProtoMessage()    {}
+func (*QueryCheckInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{1}
+}
+func (m *QueryCheckInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckInvariantsResponse.Merge(m, src)
+}
+func (m *QueryCheckInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryCheckInvariantsResponse) GetResults() []InvariantResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryCheckInvariantsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// InvariantResult is the result of checking an invariant.
+//
+// Since: cosmos-sdk 0.46
+type InvariantResult struct {
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Route      string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// broken is true if the invariant is broken.
+	Broken bool `protobuf:"varint,3,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message is the message returned by the invariant, or the recovered panic
+	// if the invariant panicked.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{2}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryCheckInvariantsRequest)(nil), "cosmos.crisis.v1beta1.QueryCheckInvariantsRequest")
+	proto.RegisterType((*QueryCheckInvariantsResponse)(nil), "cosmos.crisis.v1beta1.QueryCheckInvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "cosmos.crisis.v1beta1.InvariantResult")
+}
+
+func init() { proto.RegisterFile("cosmos/crisis/v1beta1/query.proto", fileDescriptor_3ca16352ca9a50b9) }
+
+var fileDescriptor_3ca16352ca9a50b9 = []byte{
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x6a, 0x14, 0x41,
+	0x10, 0xde, 0xce, 0xe6, 0xc7, 0xed, 0x1c, 0x02, 0x4d, 0x94, 0x61, 0x0d, 0x93, 0x75, 0x0e, 0xba,
+	0x22, 0x4e, 0x93, 0xcd, 0x1b, 0x44, 0x14, 0xbc, 0x08, 0x36, 0x78, 0xf1, 0x12, 0x7a, 0x26, 0xc5,
+	0x4c, 0xb3, 0x3b, 0x5d, 0x93, 0xee, 0x9e, 0x60, 0x3c, 0x78, 0xf0, 0x09, 0x04, 0x1f, 0xc3, 0xb3,
+	0xef, 0x90, 0x63, 0xc0, 0x8b, 0x27, 0x91, 0x5d, 0x1f, 0x44, 0xa6, 0x7b, 0x76, 0x91, 0x65, 0x15,
+	0x3c, 0xcd, 0x7c, 0xd5, 0xdf, 0xf7, 0x75, 0x7d, 0xd5, 0x45, 0x1f, 0xe4, 0x68, 0x2b, 0xb4, 0x3c,
+	0x37, 0xca, 0x2a, 0xcb, 0xaf, 0x4e, 0x32, 0x70, 0xf2, 0x84, 0x5f, 0x36, 0x60, 0xae, 0xd3, 0xda,
+	0xa0, 0x43, 0x76, 0x37, 0x50, 0xd2, 0x40, 0x49, 0x3b, 0xca, 0xf0, 0xb0, 0xc0, 0x02, 0x3d, 0x83,
+	0xb7, 0x7f, 0x81, 0x3c, 0x3c, 0x2a, 0x10, 0x8b, 0x19, 0x70, 0x59, 0x2b, 0x2e, 0xb5, 0x46, 0x27,
+	0x9d, 0x42, 0x6d, 0xc3, 0x69, 0xf2, 0x86, 0xde, 0x7f, 0xdd, 0x3a, 0x3f, 0x2b, 0x21, 0x9f, 0xbe,
+	0xd4, 0x57, 0xd2, 0x28, 0xa9, 0x9d, 0x15, 0x70, 0xd9, 0x80, 0x75, 0xec, 0x1e, 0xdd, 0x35, 0xd8,
+	0x38, 0xb0, 0x11, 0x19, 0xf5, 0xc7, 0x03, 0xd1, 0x21, 0x16, 0x53, 0x9a, 0xa3, 0xce, 0x1b, 0x63,
+	0x40, 0xbb, 0x68, 0x6b, 0x44, 0xc6, 0x77, 0xc4, 0x1f, 0x95, 0xe4, 0x03, 0x3d, 0xda, 0x6c, 0x6b,
+	0x6b, 0xd4, 0x16, 0xd8, 0x0b, 0xba, 0x67, 0xc0, 0x36, 0x33, 0x17, 0x8c, 0xf7, 0x27, 0x0f, 0xd3,
+	0x8d, 0x99, 0xd2, 0x95, 0x56, 0x78, 0xfa, 0xd9, 0xf6, 0xcd, 0x8f, 0xe3, 0x9e, 0x58, 0x8a, 0xdb,
+	0xfe, 0x4a, 0x50, 0x45, 0x19, 0x7a, 0xe8, 0x8b, 0x0e, 0x25, 0xef, 0xe9, 0xc1, 0x9a, 0x92, 0x1d,
+	0xd3, 0xfd, 0x0a, 0x2f, 0x9a, 0x19, 0x9c, 0x6b, 0x59, 0x41, 0x44, 0x46, 0x64, 0x3c, 0x10, 0x34,
+	0x94, 0x5e, 0xc9, 0x0a, 0xd8, 0x21, 0xdd, 0xf1, 0xe9, 0xbc, 0xd5, 0x40, 0x04, 0xd0, 0xde, 0x90,
+	0x19, 0x9c, 0x82, 0x8e, 0xfa, 0x3e, 0x65, 0x87, 0x58, 0x44, 0xf7, 0x2a, 0xb0, 0x56, 0x16, 0x10,
+	0x6d, 0x7b, 0xfe, 0x12, 0x4e, 0xbe, 0x12, 0xba, 0xe3, 0xc3, 0xb3, 0x2f, 0x84, 0x1e, 0xac, 0x4d,
+	0x80, 0x4d, 0xfe, 0x12, 0xf4, 0x1f, 0xaf, 0x30, 0x3c, 0xfd, 0x2f, 0x4d, 0x18, 0x71, 0xc2, 0x3f,
+	0x7e, 0xfb, 0xf5, 0x79, 0xeb, 0x31, 0x7b, 0xc4, 0x37, 0x2f, 0x54, 0xde, 0xea, 0xce, 0xd5, 0x4a,
+	0x78, 0xf6, 0xfc, 0x66, 0x1e, 0x93, 0xdb, 0x79, 0x4c, 0x7e, 0xce, 0x63, 0xf2, 0x69, 0x11, 0xf7,
+	0x6e, 0x17, 0x71, 0xef, 0xfb, 0x22, 0xee, 0xbd, 0x7d, 0x52, 0x28, 0x57, 0x36, 0x59, 0x9a, 0x63,
+	0xb5, 0x32, 0xf3, 0x9f, 0xa7, 0xf6, 0x62, 0xca, 0xdf, 0x2d, 0x9d, 0xdd, 0x75, 0x0d, 0x36, 0xdb,
+	0xf5, 0x8b, 0x75, 0xfa, 0x7b, 0x00, 0x97, 0xee, 0x7a, 0x45, 0xc8, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// CheckInvariants runs the registered invariants against the queried state
+	// and reports the result of each of them, without halting on a broken one.
+	//
+	// Since: cosmos-sdk 0.46
+	CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) CheckInvariants(ctx context.Context, in *QueryCheckInvariantsRequest, opts ...grpc.CallOption) (*QueryCheckInvariantsResponse, error) {
+	out := new(QueryCheckInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/CheckInvariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// CheckInvariants runs the registered invariants against the queried state
+	// and reports the result of each of them, without halting on a broken one.
+	//
+	// Since: cosmos-sdk 0.46
+	CheckInvariants(context.Context, *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) CheckInvariants(ctx context.Context, req *QueryCheckInvariantsRequest) (*QueryCheckInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInvariants not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_CheckInvariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckInvariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/CheckInvariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckInvariants(ctx, req.(*QueryCheckInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckInvariants",
+			Handler:    _Query_CheckInvariants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/query.proto",
+}
+
+func (m *QueryCheckInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Concurrent {
+		i--
+		if m.Concurrent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Routes[iNdEx])
+			copy(dAtA[i:], m.Routes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Routes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCheckInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, s := range m.Routes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Concurrent {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCheckInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCheckInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Concurrent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, InvariantResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
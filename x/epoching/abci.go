@@ -0,0 +1,19 @@
+package epoching
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndBlocker executes the queued msgs if the current block ends an epoch.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if k.IsEpochEnd(ctx) {
+		k.EndEpoch(ctx)
+	}
+}
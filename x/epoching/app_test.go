@@ -0,0 +1,44 @@
+package epoching_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDirectStakingMsgResponse(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+
+	acc := &authtypes.BaseAccount{Address: addr.String()}
+	app := simapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{acc}, banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(coin)})
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+
+	// the TxMsgData of a MsgDelegate sent directly holds a MsgDelegateResponse,
+	// although the msg is queued until the end of the epoch
+	msg := stakingtypes.NewMsgDelegate(addr, valAddr, coin)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	_, res, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{msg}, "", []uint64{app.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()}, []uint64{0}, true, true, priv)
+	require.NoError(t, err)
+
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, app.AppCodec().Unmarshal(res.Data, &txMsgData))
+	require.Len(t, txMsgData.Data, 1)
+	require.Equal(t, sdk.MsgTypeURL(msg), txMsgData.Data[0].MsgType)
+
+	var delegateRes stakingtypes.MsgDelegateResponse
+	require.NoError(t, app.AppCodec().Unmarshal(txMsgData.Data[0].Data, &delegateRes))
+	require.Equal(t, []sdk.Msg{msg}, app.EpochingKeeper.GetEpochActions(app.BaseApp.NewContext(true, header)))
+}
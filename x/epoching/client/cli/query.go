@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// FlagEpochNumber is the flag restricting the queued msgs query to one epoch.
+const FlagEpochNumber = "epoch-number"
+
+// GetQueryCmd returns the cli query commands for the epoching module.
+func GetQueryCmd() *cobra.Command {
+	epochingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epoching module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochingQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryQueuedMsgs(),
+	)
+
+	return epochingQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current epoching
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current epoching parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements a command to return the current epoch
+// number and its estimated end.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch",
+		Short: "Query the current epoch number and when it ends",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQueuedMsgs implements a command to return the msgs queued for
+// execution at the end of an epoch.
+func GetCmdQueryQueuedMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-msgs",
+		Short: "Query the msgs queued for execution at the end of an epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNumber, err := cmd.Flags().GetString(FlagEpochNumber)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQueuedMsgsRequest{}
+			if epochNumber != "" {
+				req.EpochNumber, err = strconv.ParseInt(epochNumber, 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedMsgs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagEpochNumber, "", "Only return the msgs queued on the given epoch")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued-msgs")
+
+	return cmd
+}
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTxCmd returns the transaction commands for the epoching module.
+func GetTxCmd() *cobra.Command {
+	epochingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Epoching transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochingTxCmd.AddCommand(
+		NewDelegateCmd(),
+		NewRedelegateCmd(),
+		NewUnbondCmd(),
+	)
+
+	return epochingTxCmd
+}
+
+// NewDelegateCmd returns a CLI command handler for creating a MsgWrappedDelegate transaction.
+func NewDelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegate [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Delegate liquid tokens to a validator at the end of the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queue a delegation of an amount of liquid coins to a validator from your wallet.
+The delegation is executed at the end of the current epoch.
+
+Example:
+$ %s tx epoching delegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr, amount))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedelegateCmd returns a CLI command handler for creating a MsgWrappedBeginRedelegate transaction.
+func NewRedelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Redelegate illiquid tokens from one validator to another at the end of the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queue a redelegation of an amount of illiquid staking tokens from one validator to another.
+The redelegation is executed at the end of the current epoch.
+
+Example:
+$ %s tx epoching redelegate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 100stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valDstAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(delAddr, valSrcAddr, valDstAddr, amount))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnbondCmd returns a CLI command handler for creating a MsgWrappedUndelegate transaction.
+func NewUnbondCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbond [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unbond shares from a validator at the end of the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queue an unbonding of an amount of bonded shares from a validator.
+The unbonding is started at the end of the current epoch.
+
+Example:
+$ %s tx epoching unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, valAddr, amount))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
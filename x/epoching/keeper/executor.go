@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// IsEpochEnd returns true if the current block is the last block of an epoch.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return ctx.BlockHeight()%k.EpochLength(ctx) == 0
+}
+
+// ExecuteEpochActions executes all the queued actions in the order they were
+// queued and removes them from the queue. Every action is executed atomically
+// in its own cached context: the state changes of an action are only committed
+// if its handler succeeds. A failing action does not affect the other ones.
+// The coins escrowed for a queued delegation are returned to the delegator
+// before the delegation is executed.
+func (k Keeper) ExecuteEpochActions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the keys first so that the queue is not mutated while iterating
+	var keys [][]byte
+	iterator := k.GetEpochActionsIterator(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		epochNumber, actionID := types.SplitActionStoreKey(key)
+
+		var msg sdk.Msg
+		err := k.cdc.UnmarshalInterface(store.Get(key), &msg)
+		store.Delete(key)
+		if err != nil {
+			// the msg type has been unregistered since it was queued
+			k.Logger(ctx).Error("failed to decode queued msg", "epoch", epochNumber, "action", actionID, "err", err)
+			continue
+		}
+
+		k.releaseEscrow(ctx, msg)
+
+		var errMsg string
+		if err := k.executeMsg(ctx, msg); err != nil {
+			errMsg = err.Error()
+			k.Logger(ctx).Info("queued msg failed", "epoch", epochNumber, "action", actionID, "err", err)
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventExecQueuedMsg{
+			EpochNumber: epochNumber,
+			ActionId:    actionID,
+			MsgTypeUrl:  sdk.MsgTypeURL(msg),
+			Error:       errMsg,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// executeMsg dispatches msg through the MsgServiceRouter in a cached context
+// and commits its state changes on success. The cached context is marked as
+// an epoch execution, so that the staking msgs reach the staking module
+// instead of being queued again.
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) error {
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithValue(epochExecutionKey{}, true)
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
+
+// EndEpoch executes the actions queued during the current epoch and moves to
+// the next epoch.
+func (k Keeper) EndEpoch(ctx sdk.Context) {
+	k.ExecuteEpochActions(ctx)
+
+	epochNumber := k.GetEpochNumber(ctx)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEndEpoch{EpochNumber: epochNumber}); err != nil {
+		panic(err)
+	}
+
+	k.IncreaseEpochNumber(ctx)
+}
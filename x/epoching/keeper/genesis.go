@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state. The queued msgs are queued on the current epoch.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	epochNumber := k.GetEpochNumber(ctx)
+	for _, action := range data.QueuedMsgs {
+		k.RestoreEpochAction(ctx, epochNumber, action)
+	}
+}
+
+// ExportGenesis returns the epoching module's exported genesis. The queued
+// msgs are exported without their epoch number.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var queuedMsgs []*codectypes.Any
+	iterator := k.GetEpochActionsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		any, err := codectypes.NewAnyWithValue(k.GetEpochActionByIterator(iterator))
+		if err != nil {
+			panic(err)
+		}
+		queuedMsgs = append(queuedMsgs, any)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), queuedMsgs)
+}
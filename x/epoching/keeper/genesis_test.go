@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestExportImportGenesis() {
+	k := s.app.EpochingKeeper
+	k.SetParams(s.ctx, types.NewParams(7))
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, coin)
+	k.QueueMsgForEpoch(s.ctx, k.GetEpochNumber(s.ctx), msg)
+
+	genesis := k.ExportGenesis(s.ctx)
+	s.Require().Equal(types.NewParams(7), genesis.Params)
+	s.Require().Len(genesis.QueuedMsgs, 1)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+
+	// import on a later epoch: the msgs are queued on the current epoch
+	k.DequeueEpochActions(s.ctx)
+	k.SetEpochNumber(s.ctx, 4)
+	k.InitGenesis(s.ctx, genesis)
+
+	s.Require().Equal(int64(7), k.EpochLength(s.ctx))
+	res, err := s.queryClient.QueuedMsgs(sdk.WrapSDKContext(s.ctx), &types.QueryQueuedMsgsRequest{EpochNumber: 4})
+	s.Require().NoError(err)
+	s.Require().Len(res.Msgs, 1)
+	s.Require().Equal(msg, res.Msgs[0].GetSdkMsg())
+}
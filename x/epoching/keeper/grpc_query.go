@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the params of the epoching module
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// CurrentEpoch returns the current epoch number and the estimated end of the epoch
+func (k Keeper) CurrentEpoch(goCtx context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epochLength := k.EpochLength(ctx)
+
+	return &types.QueryCurrentEpochResponse{
+		EpochNumber:     k.GetEpochNumber(ctx),
+		NextEpochHeight: k.GetNextEpochHeight(ctx, epochLength),
+		NextEpochTime:   k.GetNextEpochTime(ctx, epochLength),
+	}, nil
+}
+
+// QueuedMsgs returns the msgs queued for execution at the end of an epoch
+func (k Keeper) QueuedMsgs(goCtx context.Context, req *types.QueryQueuedMsgsRequest) (*types.QueryQueuedMsgsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keyPrefix := types.EpochActionQueuePrefix
+	if req.EpochNumber != 0 {
+		keyPrefix = types.EpochActionsPrefix(req.EpochNumber)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	var msgs []types.QueuedMsg
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var msg sdk.Msg
+		if err := k.cdc.UnmarshalInterface(value, &msg); err != nil {
+			return err
+		}
+
+		epochNumber, actionID := types.SplitActionStoreKey(append(append([]byte{}, keyPrefix...), key...))
+		queued, err := types.NewQueuedMsg(epochNumber, actionID, msg)
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, queued)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQueuedMsgsResponse{Msgs: msgs, Pagination: pageRes}, nil
+}
@@ -3,11 +3,16 @@ package keeper
 import (
 	"time"
 
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
@@ -15,16 +20,15 @@ const (
 	DefaultEpochNumber   = 0
 )
 
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
-)
-
 // Keeper of the store
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	paramSpace    paramtypes.Subspace
+	stakingKeeper types.StakingKeeper
+	bankKeeper    types.BankKeeper
+	// router is used to execute the queued msgs at the end of an epoch
+	router *middleware.MsgServiceRouter
 	// Used to calculate the estimated next epoch time.
 	// This is local to every node
 	// TODO: remove in favor of consensus param when its added
@@ -32,38 +36,49 @@ type Keeper struct {
 }
 
 // NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	sk types.StakingKeeper, bk types.BankKeeper, router *middleware.MsgServiceRouter, commitTimeout time.Duration,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
+		paramSpace:    paramSpace,
+		stakingKeeper: sk,
+		bankKeeper:    bk,
+		router:        router,
 		commitTimeout: commitTimeout,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
 // GetNewActionID returns ID to be used for next epoch
 func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(NextEpochActionID)
-	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
+	id := uint64(DefaultEpochActionID)
+	if bz := store.Get(types.NextEpochActionID); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
-	id := sdk.BigEndianToUint64(bz)
 
 	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
+	store.Set(types.NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
 
 	return id
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
-}
-
 // QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
+// and returns the ID of the queued action
+func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
 	bz, err := k.cdc.MarshalInterface(msg)
@@ -72,28 +87,31 @@ func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg
 	}
 
 	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	store.Set(types.ActionStoreKey(epochNumber, actionID), bz)
+
+	return actionID
 }
 
 // RestoreEpochAction restore the actions that need to be executed on next epoch
 func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
 	store := ctx.KVStore(k.storeKey)
 
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
+	// the Any is stored as is, this is what MarshalInterface would produce
+	// for its cached msg
+	bz, err := k.cdc.Marshal(action)
 	if err != nil {
 		panic(err)
 	}
 
 	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	store.Set(types.ActionStoreKey(epochNumber, actionID), bz)
 }
 
 // GetEpochMsg gets a msg by ID
 func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
+	bz := store.Get(types.ActionStoreKey(epochNumber, actionID))
 	if bz == nil {
 		return nil
 	}
@@ -122,13 +140,19 @@ func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
 
 // GetEpochActionsIterator returns iterator for EpochActions
 func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
-	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
+}
+
+// GetEpochActionsIteratorByEpoch returns iterator for the EpochActions queued
+// on the given epoch
+func (k Keeper) GetEpochActionsIteratorByEpoch(ctx sdk.Context, epochNumber int64) db.Iterator {
+	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionsPrefix(epochNumber))
 }
 
 // DequeueEpochActions dequeue all the actions store on epoch
 func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochActionQueuePrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -156,14 +180,14 @@ func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
 // SetEpochNumber set epoch number
 func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber int64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	store.Set(types.EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
 }
 
 // GetEpochNumber fetches epoch number
 func (k Keeper) GetEpochNumber(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(EpochNumberID)
+	bz := store.Get(types.EpochNumberID)
 	if bz == nil {
 		return DefaultEpochNumber
 	}
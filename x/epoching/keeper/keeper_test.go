@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	valAddr     sdk.ValAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.EpochingKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.EpochingKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+	s.valAddr = app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestGetNewActionID() {
+	k := s.app.EpochingKeeper
+	s.Require().Equal(uint64(1), k.GetNewActionID(s.ctx))
+	s.Require().Equal(uint64(2), k.GetNewActionID(s.ctx))
+	s.Require().Equal(uint64(3), k.GetNewActionID(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestParams() {
+	res, err := s.queryClient.Params(sdk.WrapSDKContext(s.ctx), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), res.Params)
+
+	s.app.EpochingKeeper.SetParams(s.ctx, types.NewParams(5))
+	s.Require().Equal(int64(5), s.app.EpochingKeeper.EpochLength(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestWrappedMsgs() {
+	delAddr := s.addrs[0]
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+
+	specs := map[string]struct {
+		exec   func() error
+		expErr bool
+	}{
+		"delegate": {
+			exec: func() error {
+				_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx),
+					types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, s.valAddr, coin)))
+				return err
+			},
+		},
+		"delegate to unknown validator": {
+			exec: func() error {
+				_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx),
+					types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, sdk.ValAddress(delAddr), coin)))
+				return err
+			},
+			expErr: true,
+		},
+		"undelegate without delegation": {
+			exec: func() error {
+				_, err := s.msgServer.WrappedUndelegate(sdk.WrapSDKContext(s.ctx),
+					types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, s.valAddr, coin)))
+				return err
+			},
+			expErr: true,
+		},
+		"redelegate without delegation": {
+			exec: func() error {
+				_, err := s.msgServer.WrappedBeginRedelegate(sdk.WrapSDKContext(s.ctx),
+					types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(delAddr, s.valAddr, s.valAddr, coin)))
+				return err
+			},
+			expErr: true,
+		},
+	}
+
+	for name, spec := range specs {
+		s.Run(name, func() {
+			err := spec.exec()
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEpochExecution() {
+	k := s.app.EpochingKeeper
+	k.SetParams(s.ctx, types.NewParams(3))
+
+	delAddr := s.addrs[0]
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+	tooMuch := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction))
+
+	res, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, s.valAddr, coin)))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), res.EpochNumber)
+	s.Require().Equal(uint64(1), res.ActionId)
+
+	// the delegated coins are escrowed, so a delegation exceeding the balance
+	// cannot be queued
+	_, err = s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, s.valAddr, tooMuch)))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	moduleAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().Equal(coin, s.app.BankKeeper.GetBalance(s.ctx, moduleAddr, sdk.DefaultBondDenom))
+
+	// this undelegation fails at the end of the epoch, without affecting the delegation
+	selfDelAddr := s.app.StakingKeeper.GetValidatorDelegations(s.ctx, s.valAddr)[0].GetDelegatorAddr()
+	_, err = s.msgServer.WrappedUndelegate(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(selfDelAddr, s.valAddr, tooMuch)))
+	s.Require().NoError(err)
+
+	queued, err := s.queryClient.QueuedMsgs(sdk.WrapSDKContext(s.ctx), &types.QueryQueuedMsgsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queued.Msgs, 2)
+	s.Require().Equal(uint64(1), queued.Msgs[0].ActionId)
+	s.Require().Equal(uint64(2), queued.Msgs[1].ActionId)
+
+	queued, err = s.queryClient.QueuedMsgs(sdk.WrapSDKContext(s.ctx), &types.QueryQueuedMsgsRequest{EpochNumber: 1})
+	s.Require().NoError(err)
+	s.Require().Empty(queued.Msgs)
+
+	current, err := s.queryClient.CurrentEpoch(sdk.WrapSDKContext(s.ctx), &types.QueryCurrentEpochRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), current.EpochNumber)
+	s.Require().Equal(int64(3), current.NextEpochHeight)
+
+	// nothing happens before the end of the epoch
+	epoching.EndBlocker(s.ctx.WithBlockHeight(2), k)
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().False(found)
+	s.Require().Len(k.GetEpochActions(s.ctx), 2)
+
+	epoching.EndBlocker(s.ctx.WithBlockHeight(3), k)
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().True(found)
+	s.Require().False(delegation.Shares.IsZero())
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, moduleAddr, sdk.DefaultBondDenom).IsZero())
+
+	s.Require().Empty(k.GetEpochActions(s.ctx))
+	s.Require().Equal(int64(1), k.GetEpochNumber(s.ctx))
+
+	// the delegation now exists, so an undelegation can be queued on the next epoch
+	res2, err := s.msgServer.WrappedUndelegate(sdk.WrapSDKContext(s.ctx),
+		types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, s.valAddr, coin)))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), res2.EpochNumber)
+	s.Require().Equal(uint64(3), res2.ActionId)
+
+	epoching.EndBlocker(s.ctx.WithBlockHeight(6), k)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().False(found)
+	s.Require().Equal(int64(2), k.GetEpochNumber(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestRouteStakingMsgs() {
+	k := s.app.EpochingKeeper
+	k.SetParams(s.ctx, types.NewParams(3))
+
+	delAddr := s.addrs[0]
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+	balance := s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom)
+
+	// a MsgDelegate sent directly is queued instead of being executed
+	msg := stakingtypes.NewMsgDelegate(delAddr, s.valAddr, coin)
+	handler := s.app.MsgServiceRouter().Handler(msg)
+	res, err := handler(s.ctx, msg)
+	s.Require().NoError(err)
+	var delegateRes stakingtypes.MsgDelegateResponse
+	s.Require().NoError(s.app.AppCodec().Unmarshal(res.Data, &delegateRes))
+
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().False(found)
+	s.Require().Equal(balance.Sub(coin), s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom))
+	s.Require().Equal([]sdk.Msg{msg}, k.GetEpochActions(s.ctx))
+
+	epoching.EndBlocker(s.ctx.WithBlockHeight(3), k)
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().True(found)
+	s.Require().False(delegation.Shares.IsZero())
+	s.Require().Equal(balance.Sub(coin), s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom))
+
+	// so are the undelegations
+	undelegate := stakingtypes.NewMsgUndelegate(delAddr, s.valAddr, coin)
+	res, err = s.app.MsgServiceRouter().Handler(undelegate)(s.ctx, undelegate)
+	s.Require().NoError(err)
+	var undelegateRes stakingtypes.MsgUndelegateResponse
+	s.Require().NoError(s.app.AppCodec().Unmarshal(res.Data, &undelegateRes))
+	s.Require().True(undelegateRes.CompletionTime.IsZero())
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().True(found)
+	s.Require().Equal([]sdk.Msg{undelegate}, k.GetEpochActions(s.ctx))
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epoching MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// WrappedDelegate queues a MsgDelegate for execution at the end of the current epoch.
+func (k msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochNumber, actionID, err := k.QueueDelegate(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedDelegateResponse{EpochNumber: epochNumber, ActionId: actionID}, nil
+}
+
+// WrappedUndelegate queues a MsgUndelegate for execution at the end of the current epoch.
+func (k msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochNumber, actionID, err := k.QueueUndelegate(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{EpochNumber: epochNumber, ActionId: actionID}, nil
+}
+
+// WrappedBeginRedelegate queues a MsgBeginRedelegate for execution at the end of the current epoch.
+func (k msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochNumber, actionID, err := k.QueueBeginRedelegate(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{EpochNumber: epochNumber, ActionId: actionID}, nil
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// epochExecutionKey is the context key marking the execution of the queued
+// msgs at the end of an epoch.
+type epochExecutionKey struct{}
+
+// isEpochExecution returns true if ctx executes the queued msgs at the end of
+// an epoch.
+func isEpochExecution(ctx sdk.Context) bool {
+	executing, _ := ctx.Value(epochExecutionKey{}).(bool)
+	return executing
+}
+
+// QueueDelegate queues a MsgDelegate on the current epoch. The delegated coins
+// are escrowed in the epoching module account until the end of the epoch, so
+// that the delegation cannot fail for lack of balance when it is executed.
+func (k Keeper) QueueDelegate(ctx sdk.Context, msg *stakingtypes.MsgDelegate) (int64, uint64, error) {
+	if err := k.checkValidator(ctx, msg.ValidatorAddress); err != nil {
+		return 0, 0, err
+	}
+
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); msg.Amount.Denom != bondDenom {
+		return 0, 0, sdkerrors.Wrapf(types.ErrInvalidDenom, "got %s, expected %s", msg.Amount.Denom, bondDenom)
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return 0, 0, err
+	}
+
+	return k.queueMsg(ctx, msg)
+}
+
+// QueueUndelegate queues a MsgUndelegate on the current epoch.
+func (k Keeper) QueueUndelegate(ctx sdk.Context, msg *stakingtypes.MsgUndelegate) (int64, uint64, error) {
+	if err := k.checkDelegation(ctx, msg.DelegatorAddress, msg.ValidatorAddress); err != nil {
+		return 0, 0, err
+	}
+
+	return k.queueMsg(ctx, msg)
+}
+
+// QueueBeginRedelegate queues a MsgBeginRedelegate on the current epoch.
+func (k Keeper) QueueBeginRedelegate(ctx sdk.Context, msg *stakingtypes.MsgBeginRedelegate) (int64, uint64, error) {
+	if err := k.checkDelegation(ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress); err != nil {
+		return 0, 0, err
+	}
+	if err := k.checkValidator(ctx, msg.ValidatorDstAddress); err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return 0, 0, stakingtypes.ErrBadRedelegationDst
+		}
+		return 0, 0, err
+	}
+
+	return k.queueMsg(ctx, msg)
+}
+
+// RouteStakingMsgs routes the MsgDelegate, MsgUndelegate and MsgBeginRedelegate
+// sent directly to the staking module to the epoch queue, as if they had been
+// wrapped, so that they cannot change the validator set before the end of the
+// epoch. Only the queued msgs executed at the end of an epoch reach the staking
+// module. It must be called after the msg services have been registered on the
+// keeper's router.
+//
+// The routed msgs keep the response type of their staking Msg service method,
+// so that clients can decode it, and the epoch number and action ID of the
+// queued msg are only emitted in its EventQueueMsg. As the completion time of
+// an undelegation or a redelegation is only known once it is executed, it is
+// left unset in the responses.
+func (k Keeper) RouteStakingMsgs() {
+	k.router.WrapHandler(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), k.stakingMsgHandler(func(ctx sdk.Context, msg sdk.Msg) (proto.Message, error) {
+		_, _, err := k.QueueDelegate(ctx, msg.(*stakingtypes.MsgDelegate))
+		return &stakingtypes.MsgDelegateResponse{}, err
+	}))
+	k.router.WrapHandler(sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}), k.stakingMsgHandler(func(ctx sdk.Context, msg sdk.Msg) (proto.Message, error) {
+		_, _, err := k.QueueUndelegate(ctx, msg.(*stakingtypes.MsgUndelegate))
+		return &stakingtypes.MsgUndelegateResponse{}, err
+	}))
+	k.router.WrapHandler(sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}), k.stakingMsgHandler(func(ctx sdk.Context, msg sdk.Msg) (proto.Message, error) {
+		_, _, err := k.QueueBeginRedelegate(ctx, msg.(*stakingtypes.MsgBeginRedelegate))
+		return &stakingtypes.MsgBeginRedelegateResponse{}, err
+	}))
+}
+
+// stakingMsgHandler returns a wrapper of a staking msg handler which queues the
+// msg with queue, unless the msg is executed at the end of an epoch.
+func (k Keeper) stakingMsgHandler(queue func(sdk.Context, sdk.Msg) (proto.Message, error)) func(middleware.MsgServiceHandler) middleware.MsgServiceHandler {
+	return func(next middleware.MsgServiceHandler) middleware.MsgServiceHandler {
+		return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			if isEpochExecution(ctx) {
+				return next(ctx, msg)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			res, err := queue(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+
+			return sdk.WrapServiceResult(ctx, res, nil)
+		}
+	}
+}
+
+// releaseEscrow returns the coins escrowed for a queued MsgDelegate to the
+// delegator, right before the delegation is executed.
+func (k Keeper) releaseEscrow(ctx sdk.Context, msg sdk.Msg) {
+	delegate, ok := msg.(*stakingtypes.MsgDelegate)
+	if !ok {
+		return
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(delegate.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(delegate.Amount)); err != nil {
+		panic(err)
+	}
+}
+
+// queueMsg queues msg on the current epoch and emits an EventQueueMsg.
+func (k Keeper) queueMsg(ctx sdk.Context, msg sdk.Msg) (int64, uint64, error) {
+	epochNumber := k.GetEpochNumber(ctx)
+	actionID := k.QueueMsgForEpoch(ctx, epochNumber, msg)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventQueueMsg{
+		EpochNumber: epochNumber,
+		ActionId:    actionID,
+		MsgTypeUrl:  sdk.MsgTypeURL(msg),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return epochNumber, actionID, nil
+}
+
+// checkValidator returns an error if the validator does not exist.
+func (k Keeper) checkValidator(ctx sdk.Context, validator string) error {
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	return nil
+}
+
+// checkDelegation returns an error if the validator or the delegation does
+// not exist.
+func (k Keeper) checkDelegation(ctx sdk.Context, delegator, validator string) error {
+	if err := k.checkValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); !found {
+		return stakingtypes.ErrNoDelegation
+	}
+
+	return nil
+}
@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/epoching/v1beta1/epoching.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the epoching module.
+type Params struct {
+	// epoch_length is the number of blocks in an epoch. Queued messages are
+	// executed in the EndBlock of the last block of each epoch.
+	EpochLength int64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty" yaml:"epoch_length"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525f09a6ad1d0fea, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// QueuedMsg is a message waiting in the epoch queue for execution at the end
+// of its epoch.
+type QueuedMsg struct {
+	// epoch_number is the epoch at the end of which the message is executed.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// action_id is the unique ID of the queued message.
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	// msg is the queued sdk.Msg.
+	Msg *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QueuedMsg) Reset()         { *m = QueuedMsg{} }
+func (m *QueuedMsg) String() string { return proto.CompactTextString(m) }
+func (*QueuedMsg) ProtoMessage()    {}
+func (*QueuedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525f09a6ad1d0fea, []int{1}
+}
+func (m *QueuedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedMsg.Merge(m, src)
+}
+func (m *QueuedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedMsg proto.InternalMessageInfo
+
+func (m *QueuedMsg) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *QueuedMsg) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+func (m *QueuedMsg) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.epoching.v1beta1.Params")
+	proto.RegisterType((*QueuedMsg)(nil), "cosmos.epoching.v1beta1.QueuedMsg")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/epoching/v1beta1/epoching.proto", fileDescriptor_525f09a6ad1d0fea)
+}
+
+var fileDescriptor_525f09a6ad1d0fea = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x4e, 0x02, 0x31,
+	0x18, 0xc7, 0xaf, 0x42, 0x50, 0x0e, 0xa7, 0x93, 0x04, 0xc4, 0xa4, 0xe0, 0x0d, 0x86, 0x85, 0x36,
+	0xc8, 0xc6, 0x26, 0x8b, 0xd1, 0x88, 0x51, 0x46, 0x17, 0xd2, 0xbb, 0xab, 0xe5, 0x02, 0x6d, 0x09,
+	0xed, 0x19, 0x6f, 0xf3, 0x11, 0x1c, 0x1d, 0x7d, 0x08, 0x1f, 0xc2, 0x38, 0x31, 0x3a, 0x19, 0x03,
+	0x6f, 0xe0, 0x13, 0x18, 0xda, 0x3b, 0x74, 0x6a, 0xff, 0xff, 0xef, 0xf7, 0xfd, 0xbf, 0x7c, 0xad,
+	0x7b, 0x12, 0x4a, 0xc5, 0xa5, 0xc2, 0x74, 0x2e, 0xc3, 0x49, 0x2c, 0x18, 0x7e, 0xe8, 0x06, 0x54,
+	0x93, 0xee, 0xd6, 0x40, 0xf3, 0x85, 0xd4, 0xd2, 0xab, 0x59, 0x0e, 0x6d, 0xed, 0x8c, 0x6b, 0x54,
+	0x99, 0x64, 0xd2, 0x30, 0x78, 0x73, 0xb3, 0x78, 0xe3, 0x90, 0x49, 0xc9, 0x66, 0x14, 0x1b, 0x15,
+	0x24, 0xf7, 0x98, 0x88, 0x34, 0x2f, 0xd9, 0xa4, 0xb1, 0xed, 0xc9, 0x62, 0x8d, 0xf0, 0x2f, 0xdd,
+	0xd2, 0x0d, 0x59, 0x10, 0xae, 0xbc, 0xbe, 0xbb, 0x6f, 0x26, 0x8d, 0x67, 0x54, 0x30, 0x3d, 0xa9,
+	0x83, 0x16, 0x68, 0x17, 0x06, 0xb5, 0x9f, 0xaf, 0xe6, 0x41, 0x4a, 0xf8, 0xac, 0xef, 0xff, 0xaf,
+	0xfa, 0xa3, 0x8a, 0x91, 0x57, 0x46, 0xf5, 0x8b, 0x2f, 0xaf, 0x4d, 0xc7, 0x7f, 0x02, 0x6e, 0xf9,
+	0x36, 0xa1, 0x09, 0x8d, 0x86, 0x8a, 0x79, 0xc7, 0x79, 0x9e, 0x48, 0x78, 0x40, 0x17, 0x36, 0x2f,
+	0x6b, 0xbb, 0x36, 0x96, 0x77, 0xe4, 0x96, 0x49, 0xa8, 0x63, 0x29, 0xc6, 0x71, 0x54, 0xdf, 0x69,
+	0x81, 0x76, 0x71, 0xb4, 0x67, 0x8d, 0x8b, 0xc8, 0xeb, 0xb9, 0x05, 0xae, 0x58, 0xbd, 0xd0, 0x02,
+	0xed, 0xca, 0x69, 0x15, 0xd9, 0xed, 0x50, 0xbe, 0x1d, 0x3a, 0x13, 0xe9, 0xa0, 0xf2, 0xf1, 0xd6,
+	0xd9, 0x55, 0xd1, 0x14, 0x0d, 0x15, 0x1b, 0x6d, 0xe8, 0xc1, 0xf9, 0xfb, 0x0a, 0x82, 0xe5, 0x0a,
+	0x82, 0xef, 0x15, 0x04, 0xcf, 0x6b, 0xe8, 0x2c, 0xd7, 0xd0, 0xf9, 0x5c, 0x43, 0xe7, 0xae, 0xc3,
+	0x62, 0x3d, 0x49, 0x02, 0x14, 0x4a, 0x9e, 0xbd, 0x40, 0x76, 0x74, 0x54, 0x34, 0xc5, 0x8f, 0x7f,
+	0xbf, 0xa1, 0xd3, 0x39, 0x55, 0x41, 0xc9, 0x0c, 0xea, 0xfd, 0x0e, 0x00, 0xbb, 0x98, 0x25, 0x4b,
+	0xad, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEpoching(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActionId != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEpoching(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEpoching(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovEpoching(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *QueuedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovEpoching(uint64(m.EpochNumber))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovEpoching(uint64(m.ActionId))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	return n
+}
+
+func sovEpoching(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEpoching(x uint64) (n int) {
+	return sovEpoching(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEpoching(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEpoching
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEpoching
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEpoching
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEpoching        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEpoching          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEpoching = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrEmptyMsg     = sdkerrors.Register(ModuleName, 2, "empty wrapped msg")
+	ErrInvalidDenom = sdkerrors.Register(ModuleName, 3, "invalid delegation denom")
+)
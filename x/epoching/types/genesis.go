@@ -0,0 +1,93 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, queuedMsgs []*types.Any) *GenesisState {
+	return &GenesisState{
+		Params:     params,
+		QueuedMsgs: queuedMsgs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for i, any := range data.QueuedMsgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("queued msg %d: expected sdk.Msg, got %T", i, any.GetCachedValue())
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "queued msg %d", i)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range data.QueuedMsgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ types.UnpackInterfacesMessage = QueuedMsg{}
+
+// NewQueuedMsg creates a new QueuedMsg object
+func NewQueuedMsg(epochNumber int64, actionID uint64, msg sdk.Msg) (QueuedMsg, error) {
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMsg{}, err
+	}
+
+	return QueuedMsg{
+		EpochNumber: epochNumber,
+		ActionId:    actionID,
+		Msg:         any,
+	}, nil
+}
+
+// GetSdkMsg returns the cached sdk.Msg of the queued message.
+func (m QueuedMsg) GetSdkMsg() sdk.Msg {
+	msg, _ := m.Msg.GetCachedValue().(sdk.Msg)
+	return msg
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMsg) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+var _ types.UnpackInterfacesMessage = QueryQueuedMsgsResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryQueuedMsgsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, msg := range m.Msgs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
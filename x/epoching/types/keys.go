@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+const (
+	// ModuleName is the name of the epoching module
+	ModuleName = "epoching"
+
+	// StoreKey is the default store key for epoching
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for epoching
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the epoching store.
+	QuerierRoute = ModuleName
+)
+
+var (
+	NextEpochActionID      = []byte{0x11}
+	EpochNumberID          = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+)
+
+// EpochActionsPrefix returns the prefix of all actions queued on an epoch:
+// 0x13 | epochNumber (8 bytes, big endian)
+func EpochActionsPrefix(epochNumber int64) []byte {
+	key := make([]byte, 0, len(EpochActionQueuePrefix)+8)
+	key = append(key, EpochActionQueuePrefix...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// ActionStoreKey returns the action store key from an epoch number and an
+// action ID: 0x13 | epochNumber (8 bytes, big endian) | actionID (8 bytes, big endian)
+func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
+	return append(EpochActionsPrefix(epochNumber), sdk.Uint64ToBigEndian(actionID)...)
+}
+
+// SplitActionStoreKey returns the epoch number and action ID from an action
+// store key.
+func SplitActionStoreKey(key []byte) (epochNumber int64, actionID uint64) {
+	kv.AssertKeyLength(key, 1+8+8)
+	return int64(sdk.BigEndianToUint64(key[1:9])), sdk.BigEndianToUint64(key[9:])
+}
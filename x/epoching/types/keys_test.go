@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+func TestActionStoreKey(t *testing.T) {
+	specs := []struct {
+		epochNumber int64
+		actionID    uint64
+	}{
+		{0, 1},
+		{1, 256},
+		{256, 1},
+		{math.MaxInt64, math.MaxUint64},
+	}
+
+	for _, spec := range specs {
+		key := types.ActionStoreKey(spec.epochNumber, spec.actionID)
+		require.Len(t, key, 17)
+		require.Equal(t, types.EpochActionsPrefix(spec.epochNumber), key[:9])
+
+		epochNumber, actionID := types.SplitActionStoreKey(key)
+		require.Equal(t, spec.epochNumber, epochNumber)
+		require.Equal(t, spec.actionID, actionID)
+	}
+
+	// keys of different epochs and actions must not collide
+	require.NotEqual(t, types.ActionStoreKey(1, 256), types.ActionStoreKey(1, 0))
+	require.NotEqual(t, types.ActionStoreKey(256, 1), types.ActionStoreKey(0, 1))
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// epoching message types
+const (
+	TypeMsgWrappedDelegate        = "wrapped_delegate"
+	TypeMsgWrappedUndelegate      = "wrapped_begin_unbonding"
+	TypeMsgWrappedBeginRedelegate = "wrapped_begin_redelegate"
+)
+
+var (
+	_ sdk.Msg            = &MsgWrappedDelegate{}
+	_ sdk.Msg            = &MsgWrappedUndelegate{}
+	_ sdk.Msg            = &MsgWrappedBeginRedelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedDelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedUndelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedBeginRedelegate{}
+)
+
+// NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
+func NewMsgWrappedDelegate(msg *stakingtypes.MsgDelegate) *MsgWrappedDelegate {
+	return &MsgWrappedDelegate{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgWrappedDelegate) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgWrappedDelegate) Type() string { return TypeMsgWrappedDelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgWrappedDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrEmptyMsg
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedUndelegate creates a new MsgWrappedUndelegate instance.
+func NewMsgWrappedUndelegate(msg *stakingtypes.MsgUndelegate) *MsgWrappedUndelegate {
+	return &MsgWrappedUndelegate{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgWrappedUndelegate) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgWrappedUndelegate) Type() string { return TypeMsgWrappedUndelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgWrappedUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrEmptyMsg
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedBeginRedelegate creates a new MsgWrappedBeginRedelegate instance.
+func NewMsgWrappedBeginRedelegate(msg *stakingtypes.MsgBeginRedelegate) *MsgWrappedBeginRedelegate {
+	return &MsgWrappedBeginRedelegate{Msg: msg}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgWrappedBeginRedelegate) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgWrappedBeginRedelegate) Type() string { return TypeMsgWrappedBeginRedelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgWrappedBeginRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrEmptyMsg
+	}
+	return msg.Msg.ValidateBasic()
+}
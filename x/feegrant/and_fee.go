@@ -0,0 +1,121 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*AndAllowance)(nil)
+var _ types.UnpackInterfacesMessage = (*AndAllowance)(nil)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AndAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range a.Allowances {
+		var allowance FeeAllowanceI
+		if err := unpacker.UnpackAny(any, &allowance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewAndAllowance creates new fee allowance accepting the txs accepted by all
+// the given allowances.
+func NewAndAllowance(allowances ...FeeAllowanceI) (*AndAllowance, error) {
+	anys := make([]*types.Any, len(allowances))
+	for i, allowance := range allowances {
+		any, err := newAnyAllowance(allowance)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &AndAllowance{Allowances: anys}, nil
+}
+
+// GetAllowances returns the chained fee allowances.
+func (a *AndAllowance) GetAllowances() ([]FeeAllowanceI, error) {
+	allowances := make([]FeeAllowanceI, len(a.Allowances))
+	for i, any := range a.Allowances {
+		allowance, ok := any.GetCachedValue().(FeeAllowanceI)
+		if !ok {
+			return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+		}
+		allowances[i] = allowance
+	}
+
+	return allowances, nil
+}
+
+// Accept method passes the fee to each of the chained allowances in order, and
+// rejects it as soon as one of them does. The AndAllowance is removed once any
+// of the chained allowances is used up.
+func (a *AndAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	allowances, err := a.GetAllowances()
+	if err != nil {
+		return false, err
+	}
+
+	remove := false
+	for i, allowance := range allowances {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check allowance")
+		removeAllowance, err := allowance.Accept(ctx, fee, msgs)
+		if err != nil {
+			return removeAllowance, err
+		}
+		if removeAllowance {
+			remove = true
+			continue
+		}
+		if a.Allowances[i], err = newAnyAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+
+	return remove, nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AndAllowance) ValidateBasic() error {
+	if len(a.Allowances) < 2 {
+		return sdkerrors.Wrap(ErrNoAllowance, "at least two allowances are required")
+	}
+
+	allowances, err := a.GetAllowances()
+	if err != nil {
+		return err
+	}
+
+	for _, allowance := range allowances {
+		if err := allowance.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExpiresAt returns the earliest expiry time of the chained allowances.
+func (a *AndAllowance) ExpiresAt() (*time.Time, error) {
+	allowances, err := a.GetAllowances()
+	if err != nil {
+		return nil, err
+	}
+
+	var earliest *time.Time
+	for _, allowance := range allowances {
+		exp, err := allowance.ExpiresAt()
+		if err != nil {
+			return nil, err
+		}
+		if exp != nil && (earliest == nil || exp.Before(*earliest)) {
+			earliest = exp
+		}
+	}
+
+	return earliest, nil
+}
@@ -0,0 +1,56 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestAndAllowance(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))}
+	periodic := &feegrant.PeriodicAllowance{
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 20)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 20)),
+		PeriodReset:      now.Add(time.Hour),
+	}
+
+	single, err := feegrant.NewAndAllowance(basic)
+	require.NoError(t, err)
+	require.Error(t, single.ValidateBasic())
+
+	allowance, err := feegrant.NewAndAllowance(basic, periodic)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	t.Log("verify the fee is spent from all the allowances")
+	remove, err := allowance.Accept(ctx, fee, nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+	allowances, err := allowance.GetAllowances()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 90)), allowances[0].(*feegrant.BasicAllowance).SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), allowances[1].(*feegrant.PeriodicAllowance).PeriodCanSpend)
+
+	t.Log("verify the fee is rejected when one of the allowances rejects it")
+	_, err = allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 15)), nil)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	t.Log("verify the allowance is removed when one of the allowances is used up")
+	small, err := feegrant.NewAndAllowance(&feegrant.BasicAllowance{SpendLimit: fee}, &feegrant.BasicAllowance{})
+	require.NoError(t, err)
+	remove, err = small.Accept(ctx, fee, nil)
+	require.NoError(t, err)
+	require.True(t, remove)
+}
@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -52,3 +54,8 @@ func (a BasicAllowance) ValidateBasic() error {
 
 	return nil
 }
+
+// ExpiresAt returns the expiry time of the BasicAllowance.
+func (a BasicAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Expiration, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
@@ -22,6 +23,9 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagRecipients  = "allowed-recipients"
+	FlagDenoms      = "allowed-denoms"
+	FlagMaxGas      = "max-gas"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +42,7 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdRevokeAllFeegrants(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +62,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 36000 or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allowed-recipients cosmos1r2m...,cosmos1x9d...
+	--allowed-denoms stake --max-gas 200000
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -161,6 +169,43 @@ Examples:
 				}
 			}
 
+			allowedRecipients, err := cmd.Flags().GetStringSlice(FlagRecipients)
+			if err != nil {
+				return err
+			}
+
+			allowedDenoms, err := cmd.Flags().GetStringSlice(FlagDenoms)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedRecipients) > 0 || len(allowedDenoms) > 0 {
+				recipients := make([]sdk.AccAddress, len(allowedRecipients))
+				for i, recipient := range allowedRecipients {
+					recipients[i], err = sdk.AccAddressFromBech32(recipient)
+					if err != nil {
+						return err
+					}
+				}
+
+				grant, err = feegrant.NewAllowedRecipientAllowance(grant, recipients, allowedDenoms)
+				if err != nil {
+					return err
+				}
+			}
+
+			maxGas, err := cmd.Flags().GetUint64(FlagMaxGas)
+			if err != nil {
+				return err
+			}
+
+			if maxGas > 0 {
+				grant, err = feegrant.NewMaxGasAllowance(grant, maxGas)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -172,6 +217,9 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().StringSlice(FlagRecipients, []string{}, "Set of addresses the messages paid by the fee allowance can transfer funds to")
+	cmd.Flags().StringSlice(FlagDenoms, []string{}, "Set of denominations the messages paid by the fee allowance can transfer")
+	cmd.Flags().Uint64(FlagMaxGas, 0, "Maximum gas limit of the txs paid by the fee allowance, if not mentioned there is no limit")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
@@ -216,6 +264,67 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllFeegrants returns a CLI command handler for creating a transaction revoking
+// all the fee allowances of a granter.
+func NewCmdRevokeAllFeegrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all [granter]",
+		Short: "revoke all fee-grants of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`list all the fee grants from a granter and revoke them in a single
+			transaction. Note, the'--from' flag is ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s revoke-all cosmos1skj..
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter := clientCtx.GetFromAddress()
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			var msgs []sdk.Msg
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := queryClient.AllowancesByGranter(cmd.Context(), &feegrant.QueryAllowancesByGranterRequest{
+					Granter:    granter.String(),
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				for _, grant := range res.Allowances {
+					msgs = append(msgs, &feegrant.MsgRevokeAllowance{
+						Granter: grant.Granter,
+						Grantee: grant.Grantee,
+					})
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			if len(msgs) == 0 {
+				return fmt.Errorf("no fee grants found for granter %s", granter)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -18,6 +18,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	govtestutil "github.com/cosmos/cosmos-sdk/x/gov/client/testutil"
@@ -253,6 +254,62 @@ func (s *IntegrationTestSuite) TestCmdGetFeeGrants() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdGetFeeGrantsByGranter() {
+	val := s.network.Validators[0]
+	granter := s.addedGranter
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		resp         *feegrant.QueryAllowancesByGranterResponse
+		expectLength int
+	}{
+		{
+			"wrong granter",
+			[]string{
+				"wrong_granter",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true, nil, 0,
+		},
+		{
+			"non existed granter",
+			[]string{
+				"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &feegrant.QueryAllowancesByGranterResponse{}, 0,
+		},
+		{
+			"valid req",
+			[]string{
+				granter.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false, &feegrant.QueryAllowancesByGranterResponse{}, 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryFeeGrantsByGranter()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.resp), out.String())
+				s.Require().Len(tc.resp.Allowances, tc.expectLength)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 	val := s.network.Validators[0]
 	granter := val.Address
@@ -655,6 +712,46 @@ func (s *IntegrationTestSuite) TestNewCmdRevokeFeegrant() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdRevokeAllFeegrants() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// create and fund a granter with two grants
+	k, _, err := val.ClientCtx.Keyring.NewMnemonic("revoker", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	granter := sdk.AccAddress(pub.Address())
+	_, err = banktestutil.MsgSendExec(clientCtx, val.Address, granter,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))), commonFlags...)
+	s.Require().NoError(err)
+	s.createGrant(granter, sdk.AccAddress("grantee1____________"))
+	s.createGrant(granter, sdk.AccAddress("grantee2____________"))
+
+	s.T().Log("verify the grants of the granter are revoked in one tx")
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdRevokeAllFeegrants(), append([]string{granter.String()}, commonFlags...))
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryFeeGrantsByGranter(), []string{granter.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var resp feegrant.QueryAllowancesByGranterResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+	s.Require().Empty(resp.Allowances)
+
+	s.T().Log("verify revoking without grants fails")
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdRevokeAllFeegrants(), append([]string{granter.String()}, commonFlags...))
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestTxWithFeeGrant() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -19,6 +19,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&AllowedRecipientAllowance{},
+		&MaxGasAllowance{},
+		&AndAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -22,4 +22,10 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrRecipientNotAllowed error if a message transfers funds to a recipient which is not allowed
+	ErrRecipientNotAllowed = sdkerrors.Register(DefaultCodespace, 8, "recipient not allowed")
+	// ErrDenomNotAllowed error if a message transfers a denomination which is not allowed
+	ErrDenomNotAllowed = sdkerrors.Register(DefaultCodespace, 9, "denomination not allowed")
+	// ErrGasLimitExceeded error if the gas limit of the tx is above the allowed maximum
+	ErrGasLimitExceeded = sdkerrors.Register(DefaultCodespace, 10, "gas limit exceeded")
 )
@@ -218,6 +218,132 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// AllowedRecipientAllowance creates allowance only for txs whose messages transfer
+// funds to the allowed recipients, in the allowed denominations.
+type AllowedRecipientAllowance struct {
+	// allowance can be any of basic and filtered fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_recipients are the addresses the messages can transfer funds to. If it
+	// is empty, funds can be transferred to any address.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// allowed_denoms are the denominations the messages can transfer. If it is empty,
+	// any denomination can be transferred.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+}
+
+func (m *AllowedRecipientAllowance) Reset()         { *m = AllowedRecipientAllowance{} }
+func (m *AllowedRecipientAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedRecipientAllowance) ProtoMessage()    {}
+func (*AllowedRecipientAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *AllowedRecipientAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedRecipientAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedRecipientAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedRecipientAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedRecipientAllowance.Merge(m, src)
+}
+func (m *AllowedRecipientAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedRecipientAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedRecipientAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedRecipientAllowance proto.InternalMessageInfo
+
+// MaxGasAllowance creates allowance only for txs with a gas limit up to max_gas.
+type MaxGasAllowance struct {
+	// allowance can be any of basic and filtered fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas is the maximum gas limit of the txs paid by the allowance.
+	MaxGas uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *MaxGasAllowance) Reset()         { *m = MaxGasAllowance{} }
+func (m *MaxGasAllowance) String() string { return proto.CompactTextString(m) }
+func (*MaxGasAllowance) ProtoMessage()    {}
+func (*MaxGasAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *MaxGasAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxGasAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxGasAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxGasAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxGasAllowance.Merge(m, src)
+}
+func (m *MaxGasAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxGasAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxGasAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxGasAllowance proto.InternalMessageInfo
+
+// AndAllowance creates allowance only for txs accepted by all of its allowances.
+type AndAllowance struct {
+	// allowances are the allowances which must all accept the tx.
+	Allowances []*types1.Any `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+}
+
+func (m *AndAllowance) Reset()         { *m = AndAllowance{} }
+func (m *AndAllowance) String() string { return proto.CompactTextString(m) }
+func (*AndAllowance) ProtoMessage()    {}
+func (*AndAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *AndAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AndAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AndAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AndAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AndAllowance.Merge(m, src)
+}
+func (m *AndAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AndAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AndAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AndAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -232,7 +358,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{6}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,6 +412,9 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*AllowedRecipientAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedRecipientAllowance")
+	proto.RegisterType((*MaxGasAllowance)(nil), "cosmos.feegrant.v1beta1.MaxGasAllowance")
+	proto.RegisterType((*AndAllowance)(nil), "cosmos.feegrant.v1beta1.AndAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -294,44 +423,50 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa6, 0x90, 0x0b, 0x94, 0xd6, 0x04, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb1, 0x69, 0xd8, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x72, 0x99, 0x58, 0xa2, 0xb3, 0xfd,
-	0x6a, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe6, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x15,
-	0x9f, 0xa1, 0x62, 0xaa, 0x60, 0x61, 0xa2, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0xe7, 0x24, 0x24, 0xfc,
-	0x13, 0xea, 0x14, 0xdf, 0xbb, 0xf7, 0xfb, 0xf7, 0xde, 0x29, 0xe8, 0x96, 0x4f, 0x59, 0x44, 0x99,
-	0x7d, 0x08, 0x10, 0xa6, 0x38, 0xe6, 0xf6, 0xab, 0x1d, 0x0f, 0x38, 0xde, 0x99, 0x16, 0xac, 0x24,
-	0xa5, 0x9c, 0xea, 0x37, 0x65, 0x9f, 0x35, 0x2d, 0xab, 0xbe, 0x7a, 0x35, 0xa4, 0x21, 0x15, 0x3d,
-	0x76, 0xf6, 0x25, 0xdb, 0xeb, 0xb5, 0x90, 0xd2, 0x70, 0x00, 0xb6, 0x38, 0x79, 0xc3, 0x43, 0x1b,
-	0xc7, 0xa3, 0xfc, 0x4a, 0x32, 0xf5, 0x25, 0x46, 0xd1, 0xca, 0x2b, 0x53, 0x99, 0xf1, 0x30, 0x83,
-	0xa9, 0x11, 0x9f, 0x92, 0x58, 0xdd, 0x37, 0x16, 0x59, 0x39, 0x89, 0x80, 0x71, 0x1c, 0x25, 0x39,
-	0xc1, 0x62, 0x43, 0x30, 0x4c, 0x31, 0x27, 0x54, 0x11, 0x6c, 0x7d, 0xd1, 0xd0, 0xba, 0x83, 0x19,
-	0xf1, 0xbb, 0x83, 0x01, 0x7d, 0x8d, 0x63, 0x1f, 0xf4, 0x01, 0xaa, 0xb0, 0x04, 0xe2, 0xa0, 0x3f,
-	0x20, 0x11, 0xe1, 0x86, 0xd6, 0x2c, 0xb6, 0x2a, 0x9d, 0x9a, 0xa5, 0x7c, 0x65, 0x4e, 0xf2, 0xa8,
-	0x56, 0x8f, 0x92, 0xd8, 0xb9, 0x73, 0xfa, 0xad, 0x51, 0xf8, 0x70, 0xde, 0x68, 0x85, 0x84, 0xbf,
-	0x18, 0x7a, 0x96, 0x4f, 0x23, 0x15, 0x42, 0xfd, 0xb4, 0x59, 0xf0, 0xd2, 0xe6, 0xa3, 0x04, 0x98,
-	0x00, 0x30, 0x17, 0x09, 0xfe, 0x27, 0x19, 0xbd, 0x7e, 0x1f, 0x21, 0x38, 0x4a, 0x88, 0x34, 0x65,
-	0xac, 0x34, 0xb5, 0x56, 0xa5, 0x53, 0xb7, 0xa4, 0x6b, 0x2b, 0x77, 0x6d, 0x3d, 0xcb, 0x63, 0x39,
-	0xab, 0xc7, 0xe7, 0x0d, 0xcd, 0x9d, 0xc3, 0xec, 0x6e, 0x7e, 0x3a, 0x69, 0x5f, 0x7d, 0x04, 0x30,
-	0x4d, 0xf0, 0x78, 0x6b, 0x52, 0x44, 0x9b, 0x4f, 0x21, 0x25, 0x34, 0x98, 0x0f, 0xd6, 0x43, 0x25,
-	0x2f, 0x8b, 0x6a, 0x68, 0x42, 0x65, 0xdb, 0xfa, 0xcd, 0x06, 0xad, 0x9f, 0x07, 0xe2, 0xac, 0x66,
-	0x01, 0x5d, 0x89, 0xd5, 0xef, 0xa1, 0xb5, 0x44, 0x30, 0x2b, 0xaf, 0xb5, 0x25, 0xaf, 0x0f, 0xd4,
-	0x84, 0x9d, 0xcb, 0x19, 0xee, 0x6d, 0x66, 0x57, 0x41, 0xf4, 0x11, 0xd2, 0xe5, 0x57, 0x7f, 0x7e,
-	0xc2, 0xc5, 0x8b, 0x9f, 0xf0, 0x86, 0x94, 0x39, 0x98, 0xcd, 0x79, 0x88, 0x54, 0xad, 0xef, 0xe3,
-	0x58, 0xca, 0x1b, 0xab, 0x17, 0x2f, 0xbc, 0x2e, 0x45, 0x7a, 0x38, 0x16, 0xda, 0xfa, 0x1e, 0xba,
-	0xa2, 0x64, 0x53, 0x60, 0xc0, 0x8d, 0xd2, 0x5f, 0x17, 0x2c, 0xa6, 0x26, 0x96, 0x5c, 0x91, 0x48,
-	0x37, 0x03, 0xfe, 0x6a, 0xcb, 0xef, 0x34, 0x74, 0x5d, 0x1c, 0x21, 0xd8, 0x67, 0xe1, 0x6c, 0xcf,
-	0x0f, 0x51, 0x19, 0xe7, 0x07, 0xb5, 0xeb, 0xea, 0x92, 0x60, 0x37, 0x1e, 0x39, 0xcb, 0x9c, 0xee,
-	0x0c, 0xa9, 0xdf, 0x46, 0x1b, 0x58, 0xb2, 0xf7, 0x23, 0x60, 0x0c, 0x87, 0xc0, 0x8c, 0x95, 0x66,
-	0xb1, 0x55, 0x76, 0xaf, 0xa9, 0xfa, 0xbe, 0x2a, 0xef, 0xde, 0x78, 0xf3, 0xbe, 0x51, 0x58, 0x36,
-	0xf8, 0x51, 0x43, 0xa5, 0xbd, 0xec, 0x65, 0xe9, 0x1d, 0x74, 0x49, 0x3c, 0x31, 0x48, 0x85, 0xa1,
-	0xb2, 0x63, 0x7c, 0x3e, 0x69, 0x57, 0xd5, 0xdc, 0xbb, 0x41, 0x90, 0x02, 0x63, 0x07, 0x3c, 0x25,
-	0x71, 0xe8, 0xe6, 0x8d, 0x33, 0x0c, 0x88, 0xa7, 0xf6, 0x0f, 0x98, 0x85, 0xe8, 0xc5, 0xff, 0x8d,
-	0xee, 0x74, 0x4f, 0xc7, 0xa6, 0x76, 0x36, 0x36, 0xb5, 0xef, 0x63, 0x53, 0x3b, 0x9e, 0x98, 0x85,
-	0xb3, 0x89, 0x59, 0xf8, 0x3a, 0x31, 0x0b, 0xcf, 0xb7, 0xff, 0xf8, 0x12, 0x8e, 0xa6, 0x7f, 0x92,
-	0xde, 0x9a, 0x90, 0xbb, 0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd8, 0x15, 0xc6, 0x4f, 0x05,
-	0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x9b, 0xb4, 0xa5, 0x97, 0xfe, 0x35, 0x45, 0x75, 0x3a, 0x38, 0x55, 0x25, 0x68, 0x18,
+	0xea, 0xd0, 0xb2, 0x95, 0x85, 0xb8, 0x85, 0x08, 0x89, 0x4a, 0xc8, 0x65, 0x62, 0x89, 0x2e, 0xf6,
+	0xab, 0x39, 0x11, 0xdf, 0x59, 0x3e, 0x07, 0x92, 0x6f, 0xd0, 0xb1, 0x23, 0x13, 0x62, 0x66, 0xae,
+	0xf8, 0x0c, 0x15, 0x53, 0x05, 0x0b, 0x13, 0x45, 0xcd, 0xc4, 0xb7, 0x40, 0xbe, 0x3b, 0x3b, 0x21,
+	0x21, 0xa5, 0x42, 0x99, 0x6a, 0xbf, 0x7b, 0xbf, 0x7f, 0xef, 0xd5, 0x17, 0x74, 0xcf, 0x65, 0x3c,
+	0x60, 0xbc, 0x7a, 0x0c, 0xe0, 0x47, 0x98, 0xc6, 0xd5, 0xb7, 0x3b, 0x4d, 0x88, 0xf1, 0x4e, 0x56,
+	0xb0, 0xc2, 0x88, 0xc5, 0x4c, 0x5f, 0x93, 0x7d, 0x56, 0x56, 0x56, 0x7d, 0xeb, 0xab, 0x3e, 0xf3,
+	0x99, 0xe8, 0xa9, 0x26, 0x4f, 0xb2, 0x7d, 0xbd, 0xe4, 0x33, 0xe6, 0xb7, 0xa0, 0x2a, 0xde, 0x9a,
+	0xed, 0xe3, 0x2a, 0xa6, 0xdd, 0xf4, 0x48, 0x32, 0x35, 0x24, 0x46, 0xd1, 0xca, 0x23, 0x53, 0x99,
+	0x69, 0x62, 0x0e, 0x99, 0x11, 0x97, 0x11, 0xaa, 0xce, 0xcb, 0xc3, 0xac, 0x31, 0x09, 0x80, 0xc7,
+	0x38, 0x08, 0x53, 0x82, 0xe1, 0x06, 0xaf, 0x1d, 0xe1, 0x98, 0x30, 0x45, 0xb0, 0xf9, 0x4d, 0x43,
+	0x8b, 0x36, 0xe6, 0xc4, 0xad, 0xb5, 0x5a, 0xec, 0x1d, 0xa6, 0x2e, 0xe8, 0x2d, 0x54, 0xe4, 0x21,
+	0x50, 0xaf, 0xd1, 0x22, 0x01, 0x89, 0x0d, 0x6d, 0x23, 0x5f, 0x29, 0xee, 0x96, 0x2c, 0xe5, 0x2b,
+	0x71, 0x92, 0x46, 0xb5, 0xf6, 0x19, 0xa1, 0xf6, 0x83, 0xf3, 0x1f, 0xe5, 0xdc, 0xa7, 0xcb, 0x72,
+	0xc5, 0x27, 0xf1, 0xeb, 0x76, 0xd3, 0x72, 0x59, 0xa0, 0x42, 0xa8, 0x3f, 0xdb, 0xdc, 0x7b, 0x53,
+	0x8d, 0xbb, 0x21, 0x70, 0x01, 0xe0, 0x0e, 0x12, 0xfc, 0xcf, 0x13, 0x7a, 0xfd, 0x31, 0x42, 0xd0,
+	0x09, 0x89, 0x34, 0x65, 0x4c, 0x6d, 0x68, 0x95, 0xe2, 0xee, 0xba, 0x25, 0x5d, 0x5b, 0xa9, 0x6b,
+	0xeb, 0x65, 0x1a, 0xcb, 0x2e, 0x9c, 0x5e, 0x96, 0x35, 0x67, 0x00, 0xb3, 0xb7, 0xf2, 0xe5, 0x6c,
+	0x7b, 0xe1, 0x29, 0x40, 0x96, 0xe0, 0xd9, 0x66, 0x2f, 0x8f, 0x56, 0x5e, 0x40, 0x44, 0x98, 0x37,
+	0x18, 0x6c, 0x1f, 0x4d, 0x37, 0x93, 0xa8, 0x86, 0x26, 0x54, 0xb6, 0xac, 0x31, 0x1b, 0xb4, 0xfe,
+	0x1c, 0x88, 0x5d, 0x48, 0x02, 0x3a, 0x12, 0xab, 0x3f, 0x42, 0x33, 0xa1, 0x60, 0x56, 0x5e, 0x4b,
+	0x23, 0x5e, 0x0f, 0xd4, 0x84, 0xed, 0x5b, 0x09, 0xee, 0x7d, 0x62, 0x57, 0x41, 0xf4, 0x2e, 0xd2,
+	0xe5, 0x53, 0x63, 0x70, 0xc2, 0xf9, 0xc9, 0x4f, 0x78, 0x59, 0xca, 0x1c, 0xf5, 0xe7, 0xdc, 0x46,
+	0xaa, 0xd6, 0x70, 0x31, 0x95, 0xf2, 0x46, 0x61, 0xf2, 0xc2, 0x8b, 0x52, 0x64, 0x1f, 0x53, 0xa1,
+	0xad, 0xd7, 0xd1, 0xbc, 0x92, 0x8d, 0x80, 0x43, 0x6c, 0x4c, 0xff, 0x73, 0xc1, 0x62, 0x6a, 0x62,
+	0xc9, 0x45, 0x89, 0x74, 0x12, 0xe0, 0xdf, 0xb6, 0xfc, 0x41, 0x43, 0xb7, 0xc5, 0x2b, 0x78, 0x87,
+	0xdc, 0xef, 0xef, 0xf9, 0x09, 0x9a, 0xc3, 0xe9, 0x8b, 0xda, 0xf5, 0xea, 0x88, 0x60, 0x8d, 0x76,
+	0xed, 0x51, 0x4e, 0xa7, 0x8f, 0xd4, 0xef, 0xa3, 0x65, 0x2c, 0xd9, 0x1b, 0x01, 0x70, 0x8e, 0x7d,
+	0xe0, 0xc6, 0xd4, 0x46, 0xbe, 0x32, 0xe7, 0x2c, 0xa9, 0xfa, 0xa1, 0x2a, 0xef, 0xdd, 0x39, 0xf9,
+	0x58, 0xce, 0x8d, 0x1a, 0xfc, 0xa5, 0xa1, 0x92, 0x32, 0xe8, 0x80, 0x4b, 0x42, 0x02, 0x34, 0x9e,
+	0xb8, 0xcd, 0x3a, 0xd2, 0x53, 0x9b, 0x51, 0x2a, 0xa2, 0x8c, 0xda, 0xc6, 0xd7, 0xb3, 0xed, 0x55,
+	0xb5, 0xdd, 0x9a, 0xe7, 0x45, 0xc0, 0xf9, 0x51, 0x1c, 0x11, 0xea, 0x3b, 0x2b, 0x78, 0xc8, 0x17,
+	0xd7, 0xef, 0xa2, 0xc5, 0x94, 0xc8, 0x03, 0xca, 0x02, 0x2e, 0xfe, 0x31, 0xe7, 0x9c, 0x05, 0x55,
+	0x3d, 0x10, 0xc5, 0x71, 0x59, 0x4f, 0x34, 0xb4, 0x74, 0x88, 0x3b, 0x75, 0xcc, 0x27, 0x9e, 0x70,
+	0x0d, 0xcd, 0x06, 0xb8, 0xd3, 0xf0, 0x31, 0x17, 0xdf, 0x5c, 0xc1, 0x99, 0x09, 0x84, 0xd0, 0x38,
+	0x2b, 0x14, 0xcd, 0xd7, 0xa8, 0x57, 0x1b, 0x98, 0x10, 0xca, 0xc8, 0xb8, 0xba, 0xcf, 0x6e, 0xec,
+	0x63, 0x00, 0x3a, 0x4e, 0xef, 0xb3, 0x86, 0xa6, 0xeb, 0xc9, 0x05, 0xa2, 0xef, 0xa2, 0x59, 0x71,
+	0x93, 0x40, 0x24, 0xe2, 0x5e, 0xb7, 0x80, 0xb4, 0xb1, 0x8f, 0x01, 0x63, 0xea, 0x66, 0x98, 0xa1,
+	0xc1, 0xe6, 0xff, 0x77, 0xb0, 0x76, 0xed, 0xfc, 0xca, 0xd4, 0x2e, 0xae, 0x4c, 0xed, 0xe7, 0x95,
+	0xa9, 0x9d, 0xf6, 0xcc, 0xdc, 0x45, 0xcf, 0xcc, 0x7d, 0xef, 0x99, 0xb9, 0x57, 0x5b, 0xd7, 0x7e,
+	0xf0, 0x9d, 0xec, 0xb7, 0xb0, 0x39, 0x23, 0xe4, 0x1e, 0xfe, 0x1e, 0x00, 0xc5, 0x6d, 0x18, 0x11,
+	0x36, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -502,6 +637,136 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowedRecipientAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedRecipientAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedRecipientAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxGasAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxGasAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxGasAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AndAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AndAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AndAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -627,6 +892,62 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *AllowedRecipientAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MaxGasAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *AndAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1109,6 +1430,345 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowedRecipientAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedRecipientAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedRecipientAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxGasAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxGasAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxGasAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AndAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AndAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AndAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &types1.Any{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
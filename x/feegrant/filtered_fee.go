@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -61,7 +63,12 @@ func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.
 		return false, err
 	}
 
-	return allowance.Accept(ctx, fee, msgs)
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		// store the updated state of the underlying allowance
+		a.Allowance, err = newAnyAllowance(allowance)
+	}
+	return remove, err
 }
 
 func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
@@ -103,3 +110,13 @@ func (a *AllowedMsgAllowance) ValidateBasic() error {
 
 	return allowance.ValidateBasic()
 }
+
+// ExpiresAt returns the expiry time of the underlying allowance.
+func (a *AllowedMsgAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -61,6 +62,19 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	suite.Require().Equal(genesis, newGenesis)
 }
 
+func (suite *GenesisTestSuite) TestInitGenesisSkipsExpiredAllowances() {
+	now := time.Now()
+	ctx := suite.ctx.WithBlockTime(now)
+	expired := now.Add(-time.Hour)
+	grant, err := feegrant.NewGrant(granterAddr, granteeAddr, &feegrant.BasicAllowance{Expiration: &expired})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.keeper.InitGenesis(ctx, &feegrant.GenesisState{Allowances: []feegrant.Grant{grant}}))
+	genesis, err := suite.keeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(genesis.Allowances)
+}
+
 func (suite *GenesisTestSuite) TestInitGenesis() {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	suite.Require().NoError(err)
@@ -10,6 +10,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
@@ -93,3 +94,42 @@ func (q Keeper) Allowances(c context.Context, req *feegrant.QueryAllowancesReque
 
 	return &feegrant.QueryAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowancesByGranter queries all the allowances granted by the given granter.
+func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowancesByGranterRequest) (*feegrant.QueryAllowancesByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*feegrant.Grant
+
+	store := ctx.KVStore(q.storeKey)
+	indexStore := prefix.NewStore(store, feegrant.FeeAllowancePrefixByGranter(granterAddr))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		// the key is the length prefixed grantee address
+		kv.AssertKeyAtLeastLength(key, 1)
+		kv.AssertKeyLength(key, 1+int(key[0]))
+		granteeAddr := sdk.AccAddress(key[1:])
+		grant, err := q.getGrant(ctx, granterAddr, granteeAddr)
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
+}
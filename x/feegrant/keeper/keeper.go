@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -47,6 +48,14 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		k.authKeeper.SetAccount(ctx, granteeAcc)
 	}
 
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+	if exp != nil && exp.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiration %s is before the current block time", exp)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 	grant, err := feegrant.NewGrant(granter, grantee, feeAllowance)
@@ -59,7 +68,22 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		return err
 	}
 
+	// keep the expiration queue in sync with the expiration of the stored grant
+	var oldExp *time.Time
+	if oldGrant, err := k.getGrant(ctx, granter, grantee); err == nil {
+		if oldExp, err = expiresAt(oldGrant); err != nil {
+			return err
+		}
+	}
+	if oldExp != nil && (exp == nil || !oldExp.Equal(*exp)) {
+		store.Delete(feegrant.FeeAllowanceQueueKey(*oldExp, granter, grantee))
+	}
+	if exp != nil {
+		store.Set(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee), []byte{0x01})
+	}
+
 	store.Set(key, bz)
+	store.Set(feegrant.FeeAllowanceByGranterKey(granter, grantee), []byte{0x01})
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -74,7 +98,7 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 
 // revokeAllowance removes an existing grant
 func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
-	_, err := k.getGrant(ctx, granter, grantee)
+	grant, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
@@ -82,6 +106,12 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	store := ctx.KVStore(k.storeKey)
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 	store.Delete(key)
+	store.Delete(feegrant.FeeAllowanceByGranterKey(granter, grantee))
+
+	// an allowance whose expiration cannot be read was not queued
+	if exp, err := expiresAt(grant); err == nil && exp != nil {
+		store.Delete(feegrant.FeeAllowanceQueueKey(*exp, granter, grantee))
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -144,6 +174,44 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant feegrant.
 	return nil
 }
 
+// RemoveExpiredAllowances deletes at most limit grants whose expiration is before the
+// block time, in order of expiration, and returns the number of deleted grants.
+func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context, limit int) (int, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the expired entries first, the store must not be written while iterating
+	var expired [][]byte
+	iter := store.Iterator(feegrant.FeeAllowanceQueueKeyPrefix, feegrant.FeeAllowanceQueueTimePrefix(ctx.BlockTime()))
+	for ; iter.Valid() && len(expired) < limit; iter.Next() {
+		expired = append(expired, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		_, granter, grantee, err := feegrant.ParseFeeAllowanceQueueKey(key)
+		if err != nil {
+			return 0, err
+		}
+
+		store.Delete(key)
+		if err := k.revokeAllowance(ctx, granter, grantee); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(expired), nil
+}
+
+// expiresAt returns the expiration of the allowance of the grant.
+func expiresAt(grant *feegrant.Grant) (*time.Time, error) {
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
+
 // UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
 func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	f, err := k.getGrant(ctx, granter, grantee)
@@ -190,7 +258,8 @@ func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 	)
 }
 
-// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState.
+// Allowances which are already expired at the genesis block time are skipped.
 func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error {
 	for _, f := range data.Allowances {
 		granter, err := sdk.AccAddressFromBech32(f.Granter)
@@ -207,6 +276,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 			return err
 		}
 
+		exp, err := grant.ExpiresAt()
+		if err != nil {
+			return err
+		}
+		if exp != nil && exp.Before(ctx.BlockTime()) {
+			continue
+		}
+
 		err = k.GrantAllowance(ctx, granter, grantee, grant)
 		if err != nil {
 			return err
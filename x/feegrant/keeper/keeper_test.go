@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -218,10 +219,14 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 		SpendLimit: eth,
 		Expiration: &blockTime,
 	}
-	// creating expired feegrant
-	ctx := suite.sdkCtx.WithBlockTime(oneYear)
-	err := suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], expired)
+	// creating feegrant which is expired a year later
+	err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2], expired)
 	suite.Require().NoError(err)
+	ctx := suite.sdkCtx.WithBlockTime(oneYear)
+
+	// expect error: feegrant already expired
+	err = suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[3], expired)
+	suite.Require().Error(err)
 
 	// expect error: feegrant expired
 	err = suite.keeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], eth, []sdk.Msg{})
@@ -235,6 +240,85 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 
 }
 
+func (suite *KeeperTestSuite) TestUseGrantedFeeNestedAllowances() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+	basic := &feegrant.BasicAllowance{SpendLimit: suite.atom}
+	maxGas, err := feegrant.NewMaxGasAllowance(basic, 100000)
+	suite.Require().NoError(err)
+	allowance, err := feegrant.NewAndAllowance(maxGas, &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.GrantAllowance(suite.sdkCtx, granter, grantee, allowance))
+
+	ctx := suite.sdkCtx.WithGasMeter(sdk.NewGasMeter(200000))
+	suite.Require().ErrorIs(suite.keeper.UseGrantedFees(ctx, granter, grantee, fee, []sdk.Msg{}), feegrant.ErrGasLimitExceeded)
+
+	ctx = suite.sdkCtx.WithGasMeter(sdk.NewGasMeter(100000))
+	suite.Require().NoError(suite.keeper.UseGrantedFees(ctx, granter, grantee, fee, []sdk.Msg{}))
+
+	// the spent fee is stored in the innermost allowance
+	stored, err := suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	allowances, err := stored.(*feegrant.AndAllowance).GetAllowances()
+	suite.Require().NoError(err)
+	inner, err := allowances[0].(*feegrant.MaxGasAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(fee), inner.(*feegrant.BasicAllowance).SpendLimit)
+}
+
+func (suite *KeeperTestSuite) TestRemoveExpiredAllowances() {
+	now := suite.sdkCtx.BlockTime()
+	oneHour, twoHours := now.Add(time.Hour), now.Add(2*time.Hour)
+	granter := suite.addrs[0]
+
+	for i, exp := range []*time.Time{&oneHour, &oneHour, &twoHours, nil} {
+		allowance := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: exp}
+		suite.Require().NoError(suite.keeper.GrantAllowance(suite.sdkCtx, granter, suite.addrs[i], allowance))
+	}
+
+	suite.T().Log("verify nothing is removed before the expiration")
+	removed, err := suite.keeper.RemoveExpiredAllowances(suite.sdkCtx.WithBlockTime(oneHour), 10)
+	suite.Require().NoError(err)
+	suite.Require().Zero(removed)
+
+	suite.T().Log("verify the removals are bounded by the limit")
+	ctx := suite.sdkCtx.WithBlockTime(twoHours.Add(time.Second))
+	removed, err = suite.keeper.RemoveExpiredAllowances(ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, removed)
+	_, err = suite.keeper.GetAllowance(ctx, granter, suite.addrs[2])
+	suite.Require().NoError(err)
+
+	removed, err = suite.keeper.RemoveExpiredAllowances(ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, removed)
+
+	suite.T().Log("verify the allowance without expiration is kept, in the store and the granter index")
+	resp, err := suite.keeper.AllowancesByGranter(sdk.WrapSDKContext(ctx), &feegrant.QueryAllowancesByGranterRequest{Granter: granter.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 1)
+	suite.Require().Equal(suite.addrs[3].String(), resp.Allowances[0].Grantee)
+}
+
+func (suite *KeeperTestSuite) TestExpirationQueueUpdates() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	now := suite.sdkCtx.BlockTime()
+	oneHour, twoHours := now.Add(time.Hour), now.Add(2*time.Hour)
+	store := suite.sdkCtx.KVStore(suite.app.GetKey(feegrant.StoreKey))
+
+	suite.T().Log("verify overwriting an allowance moves it in the queue")
+	suite.Require().NoError(suite.keeper.GrantAllowance(suite.sdkCtx, granter, grantee, &feegrant.BasicAllowance{Expiration: &oneHour}))
+	suite.Require().NoError(suite.keeper.GrantAllowance(suite.sdkCtx, granter, grantee, &feegrant.BasicAllowance{Expiration: &twoHours}))
+	suite.Require().False(store.Has(feegrant.FeeAllowanceQueueKey(oneHour, granter, grantee)))
+	suite.Require().True(store.Has(feegrant.FeeAllowanceQueueKey(twoHours, granter, grantee)))
+
+	suite.T().Log("verify revoking an allowance removes it from the queue and the granter index")
+	_, err := suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: granter.String(), Grantee: grantee.String()})
+	suite.Require().NoError(err)
+	suite.Require().False(store.Has(feegrant.FeeAllowanceQueueKey(twoHours, granter, grantee)))
+	suite.Require().False(store.Has(feegrant.FeeAllowanceByGranterKey(granter, grantee)))
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
@@ -1,8 +1,11 @@
 package feegrant
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
 const (
@@ -22,6 +25,12 @@ const (
 var (
 	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
 	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for the fee allowance expiration queue
+	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// FeeAllowanceByGranterKeyPrefix is the set of the kvstore for the granter index of fee allowances
+	FeeAllowanceByGranterKeyPrefix = []byte{0x02}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -34,3 +43,52 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
+
+// FeeAllowanceQueueKey is the key of a grant in the expiration queue, ordered by
+// expiration so that the expired grants can be found by iterating up to the block time.
+// Key format is:
+//
+// - 0x01<expiration><granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes>
+func FeeAllowanceQueueKey(expiration time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := FeeAllowanceQueueTimePrefix(expiration)
+	key = append(key, address.MustLengthPrefix(granter.Bytes())...)
+	return append(key, address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// FeeAllowanceQueueTimePrefix returns the prefix of the queue keys of the grants expiring at the given time.
+func FeeAllowanceQueueTimePrefix(expiration time.Time) []byte {
+	return append(append([]byte{}, FeeAllowanceQueueKeyPrefix...), sdk.FormatTimeBytes(expiration)...)
+}
+
+// ParseFeeAllowanceQueueKey splits the expiration, granter and grantee from a fee allowance queue key.
+func ParseFeeAllowanceQueueKey(key []byte) (time.Time, sdk.AccAddress, sdk.AccAddress, error) {
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, 2+timeLen)
+	exp, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		return exp, nil, nil, err
+	}
+
+	granterAddrLen := int(key[1+timeLen])
+	kv.AssertKeyAtLeastLength(key, 3+timeLen+granterAddrLen)
+	granter := sdk.AccAddress(key[2+timeLen : 2+timeLen+granterAddrLen])
+
+	granteeAddrLen := int(key[2+timeLen+granterAddrLen])
+	kv.AssertKeyAtLeastLength(key, 3+timeLen+granterAddrLen+granteeAddrLen)
+	grantee := sdk.AccAddress(key[3+timeLen+granterAddrLen : 3+timeLen+granterAddrLen+granteeAddrLen])
+
+	return exp, granter, grantee, nil
+}
+
+// FeeAllowanceByGranterKey is the key of a grant in the granter index.
+// Key format is:
+//
+// - 0x02<granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes>
+func FeeAllowanceByGranterKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGranter(granter), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// FeeAllowancePrefixByGranter returns a prefix to scan the granter index for all grants given by this address.
+func FeeAllowancePrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(append([]byte{}, FeeAllowanceByGranterKeyPrefix...), address.MustLengthPrefix(granter.Bytes())...)
+}
@@ -0,0 +1,89 @@
+package feegrant
+
+import (
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*MaxGasAllowance)(nil)
+var _ types.UnpackInterfacesMessage = (*MaxGasAllowance)(nil)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *MaxGasAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewMaxGasAllowance creates new gas capped fee allowance.
+func NewMaxGasAllowance(allowance FeeAllowanceI, maxGas uint64) (*MaxGasAllowance, error) {
+	any, err := newAnyAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxGasAllowance{
+		Allowance: any,
+		MaxGas:    maxGas,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *MaxGasAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// Accept method checks the gas limit of the tx, read from the gas meter of the
+// context, before passing the fee to the underlying allowance. The infinite gas
+// meter used when simulating txs is not checked, so the gas can be estimated.
+func (a *MaxGasAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if gas := ctx.GasMeter().Limit(); gas != math.MaxUint64 && gas > a.MaxGas {
+		return false, sdkerrors.Wrapf(ErrGasLimitExceeded, "tx gas limit %d is above the allowed %d", gas, a.MaxGas)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		a.Allowance, err = newAnyAllowance(allowance)
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *MaxGasAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if a.MaxGas == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max gas must be positive")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the underlying allowance.
+func (a *MaxGasAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
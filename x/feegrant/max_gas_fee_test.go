@@ -0,0 +1,45 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestMaxGasAllowance(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	allowance, err := feegrant.NewMaxGasAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, 0)
+	require.NoError(t, err)
+	require.Error(t, allowance.ValidateBasic())
+
+	allowance, err = feegrant.NewMaxGasAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, 100000)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	t.Log("verify txs above the max gas are rejected")
+	_, err = allowance.Accept(ctx.WithGasMeter(sdk.NewGasMeter(100001)), fee, nil)
+	require.ErrorIs(t, err, feegrant.ErrGasLimitExceeded)
+
+	t.Log("verify txs up to the max gas are accepted")
+	remove, err := allowance.Accept(ctx.WithGasMeter(sdk.NewGasMeter(100000)), fee, nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	t.Log("verify simulated txs are accepted")
+	remove, err = allowance.Accept(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), fee, nil)
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	inner, err := allowance.GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 80)), inner.(*feegrant.BasicAllowance).SpendLimit)
+}
@@ -0,0 +1,57 @@
+package v046
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// Keys for store prefixes
+var (
+	FeeAllowanceKeyPrefix          = []byte{0x00}
+	FeeAllowanceQueueKeyPrefix     = []byte{0x01}
+	FeeAllowanceByGranterKeyPrefix = []byte{0x02}
+)
+
+// FeeAllowanceKey returns the key of a grant:
+//
+// - 0x00<granteeAddressLen (1 Byte)><granteeAddressBytes><granterAddressLen (1 Byte)><granterAddressBytes>: Grant
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	key := append([]byte{}, FeeAllowanceKeyPrefix...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	return append(key, address.MustLengthPrefix(granter)...)
+}
+
+// FeeAllowanceQueueKey returns the key of a grant in the expiration queue:
+//
+// - 0x01<expiration><granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes>
+func FeeAllowanceQueueKey(expiration time.Time, granter, grantee sdk.AccAddress) []byte {
+	key := append([]byte{}, FeeAllowanceQueueKeyPrefix...)
+	key = append(key, sdk.FormatTimeBytes(expiration)...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, address.MustLengthPrefix(grantee)...)
+}
+
+// FeeAllowanceByGranterKey returns the key of a grant in the granter index:
+//
+// - 0x02<granterAddressLen (1 Byte)><granterAddressBytes><granteeAddressLen (1 Byte)><granteeAddressBytes>
+func FeeAllowanceByGranterKey(granter, grantee sdk.AccAddress) []byte {
+	key := append([]byte{}, FeeAllowanceByGranterKeyPrefix...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, address.MustLengthPrefix(grantee)...)
+}
+
+// parseFeeAllowanceKey splits the granter and grantee from a grant key.
+func parseFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddress) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	granteeAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+granteeAddrLen)
+	grantee = sdk.AccAddress(key[2 : 2+granteeAddrLen])
+	granterAddrLen := int(key[2+granteeAddrLen])
+	kv.AssertKeyLength(key, 3+granteeAddrLen+granterAddrLen)
+	granter = sdk.AccAddress(key[3+granteeAddrLen:])
+
+	return granter, grantee
+}
@@ -0,0 +1,59 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Delete the grants which are already expired.
+// - Add the remaining grants to the expiration queue and to the granter index.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var expired, indexKeys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, FeeAllowanceKeyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		var grant feegrant.Grant
+		if err := cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			iter.Close()
+			return err
+		}
+
+		allowance, err := grant.GetGrant()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		exp, err := allowance.ExpiresAt()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if exp != nil && exp.Before(ctx.BlockTime()) {
+			expired = append(expired, append([]byte{}, iter.Key()...))
+			continue
+		}
+
+		granter, grantee := parseFeeAllowanceKey(iter.Key())
+		indexKeys = append(indexKeys, FeeAllowanceByGranterKey(granter, grantee))
+		if exp != nil {
+			indexKeys = append(indexKeys, FeeAllowanceQueueKey(*exp, granter, grantee))
+		}
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{0x01})
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	v046 "github.com/cosmos/cosmos-sdk/x/feegrant/migrations/v046"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	feegrantKey := sdk.NewKVStoreKey(feegrant.StoreKey)
+	ctx := testutil.DefaultContext(feegrantKey, sdk.NewTransientStoreKey("transient_test"))
+	now := time.Date(2021, 11, 5, 10, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	store := ctx.KVStore(feegrantKey)
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee1 := sdk.AccAddress("grantee1____________")
+	grantee2 := sdk.AccAddress("grantee2____________")
+	grantee3 := sdk.AccAddress("grantee3____________")
+	oneHour, oneHourAgo := now.Add(time.Hour), now.Add(-time.Hour)
+
+	grants := []struct {
+		grantee    sdk.AccAddress
+		expiration *time.Time
+	}{
+		{grantee1, &oneHour},
+		{grantee2, &oneHourAgo},
+		{grantee3, nil},
+	}
+	for _, g := range grants {
+		grant, err := feegrant.NewGrant(granter, g.grantee, &feegrant.BasicAllowance{Expiration: g.expiration})
+		require.NoError(t, err)
+		bz, err := encCfg.Codec.Marshal(&grant)
+		require.NoError(t, err)
+		store.Set(v046.FeeAllowanceKey(granter, g.grantee), bz)
+	}
+
+	require.NoError(t, v046.MigrateStore(ctx, feegrantKey, encCfg.Codec))
+
+	// expired grants are deleted
+	require.False(t, store.Has(v046.FeeAllowanceKey(granter, grantee2)))
+	require.False(t, store.Has(v046.FeeAllowanceQueueKey(oneHourAgo, granter, grantee2)))
+	require.False(t, store.Has(v046.FeeAllowanceByGranterKey(granter, grantee2)))
+
+	// the other grants are indexed by granter, and queued by expiration if they expire
+	require.True(t, store.Has(v046.FeeAllowanceQueueKey(oneHour, granter, grantee1)))
+	require.True(t, store.Has(v046.FeeAllowanceByGranterKey(granter, grantee1)))
+	require.True(t, store.Has(v046.FeeAllowanceByGranterKey(granter, grantee3)))
+
+	// the keys match the ones of the keeper
+	require.Equal(t, feegrant.FeeAllowanceKey(granter, grantee1), v046.FeeAllowanceKey(granter, grantee1))
+	require.Equal(t, feegrant.FeeAllowanceQueueKey(oneHour, granter, grantee1), v046.FeeAllowanceQueueKey(oneHour, granter, grantee1))
+	require.Equal(t, feegrant.FeeAllowanceByGranterKey(granter, grantee1), v046.FeeAllowanceByGranterKey(granter, grantee1))
+}
@@ -0,0 +1,27 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+// MaxPrunedAllowancesPerBlock bounds the number of expired allowances deleted in a single
+// block. Any remaining expired allowances are pruned in the following blocks.
+const MaxPrunedAllowancesPerBlock = 200
+
+// EndBlocker is called at the end of every block and prunes expired allowances.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(feegrant.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	removed, err := k.RemoveExpiredAllowances(ctx, MaxPrunedAllowancesPerBlock)
+	if err != nil {
+		panic(err)
+	}
+	if removed > 0 {
+		k.Logger(ctx).Debug("pruned expired fee allowances", "count", removed)
+	}
+}
@@ -105,3 +105,8 @@ func (a PeriodicAllowance) ValidateBasic() error {
 
 	return nil
 }
+
+// ExpiresAt returns the expiry time of the PeriodicAllowance.
+func (a PeriodicAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Basic.ExpiresAt()
+}
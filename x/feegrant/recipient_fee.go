@@ -0,0 +1,163 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*AllowedRecipientAllowance)(nil)
+var _ types.UnpackInterfacesMessage = (*AllowedRecipientAllowance)(nil)
+
+// TransferMsg is implemented by messages transferring funds to other accounts, such as
+// bank's MsgSend and MsgMultiSend. AllowedRecipientAllowance only accepts txs whose
+// messages all implement it.
+type TransferMsg interface {
+	sdk.Msg
+
+	// GetRecipients returns the bech32 addresses receiving funds.
+	GetRecipients() []string
+
+	// GetTransferredCoins returns the coins transferred by the message.
+	GetTransferredCoins() sdk.Coins
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedRecipientAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewAllowedRecipientAllowance creates new recipient restricted fee allowance.
+func NewAllowedRecipientAllowance(allowance FeeAllowanceI, allowedRecipients []sdk.AccAddress, allowedDenoms []string) (*AllowedRecipientAllowance, error) {
+	any, err := newAnyAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]string, len(allowedRecipients))
+	for i, addr := range allowedRecipients {
+		recipients[i] = addr.String()
+	}
+
+	return &AllowedRecipientAllowance{
+		Allowance:         any,
+		AllowedRecipients: recipients,
+		AllowedDenoms:     allowedDenoms,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *AllowedRecipientAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// Accept method checks that all the messages transfer funds to allowed recipients in
+// allowed denominations before passing the fee to the underlying allowance.
+func (a *AllowedRecipientAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	recipients := toSet(ctx, a.AllowedRecipients)
+	denoms := toSet(ctx, a.AllowedDenoms)
+
+	for _, msg := range msgs {
+		transfer, ok := msg.(TransferMsg)
+		if !ok {
+			return false, sdkerrors.Wrapf(ErrMessageNotAllowed, "%s does not transfer funds", sdk.MsgTypeURL(msg))
+		}
+
+		if len(recipients) > 0 {
+			for _, recipient := range transfer.GetRecipients() {
+				ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check recipient")
+				if !recipients[recipient] {
+					return false, sdkerrors.Wrapf(ErrRecipientNotAllowed, "%s is not an allowed recipient", recipient)
+				}
+			}
+		}
+
+		if len(denoms) > 0 {
+			for _, coin := range transfer.GetTransferredCoins() {
+				ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check denom")
+				if !denoms[coin.Denom] {
+					return false, sdkerrors.Wrapf(ErrDenomNotAllowed, "%s is not an allowed denomination", coin.Denom)
+				}
+			}
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		a.Allowance, err = newAnyAllowance(allowance)
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedRecipientAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedRecipients) == 0 && len(a.AllowedDenoms) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allowed recipients and allowed denoms cannot both be empty")
+	}
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed recipient %s: %s", recipient, err)
+		}
+	}
+	for _, denom := range a.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid allowed denom %s: %s", denom, err)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func toSet(ctx sdk.Context, items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		set[item] = true
+	}
+
+	return set
+}
+
+// newAnyAllowance packs the allowance into an Any, used by allowances wrapping
+// other allowances.
+func newAnyAllowance(allowance FeeAllowanceI) (*types.Any, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return types.NewAnyWithValue(msg)
+}
+
+// ExpiresAt returns the expiry time of the underlying allowance.
+func (a *AllowedRecipientAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	return allowance.ExpiresAt()
+}
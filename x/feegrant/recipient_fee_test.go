@@ -0,0 +1,113 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	_ feegrant.TransferMsg = &banktypes.MsgSend{}
+	_ feegrant.TransferMsg = &banktypes.MsgMultiSend{}
+)
+
+func TestAllowedRecipientAllowance(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	from := sdk.AccAddress("_______from_________")
+	allowed := sdk.AccAddress("______allowed_______")
+	other := sdk.AccAddress("_______other________")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 100))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	cases := map[string]struct {
+		recipients []sdk.AccAddress
+		denoms     []string
+		msgs       []sdk.Msg
+		valid      bool
+		accept     bool
+	}{
+		"no restriction": {
+			msgs:  []sdk.Msg{banktypes.NewMsgSend(from, allowed, atom)},
+			valid: false,
+		},
+		"allowed recipient": {
+			recipients: []sdk.AccAddress{allowed},
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(from, allowed, eth)},
+			valid:      true,
+			accept:     true,
+		},
+		"recipient not allowed": {
+			recipients: []sdk.AccAddress{allowed},
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(from, allowed, atom), banktypes.NewMsgSend(from, other, atom)},
+			valid:      true,
+			accept:     false,
+		},
+		"multi send to allowed recipients": {
+			recipients: []sdk.AccAddress{allowed, other},
+			msgs: []sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(from, atom.Add(eth...))},
+				[]banktypes.Output{banktypes.NewOutput(allowed, atom), banktypes.NewOutput(other, eth)},
+			)},
+			valid:  true,
+			accept: true,
+		},
+		"allowed denom": {
+			denoms: []string{"atom"},
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(from, other, atom)},
+			valid:  true,
+			accept: true,
+		},
+		"denom not allowed": {
+			recipients: []sdk.AccAddress{allowed},
+			denoms:     []string{"atom"},
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(from, allowed, eth)},
+			valid:      true,
+			accept:     false,
+		},
+		"not a transfer": {
+			recipients: []sdk.AccAddress{allowed},
+			msgs:       []sdk.Msg{govtypes.NewMsgVote(from, 1, govtypes.OptionYes)},
+			valid:      true,
+			accept:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			basic := &feegrant.BasicAllowance{SpendLimit: atom}
+			allowance, err := feegrant.NewAllowedRecipientAllowance(basic, tc.recipients, tc.denoms)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := allowance.Accept(ctx, fee, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, remove)
+
+			// the spent fee is recorded in the underlying allowance
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, atom.Sub(fee), inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
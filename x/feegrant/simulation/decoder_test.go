@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -37,6 +38,8 @@ func TestDecodeStore(t *testing.T) {
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(feegrant.FeeAllowanceKeyPrefix), Value: grantBz},
+			{Key: feegrant.FeeAllowanceQueueKey(time.Now(), granterAddr, granteeAddr), Value: []byte{0x01}},
+			{Key: feegrant.FeeAllowanceByGranterKey(granterAddr, granteeAddr), Value: []byte{0x01}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -46,6 +49,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"FeeAllowanceQueue", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"FeeAllowanceByGranter", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
 		{"other", ""},
 	}
 
@@ -43,6 +43,17 @@ func (s *IntegrationTestSuite) TestMigrateGenesis() {
 				s.Require().Contains(jsonOut, "\"weight\":\"1.000000000000000000\"")
 			},
 		},
+		{
+			"migrate 0.43 to 0.46",
+			v040Valid,
+			"v0.46",
+			false, "",
+			func(jsonOut string) {
+				// Make sure the json output contains the gov expedited and deposit burn params.
+				s.Require().Contains(jsonOut, "\"expedited_threshold\":\"0.667000000000000000\"")
+				s.Require().Contains(jsonOut, "\"burn_ratio\":\"1.000000000000000000\"")
+			},
+		},
 	}
 
 	for _, tc := range testCases {
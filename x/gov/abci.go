@@ -16,7 +16,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 	logger := keeper.Logger(ctx)
 
-	// delete dead proposals from store and burn theirs deposits. A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
+	// delete dead proposals from store and burn theirs deposits, as set by the deposit params. A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalId)
 		keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
@@ -49,6 +49,39 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal which does not pass is converted to a regular
+		// proposal and keeps its deposits and votes for the remaining of the
+		// regular voting period.
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			logger.Info(
+				"expedited proposal tallied",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+				"result", "rejected, converted to regular proposal",
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		keeper.DeleteVotes(ctx, proposal.ProposalId)
+
+		// whether the deposits of a proposal which did not reach the quorum or
+		// was vetoed are burned, and how, is set by the deposit params
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
 		} else {
@@ -56,13 +89,12 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			err := keeper.ExecuteProposal(cacheCtx, proposal.GetContent())
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -10,6 +10,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -288,7 +290,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -338,7 +340,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -379,3 +381,234 @@ func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sd
 		require.NotNil(t, res)
 	}
 }
+
+func TestEndBlockerMessagesProposal(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sendAmount int64
+		expStatus  types.ProposalStatus
+	}{
+		{"all messages succeed", 5, types.StatusPassed},
+		// the second message fails, so the first one is reverted as well
+		{"second message fails", 50, types.StatusFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			govAcct := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			funds := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+			require.NoError(t, banktestutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, funds))
+
+			content, err := types.NewMessagesProposal("Test", "description", []sdk.Msg{
+				banktypes.NewMsgSend(govAcct, addrs[1], sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+				banktypes.NewMsgSend(govAcct, addrs[1], sdk.NewCoins(sdk.NewInt64Coin("foocoin", tc.sendAmount))),
+			})
+			require.NoError(t, err)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, content, false)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], proposalCoins)
+			require.NoError(t, err)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+
+			received := app.BankKeeper.GetBalance(ctx, addrs[1], "foocoin").Amount.Int64()
+			if tc.expStatus == types.StatusPassed {
+				require.Equal(t, 5+tc.sendAmount, received)
+			} else {
+				require.Zero(t, received)
+			}
+		})
+	}
+}
+
+func TestEndBlockerExpeditedProposal(t *testing.T) {
+	testCases := []struct {
+		name       string
+		yesPower   int64
+		noPower    int64
+		expPassed  bool
+		expRegular bool
+	}{
+		{"meets the expedited threshold", 7, 3, true, false},
+		// 60% of yes votes is below the expedited threshold, but above the
+		// regular one
+		{"converted to regular proposal", 6, 4, true, true},
+		{"rejected as regular proposal", 4, 6, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 4, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx,
+				[]sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])},
+				[]int64{tc.yesPower, tc.noPower},
+			)
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, true)
+			require.NoError(t, err)
+			require.True(t, proposal.Expedited)
+
+			// the regular minimum deposit does not activate an expedited proposal
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			activated, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[2], depositParams.MinDeposit)
+			require.NoError(t, err)
+			require.False(t, activated)
+
+			activated, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[3], depositParams.ExpeditedMinDeposit.Sub(depositParams.MinDeposit))
+			require.NoError(t, err)
+			require.True(t, activated)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+
+			if tc.expRegular {
+				// the proposal keeps its deposits and votes until the end of the
+				// regular voting period
+				require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+				require.False(t, proposal.Expedited)
+				require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+				require.Len(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId), 2)
+				require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 2)
+
+				newHeader = ctx.BlockHeader()
+				newHeader.Time = proposal.VotingEndTime
+				ctx = ctx.WithBlockHeader(newHeader)
+
+				gov.EndBlocker(ctx, app.GovKeeper)
+
+				proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+				require.True(t, ok)
+			}
+
+			if tc.expPassed {
+				require.Equal(t, types.StatusPassed, proposal.Status)
+			} else {
+				require.Equal(t, types.StatusRejected, proposal.Status)
+			}
+			require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+			require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+			require.False(t, activeQueue.Valid())
+			activeQueue.Close()
+		})
+	}
+}
+
+func TestEndBlockerDepositBurnParams(t *testing.T) {
+	testCases := []struct {
+		name                string
+		veto                bool
+		burnVoteQuorum      bool
+		burnVoteVeto        bool
+		burnRatio           sdk.Dec
+		burnToCommunityPool bool
+		expBurnRatio        sdk.Dec
+	}{
+		{"no quorum, burned", false, true, false, sdk.OneDec(), false, sdk.OneDec()},
+		{"no quorum, refunded", false, false, true, sdk.OneDec(), false, sdk.ZeroDec()},
+		{"no quorum, partly sent to community pool", false, true, false, sdk.NewDecWithPrec(4, 1), true, sdk.NewDecWithPrec(4, 1)},
+		{"vetoed, burned", true, false, true, sdk.OneDec(), false, sdk.OneDec()},
+		{"vetoed, refunded", true, true, false, sdk.OneDec(), false, sdk.ZeroDec()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			depositParams.BurnVoteQuorum = tc.burnVoteQuorum
+			depositParams.BurnVoteVeto = tc.burnVoteVeto
+			depositParams.BurnRatio = tc.burnRatio
+			depositParams.BurnToCommunityPool = tc.burnToCommunityPool
+			app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
+			require.NoError(t, err)
+			balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+			communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom)
+			activated, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[1], depositParams.MinDeposit)
+			require.NoError(t, err)
+			require.True(t, activated)
+
+			if tc.veto {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, types.StatusRejected, proposal.Status)
+			require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+
+			burned := depositParams.MinDeposit.AmountOf(sdk.DefaultBondDenom).ToDec().Mul(tc.expBurnRatio).TruncateInt()
+			require.Equal(t, balance.SubAmount(burned), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+			if tc.burnToCommunityPool {
+				require.Equal(t, communityPool.Add(burned.ToDec()), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
@@ -7,7 +7,10 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
@@ -21,6 +24,7 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		proposal.Description, _ = fs.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(proposalType)
 		proposal.Deposit, _ = fs.GetString(FlagDeposit)
+		proposal.Expedited, _ = fs.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -30,6 +34,10 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		}
 	}
 
+	if fs.Changed(FlagExpedited) {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagExpedited)
+	}
+
 	contents, err := os.ReadFile(proposalFile)
 	if err != nil {
 		return nil, err
@@ -42,3 +50,37 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseMessagesProposal reads a messages proposal JSON file, decoding its
+// messages with cdc. It returns the proposal content and its deposit.
+func parseMessagesProposal(cdc codec.JSONCodec, proposalFile string) (*types.MessagesProposal, sdk.Coins, error) {
+	var proposal messagesProposal
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return nil, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, bz := range proposal.Messages {
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msgs[i]); err != nil {
+			return nil, nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := types.NewMessagesProposal(proposal.Title, proposal.Description, msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return content, deposit, nil
+}
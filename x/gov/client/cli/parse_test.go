@@ -14,7 +14,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "expedited": true
 }
 `)
 
@@ -39,6 +40,7 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.True(t, proposal1.Expedited)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -47,6 +49,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 		require.Error(t, err)
 		fs.Set(incompatibleFlag, "")
 	}
+	fs.Set(FlagExpedited, "true")
+	_, err = parseSubmitProposalFlags(fs)
+	require.Error(t, err)
 
 	// no --proposal, only flags
 	fs.Set(FlagProposal, "")
@@ -61,6 +66,7 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.Equal(t, proposal1.Expedited, proposal2.Expedited)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
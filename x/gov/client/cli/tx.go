@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -22,6 +24,7 @@ const (
 	FlagDescription  = "description"
 	FlagProposalType = "type"
 	FlagDeposit      = "deposit"
+	FlagExpedited    = "expedited"
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
@@ -33,6 +36,15 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
+}
+
+// messagesProposal defines the JSON file format of a messages proposal.
+type messagesProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Messages    []json.RawMessage `json:"messages"`
+	Deposit     string            `json:"deposit"`
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -64,6 +76,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		flags.AddTxFlagsToCmd(propCmd)
 		cmdSubmitProp.AddCommand(propCmd)
 	}
+	cmdSubmitProp.AddCommand(NewCmdSubmitMessagesProposal())
 
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
@@ -82,7 +95,9 @@ func NewCmdSubmitProposal() *cobra.Command {
 		Short: "Submit a proposal along with an initial deposit",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a proposal along with an initial deposit.
-Proposal title, description, type and deposit can be given directly or through a proposal JSON file.
+Proposal title, description, type, deposit and expedited can be given directly or through a proposal JSON file.
+An expedited proposal has a shorter voting period, but a higher minimum deposit and threshold. If it does
+not reach the expedited threshold, it is converted to a regular proposal.
 
 Example:
 $ %s tx gov submit-proposal --proposal="path/to/proposal.json" --from mykey
@@ -93,12 +108,13 @@ Where proposal.json contains:
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "10test"
+  "deposit": "10test",
+  "expedited": false
 }
 
 Which is equivalent to:
 
-$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --expedited=false --from mykey
 `,
 				version.AppName, version.AppName,
 			),
@@ -125,6 +141,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Expedited = proposal.Expedited
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -134,12 +151,75 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagDescription, "", "The proposal description")
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().Bool(FlagExpedited, false, "Whether the proposal is expedited")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// NewCmdSubmitMessagesProposal implements submitting a messages proposal
+// transaction command.
+func NewCmdSubmitMessagesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "messages [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages along with an initial deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal executing messages along with an initial deposit.
+The proposal details must be supplied via a JSON file. The messages are executed
+atomically when the proposal passes, and the only signer of every message must
+be the gov module account (%s).
+
+Example:
+$ %s tx gov submit-proposal messages <path/to/proposal.json> [--expedited] --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Spend",
+  "description": "Send 10stake from the gov account",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "%s",
+      "to_address": "cosmos1...",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ],
+  "deposit": "1000stake"
+}
+`,
+				authtypes.NewModuleAddress(types.ModuleName), version.AppName, authtypes.NewModuleAddress(types.ModuleName),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, deposit, err := parseMessagesProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+			msg.Expedited, _ = cmd.Flags().GetBool(FlagExpedited)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExpedited, false, "Whether the proposal is expedited")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdDeposit implements depositing tokens for an active proposal.
 func NewCmdDeposit() *cobra.Command {
 	cmd := &cobra.Command{
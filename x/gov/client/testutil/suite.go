@@ -15,6 +15,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -90,22 +91,30 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"voting_params":{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":true,"burn_vote_veto":true,"burn_ratio":"1.000000000000000000"}}`,
 		},
 		{
 			"text output",
 			[]string{},
 			`
 deposit_params:
+  burn_ratio: "1.000000000000000000"
+  burn_vote_quorum: true
+  burn_vote_veto: true
+  expedited_min_deposit:
+  - amount: "50000000"
+    denom: stake
   max_deposit_period: "172800000000000"
   min_deposit:
   - amount: "10000000"
     denom: stake
 tally_params:
+  expedited_threshold: "0.667000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
 voting_params:
+  expedited_voting_period: "86400000000000"
   voting_period: "172800000000000"
 	`,
 		},
@@ -139,7 +148,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"}`,
 		},
 		{
 			"tally params",
@@ -147,7 +156,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"tallying",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`,
+			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"}`,
 		},
 		{
 			"deposit params",
@@ -155,7 +164,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"deposit",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}`,
+			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":true,"burn_vote_veto":true,"burn_ratio":"1.000000000000000000"}`,
 		},
 	}
 
@@ -345,6 +354,21 @@ func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 			},
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid expedited transaction",
+			[]string{
+				fmt.Sprintf("--%s='Text Proposal'", cli.FlagTitle),
+				fmt.Sprintf("--%s='Where is the title!?'", cli.FlagDescription),
+				fmt.Sprintf("--%s=%s", cli.FlagProposalType, types.ProposalTypeText),
+				fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)).String()),
+				fmt.Sprintf("--%s=true", cli.FlagExpedited),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -367,6 +391,82 @@ func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdSubmitMessagesProposal() {
+	val := s.network.Validators[0]
+	govAcct := authtypes.NewModuleAddress(types.ModuleName)
+	newPropFile := func(signer sdk.AccAddress) string {
+		prop := fmt.Sprintf(`{
+  "title": "Messages Proposal",
+  "description": "Hello, World!",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "%s",
+      "to_address": "%s",
+      "amount": [{"denom": "%s", "amount": "10"}]
+    }
+  ],
+  "deposit": "%s"
+}`, signer, val.Address, s.cfg.BondDenom, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)))
+		return testutil.WriteToNewTempFile(s.T(), prop).Name()
+	}
+	invalidPropFile := testutil.WriteToNewTempFile(s.T(), `{
+  "title": "Messages Proposal",
+  "description": "Hello, World!",
+  "messages": [{"@type": "/cosmos.bank.v1beta1.MsgUnknown"}],
+  "deposit": "10stake"
+}`)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"unknown message type",
+			[]string{invalidPropFile.Name()},
+			true, 0, nil,
+		},
+		{
+			"message not signed by the gov account",
+			[]string{newPropFile(val.Address)},
+			false, types.ErrInvalidSigner.ABCICode(), &sdk.TxResponse{},
+		},
+		{
+			"valid transaction",
+			[]string{newPropFile(govAcct)},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdSubmitMessagesProposal()
+			clientCtx := val.ClientCtx
+			args := append(tc.args,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			)
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCmdGetProposal() {
 	val := s.network.Validators[0]
 
@@ -36,11 +36,11 @@ func TestImportExportQueues(t *testing.T) {
 
 	// Create two proposals, put the second into the voting period
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 	proposalID1 := proposal1.ProposalId
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 	proposalID2 := proposal2.ProposalId
 
@@ -152,10 +152,10 @@ func TestEqualProposals(t *testing.T) {
 
 	// Submit two proposals
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, false)
 	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
@@ -54,19 +54,38 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 }
 
 // DeleteAndBurnDeposits deletes and burn all the deposits on a specific proposal.
+// Only the BurnRatio of each deposit is burned, the rest being refunded to its
+// depositor, and the burned coins are sent to the community pool instead of
+// being destroyed when BurnToCommunityPool is set.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
+	params := keeper.GetDepositParams(ctx)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
-		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
 		if err != nil {
 			panic(err)
 		}
 
-		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
-		if err != nil {
-			panic(err)
+		burnAmount, _ := sdk.NewDecCoinsFromCoins(deposit.Amount...).MulDecTruncate(params.BurnRatio).TruncateDecimal()
+		if refundAmount := deposit.Amount.Sub(burnAmount); !refundAmount.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refundAmount)
+			if err != nil {
+				panic(err)
+			}
 		}
+
+		if !burnAmount.IsZero() {
+			if params.BurnToCommunityPool {
+				err = keeper.distrKeeper.FundCommunityPool(ctx, burnAmount, keeper.authKeeper.GetModuleAddress(types.ModuleName))
+			} else {
+				err = keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burnAmount)
+			}
+			if err != nil {
+				panic(err)
+			}
+		}
+
 		store.Delete(types.DepositKey(proposalID, depositor))
 		return false
 	})
@@ -135,7 +154,13 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	depositParams := keeper.GetDepositParams(ctx)
+	minDeposit := depositParams.MinDeposit
+	if proposal.Expedited {
+		minDeposit = depositParams.ExpeditedMinDeposit
+	}
+
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(minDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -18,7 +18,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 
@@ -102,7 +102,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID = proposal.ProposalId
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -112,3 +112,53 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestDeleteAndBurnDepositsParams(t *testing.T) {
+	testCases := []struct {
+		name                string
+		burnRatio           sdk.Dec
+		burnToCommunityPool bool
+		expRefund           int64
+		expBurn             int64
+	}{
+		{"burn all", sdk.OneDec(), false, 0, 10},
+		{"burn none", sdk.ZeroDec(), false, 10, 0},
+		{"burn a part", sdk.NewDecWithPrec(25, 2), false, 8, 2},
+		{"send all to community pool", sdk.OneDec(), true, 0, 10},
+		{"send a part to community pool", sdk.NewDecWithPrec(25, 2), true, 8, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+
+			params := app.GovKeeper.GetDepositParams(ctx)
+			params.BurnRatio = tc.burnRatio
+			params.BurnToCommunityPool = tc.burnToCommunityPool
+			app.GovKeeper.SetDepositParams(ctx, params)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
+			require.NoError(t, err)
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+			require.NoError(t, err)
+
+			balance := app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom)
+			supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+			communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom)
+
+			app.GovKeeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
+			require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+			require.Equal(t, balance.AddAmount(sdk.NewInt(tc.expRefund)), app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+
+			if tc.burnToCommunityPool {
+				require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+				require.Equal(t, communityPool.Add(sdk.NewDec(tc.expBurn)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom))
+			} else {
+				require.Equal(t, supply.SubAmount(sdk.NewInt(tc.expBurn)), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+				require.Equal(t, communityPool, app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
@@ -50,7 +50,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 			func() {
 				req = &types.QueryProposalRequest{ProposalId: 1}
 				testProposal := types.NewTextProposal("Proposal", "testing proposal")
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -106,7 +106,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 				for i := 0; i < 5; i++ {
 					num := strconv.Itoa(i + 1)
 					testProposal := types.NewTextProposal("Proposal"+num, "testing proposal "+num)
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, proposal)
@@ -274,7 +274,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVoteRequest{
@@ -379,7 +379,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVotesRequest{
@@ -460,7 +460,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
 				expRes = &types.QueryParamsResponse{
 					DepositParams: types.DefaultDepositParams(),
-					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
 			true,
@@ -470,8 +470,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					VotingParams:  types.DefaultVotingParams(),
+					DepositParams: types.NewDepositParams(nil, 0, nil, false, false, sdk.NewDec(0), false),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
 			true,
@@ -481,7 +482,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
 				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+					DepositParams: types.NewDepositParams(nil, 0, nil, false, false, sdk.NewDec(0), false),
+					TallyParams:   types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -570,7 +572,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -659,7 +661,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryDepositsRequest{
@@ -751,7 +753,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -4,20 +4,28 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "running-tally", RunningTallyInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the governance module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleAccountInvariant(keeper, bk)(ctx)
+		res, stop := ModuleAccountInvariant(keeper, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return RunningTallyInvariant(keeper)(ctx)
 	}
 }
 
@@ -41,3 +49,61 @@ func ModuleAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// RunningTallyInvariant checks that the running tally of every proposal in
+// voting period matches a full recount of its votes
+func RunningTallyInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, proposalID := range keeper.votingProposalIDs(ctx) {
+			expected := make(map[string]types.TallyShares)
+			keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+				voter, err := sdk.AccAddressFromBech32(vote.Voter)
+				if err != nil {
+					panic(err)
+				}
+
+				keeper.sk.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+					valAddr := delegation.GetValidatorAddr().String()
+					shares, ok := expected[valAddr]
+					if !ok {
+						shares = types.NewEmptyTallyShares()
+					}
+					expected[valAddr] = shares.Add(delegation.GetShares(), vote.Options)
+					return false
+				})
+				return false
+			})
+
+			keeper.IterateTallyShares(ctx, proposalID, func(valAddr sdk.ValAddress, shares types.TallyShares) bool {
+				if expectedShares, ok := expected[valAddr.String()]; !ok || !shares.Equal(expectedShares) {
+					broken = true
+					msg += fmt.Sprintf("\tproposal %d validator %s running tally:\n%s\n\trecount:\n%s\n",
+						proposalID, valAddr, shares, expectedShares)
+				}
+				delete(expected, valAddr.String())
+				return false
+			})
+
+			valAddrs := make([]string, 0, len(expected))
+			for valAddr := range expected {
+				valAddrs = append(valAddrs, valAddr)
+			}
+			sort.Strings(valAddrs)
+
+			for _, valAddr := range valAddrs {
+				if !expected[valAddr].IsZero() {
+					broken = true
+					msg += fmt.Sprintf("\tproposal %d validator %s missing from running tally, recount:\n%s\n",
+						proposalID, valAddr, expected[valAddr])
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "running tally", msg), broken
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -24,6 +25,9 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The reference to the community pool to send the burned deposits to
+	distrKeeper types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
@@ -35,6 +39,12 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// msgRouter is used to execute the messages of passed messages proposals
+	msgRouter *middleware.MsgServiceRouter
+
+	// tallyHandlers are the TallyHandlers set by proposal type
+	tallyHandlers map[string]types.TallyHandler
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -46,7 +56,8 @@ type Keeper struct {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	distrKeeper types.DistributionKeeper, rtr types.Router, msgRouter *middleware.MsgServiceRouter,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -60,13 +71,17 @@ func NewKeeper(
 	rtr.Seal()
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     rtr,
+		storeKey:    key,
+		paramSpace:  paramSpace,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		sk:          sk,
+		distrKeeper: distrKeeper,
+		cdc:         cdc,
+		router:      rtr,
+		msgRouter:   msgRouter,
+
+		tallyHandlers: make(map[string]types.TallyHandler),
 	}
 }
 
@@ -81,6 +96,22 @@ func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	return keeper
 }
 
+// SetTallyHandler sets the TallyHandler tallying the proposals of the given
+// type in place of the default StakeTallyHandler.
+func (keeper *Keeper) SetTallyHandler(proposalType string, handler types.TallyHandler) *Keeper {
+	if !types.IsValidProposalType(proposalType) {
+		panic(fmt.Sprintf("unknown proposal type %s", proposalType))
+	}
+
+	if _, ok := keeper.tallyHandlers[proposalType]; ok {
+		panic(fmt.Sprintf("cannot set the tally handler of %s proposals twice", proposalType))
+	}
+
+	keeper.tallyHandlers[proposalType] = handler
+
+	return keeper
+}
+
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v043"
+	v046 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,23 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates x/gov params from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v046.MigrateParams(ctx, m.keeper.paramSpace)
+}
+
+// Migrate3to4 migrates x/gov from version 3 to 4 by building the running tally
+// of the proposals in voting period.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	for _, proposalID := range m.keeper.votingProposalIDs(ctx) {
+		m.keeper.ResetRunningTally(ctx, proposalID)
+	}
+
+	return nil
+}
+
+// Migrate4to5 migrates x/gov params from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v046.MigrateDepositBurnParams(ctx, m.keeper.paramSpace)
+}
@@ -27,7 +27,7 @@ var _ types.MsgServer = msgServer{}
 
 func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	proposal, err := k.Keeper.SubmitProposal(ctx, msg.GetContent())
+	proposal, err := k.Keeper.SubmitProposal(ctx, msg.GetContent(), msg.Expedited)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,11 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetParams returns all of the current governance params from the global param store
+func (keeper Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx), keeper.GetDepositParams(ctx))
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
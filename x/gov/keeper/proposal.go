@@ -9,19 +9,33 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content. An expedited proposal
+// has its own minimum deposit, voting period and threshold.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, expedited bool) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
-	// Execute the proposal content in a new context branch (with branched store)
-	// to validate the actual parameter changes before the proposal proceeds
-	// through the governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	if msgsProposal, ok := content.(*types.MessagesProposal); ok {
+		// The messages are only executed once the proposal passes, so only
+		// check that they can be routed and are authorized by the gov account.
+		msgs, err := msgsProposal.GetMsgs()
+		if err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
+
+		if err := keeper.assertProposalMsgs(msgs); err != nil {
+			return types.Proposal{}, err
+		}
+	} else {
+		// Execute the proposal content in a new context branch (with branched store)
+		// to validate the actual parameter changes before the proposal proceeds
+		// through the governance process. State is not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -32,7 +46,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod), expedited)
 	if err != nil {
 		return types.Proposal{}, err
 	}
@@ -54,6 +68,59 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// ExecuteProposal executes the content of a passed proposal. The messages of a
+// MessagesProposal are executed in order through the MsgServiceRouter, with
+// the gov module account as signer, while any other content is executed by the
+// handler of its route. The caller must discard the state changes made on ctx
+// when an error is returned so that the messages are executed atomically.
+func (keeper Keeper) ExecuteProposal(ctx sdk.Context, content types.Content) error {
+	msgsProposal, ok := content.(*types.MessagesProposal)
+	if !ok {
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		return handler(ctx, content)
+	}
+
+	msgs, err := msgsProposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	// the message services may have changed since the proposal was submitted
+	if err := keeper.assertProposalMsgs(msgs); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := keeper.msgRouter.Handler(msg)
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
+
+// assertProposalMsgs returns an error if one of msgs cannot be routed or has
+// another signer than the gov module account.
+func (keeper Keeper) assertProposalMsgs(msgs []sdk.Msg) error {
+	govAcct := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAcct) {
+			return sdkerrors.Wrapf(types.ErrInvalidSigner, "message %d", i)
+		}
+
+		if keeper.msgRouter.Handler(msg) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
@@ -194,6 +261,9 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
 	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = keeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	}
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
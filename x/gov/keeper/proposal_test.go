@@ -4,15 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.ProposalId
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -24,7 +28,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, false)
 	suite.Require().NoError(err)
 
 	suite.Require().True(proposal.VotingStartTime.Equal(time.Time{}))
@@ -48,7 +52,22 @@ type invalidProposalRoute struct{ types.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
 
+func newMessagesProposal(t *testing.T, signers ...sdk.AccAddress) types.Content {
+	msgs := make([]sdk.Msg, len(signers))
+	for i, signer := range signers {
+		msgs[i] = banktypes.NewMsgSend(signer, signer, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1)))
+	}
+
+	content, err := types.NewMessagesProposal("title", "description", msgs)
+	require.NoError(t, err)
+
+	return content
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addr := sdk.AccAddress("foo_________________")
+
 	testCases := []struct {
 		content     types.Content
 		expectedErr error
@@ -61,10 +80,13 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 		{&types.TextProposal{Title: "title", Description: strings.Repeat("1234567890", 1000)}, nil},
 		// error only when invalid route
 		{&invalidProposalRoute{}, types.ErrNoProposalHandlerExists},
+		// messages must be signed by the gov account only
+		{newMessagesProposal(suite.T(), govAcct, govAcct), nil},
+		{newMessagesProposal(suite.T(), govAcct, addr), types.ErrInvalidSigner},
 	}
 
 	for i, tc := range testCases {
-		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content)
+		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -77,7 +99,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now(), false)
 			suite.Require().NoError(err)
 
 			p.Status = s
@@ -160,7 +160,7 @@ func TestQueries(t *testing.T) {
 	depositParams, _, _ := getQueriedParams(t, ctx, legacyQuerierCdc, querier)
 
 	// TestAddrs[0] proposes (and deposits) proposals #1 and #2
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	deposit1 := types.NewDeposit(proposal1.ProposalId, TestAddrs[0], oneCoins)
 	depositer1, err := sdk.AccAddressFromBech32(deposit1.Depositor)
@@ -170,7 +170,7 @@ func TestQueries(t *testing.T) {
 
 	proposal1.TotalDeposit = proposal1.TotalDeposit.Add(deposit1.Amount...)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	deposit2 := types.NewDeposit(proposal2.ProposalId, TestAddrs[0], consCoins)
 	depositer2, err := sdk.AccAddressFromBech32(deposit2.Depositor)
@@ -181,7 +181,7 @@ func TestQueries(t *testing.T) {
 	proposal2.TotalDeposit = proposal2.TotalDeposit.Add(deposit2.Amount...)
 
 	// TestAddrs[1] proposes (and deposits) on proposal #3
-	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	deposit3 := types.NewDeposit(proposal3.ProposalId, TestAddrs[1], oneCoins)
 	depositer3, err := sdk.AccAddressFromBech32(deposit3.Depositor)
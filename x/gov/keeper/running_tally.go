@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The running tally of a proposal in voting period holds, for each validator,
+// the delegation shares of the voters delegating to it, split by vote option.
+// It is updated when a vote is cast and, through the staking hooks, when the
+// delegations of a voter change, so that the tally at the end of the voting
+// period only has to go through the bonded validators.
+//
+// The shares of each delegation counted in the running tally are stored as
+// well, so that they can be removed when the vote or the delegation changes.
+
+// GetTallyShares returns the running tally of the voters of a proposal
+// delegating to a validator.
+func (keeper Keeper) GetTallyShares(ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress) types.TallyShares {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ValidatorTallySharesKey(proposalID, valAddr))
+	if bz == nil {
+		return types.NewEmptyTallyShares()
+	}
+
+	var shares types.TallyShares
+	keeper.cdc.MustUnmarshal(bz, &shares)
+	return shares
+}
+
+// setTallyShares sets the running tally of the voters of a proposal
+// delegating to a validator, deleting it when it is empty.
+func (keeper Keeper) setTallyShares(ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress, shares types.TallyShares) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.ValidatorTallySharesKey(proposalID, valAddr)
+	if shares.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, keeper.cdc.MustMarshal(&shares))
+}
+
+// IterateTallyShares iterates over the running tally of a proposal and
+// performs a callback function for each validator
+func (keeper Keeper) IterateTallyShares(ctx sdk.Context, proposalID uint64, cb func(valAddr sdk.ValAddress, shares types.TallyShares) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TallySharesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, valAddr := types.SplitValidatorTallySharesKey(iterator.Key())
+
+		var shares types.TallyShares
+		keeper.cdc.MustUnmarshal(iterator.Value(), &shares)
+
+		if cb(valAddr, shares) {
+			break
+		}
+	}
+}
+
+// addVoteToRunningTally adds the delegation shares of the voter to the
+// running tally of the proposal voted on.
+func (keeper Keeper) addVoteToRunningTally(ctx sdk.Context, vote types.Vote) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		panic(err)
+	}
+
+	keeper.sk.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		keeper.updateRunningTally(ctx, vote, delegation.GetValidatorAddr(), delegation.GetShares())
+		return false
+	})
+}
+
+// removeVoteFromRunningTally removes the delegation shares of the voter from
+// the running tally of the proposal voted on.
+func (keeper Keeper) removeVoteFromRunningTally(ctx sdk.Context, vote types.Vote) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		panic(err)
+	}
+
+	var valAddrs []sdk.ValAddress
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoterSharesKey(vote.ProposalId, voter))
+	for ; iterator.Valid(); iterator.Next() {
+		_, _, valAddr := types.SplitVoterDelegationSharesKey(iterator.Key())
+		valAddrs = append(valAddrs, valAddr)
+	}
+	iterator.Close()
+
+	for _, valAddr := range valAddrs {
+		keeper.updateRunningTally(ctx, vote, valAddr, sdk.ZeroDec())
+	}
+}
+
+// updateRunningTally replaces the shares of the delegation of a voter to
+// valAddr counted in the running tally by shares.
+func (keeper Keeper) updateRunningTally(ctx sdk.Context, vote types.Vote, valAddr sdk.ValAddress, shares sdk.Dec) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.VoterDelegationSharesKey(vote.ProposalId, voter, valAddr)
+	tallyShares := keeper.GetTallyShares(ctx, vote.ProposalId, valAddr)
+
+	if bz := store.Get(key); bz != nil {
+		var counted sdk.DecProto
+		keeper.cdc.MustUnmarshal(bz, &counted)
+		tallyShares = tallyShares.Sub(counted.Dec, vote.Options)
+	}
+
+	if shares.IsPositive() {
+		tallyShares = tallyShares.Add(shares, vote.Options)
+		store.Set(key, keeper.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+	} else {
+		store.Delete(key)
+	}
+
+	keeper.setTallyShares(ctx, vote.ProposalId, valAddr, tallyShares)
+}
+
+// updateVotersRunningTally replaces the shares of the delegation of delAddr
+// to valAddr counted in the running tally of every proposal in voting period
+// delAddr voted on.
+func (keeper Keeper) updateVotersRunningTally(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	for _, proposalID := range keeper.votingProposalIDs(ctx) {
+		if vote, found := keeper.GetVote(ctx, proposalID, delAddr); found {
+			keeper.updateRunningTally(ctx, vote, valAddr, shares)
+		}
+	}
+}
+
+// votingProposalIDs returns the IDs of the proposals in the active proposal
+// queue, whatever their voting end time.
+func (keeper Keeper) votingProposalIDs(ctx sdk.Context) (proposalIDs []uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ActiveProposalQueuePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, types.GetProposalIDFromBytes(iterator.Value()))
+	}
+
+	return proposalIDs
+}
+
+// ResetRunningTally rebuilds the running tally of a proposal from its votes.
+func (keeper Keeper) ResetRunningTally(ctx sdk.Context, proposalID uint64) {
+	keeper.deleteRunningTally(ctx, proposalID)
+
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		keeper.addVoteToRunningTally(ctx, vote)
+		return false
+	})
+}
+
+// deleteRunningTally deletes the running tally of a proposal.
+func (keeper Keeper) deleteRunningTally(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, prefix := range [][]byte{
+		types.TallySharesKey(proposalID),
+		append(types.VoterSharesKeyPrefix, types.GetProposalIDBytes(proposalID)...),
+	} {
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// runningTally tallies a proposal from its running tally, with the same rules
+// as the StakeTallyHandler.
+func (keeper Keeper) runningTally(ctx sdk.Context, proposal types.Proposal, params types.Params) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+	results := types.NewEmptyTallyResultsMap()
+	totalVotingPower := sdk.ZeroDec()
+
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valAddr := validator.GetOperator()
+		tokens, delegatorShares := validator.GetBondedTokens(), validator.GetDelegatorShares()
+		votingPower := func(shares sdk.Dec) sdk.Dec {
+			// shares * bonded / total shares
+			return shares.MulInt(tokens).Quo(delegatorShares)
+		}
+
+		shares := keeper.GetTallyShares(ctx, proposal.ProposalId, valAddr)
+		results[types.OptionYes] = results[types.OptionYes].Add(votingPower(shares.Yes))
+		results[types.OptionAbstain] = results[types.OptionAbstain].Add(votingPower(shares.Abstain))
+		results[types.OptionNo] = results[types.OptionNo].Add(votingPower(shares.No))
+		results[types.OptionNoWithVeto] = results[types.OptionNoWithVeto].Add(votingPower(shares.NoWithVeto))
+		totalVotingPower = totalVotingPower.Add(votingPower(shares.Total))
+
+		// the validator votes with the shares of the delegators who did not vote
+		if vote, found := keeper.GetVote(ctx, proposal.ProposalId, sdk.AccAddress(valAddr)); found {
+			validatorPower := votingPower(delegatorShares.Sub(shares.Total))
+			for _, option := range vote.Options {
+				results[option.Option] = results[option.Option].Add(validatorPower.Mul(option.Weight))
+			}
+			totalVotingPower = totalVotingPower.Add(validatorPower)
+		}
+
+		return false
+	})
+
+	tallyResults = types.NewTallyResultFromMap(results)
+
+	// If there is no staked coins, the proposal fails
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return false, false, tallyResults
+	}
+
+	turnout := totalVotingPower.Quo(totalBonded.ToDec())
+	passes, burnDeposits = types.TallyOutcome(params, turnout, totalVotingPower, results)
+	return passes, burnDeposits, tallyResults
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// requireRunningTally checks that the tally from the running tally matches a
+// full recount of the votes, and that the running tally invariant holds.
+func requireRunningTally(t *testing.T, ctx sdk.Context, app *simapp.SimApp, proposalID uint64) {
+	t.Helper()
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	expPasses, expBurnDeposits, expTallyResults := keeper.NewStakeTallyHandler(app.StakingKeeper).Tally(
+		ctx, proposal, app.GovKeeper.GetVotes(ctx, proposalID), app.GovKeeper.GetParams(ctx),
+	)
+	require.Equal(t, expPasses, passes)
+	require.Equal(t, expBurnDeposits, burnDeposits)
+	require.Equal(t, expTallyResults, tallyResults)
+
+	msg, broken := keeper.RunningTallyInvariant(app.GovKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestRunningTally(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+	app.StakingKeeper.SetHooks(app.GovKeeper.StakingHooks())
+
+	delegate := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, power int64) {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		_, err := app.StakingKeeper.Delegate(ctx, delAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, power), stakingtypes.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+	delegate(addrs[3], valAddrs[0], 4)
+	delegate(addrs[3], valAddrs[1], 3)
+	delegate(addrs[4], valAddrs[2], 5)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposalID := proposal.ProposalId
+	requireRunningTally(t, ctx, app, proposalID)
+
+	weighted := types.WeightedVoteOptions{
+		types.WeightedVoteOption{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], weighted))
+	requireRunningTally(t, ctx, app, proposalID)
+
+	// vote change
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
+	requireRunningTally(t, ctx, app, proposalID)
+
+	// delegation to a new and to an existing validator
+	delegate(addrs[4], valAddrs[0], 2)
+	delegate(addrs[3], valAddrs[0], 1)
+	requireRunningTally(t, ctx, app, proposalID)
+
+	// redelegation
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrs[3], valAddrs[0], valAddrs[1], app.StakingKeeper.TokensFromConsensusPower(ctx, 2).ToDec())
+	require.NoError(t, err)
+	requireRunningTally(t, ctx, app, proposalID)
+
+	// partial and full unbonding
+	_, err = app.StakingKeeper.Undelegate(ctx, addrs[4], valAddrs[2], app.StakingKeeper.TokensFromConsensusPower(ctx, 2).ToDec())
+	require.NoError(t, err)
+	requireRunningTally(t, ctx, app, proposalID)
+	_, err = app.StakingKeeper.Undelegate(ctx, addrs[4], valAddrs[2], app.StakingKeeper.TokensFromConsensusPower(ctx, 3).ToDec())
+	require.NoError(t, err)
+	requireRunningTally(t, ctx, app, proposalID)
+	require.True(t, app.GovKeeper.GetTallyShares(ctx, proposalID, valAddrs[2]).IsZero())
+
+	// a validator voting after its delegators
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionAbstain)))
+	requireRunningTally(t, ctx, app, proposalID)
+
+	// the running tally is rebuilt from the votes
+	var before []types.TallyShares
+	app.GovKeeper.IterateTallyShares(ctx, proposalID, func(_ sdk.ValAddress, shares types.TallyShares) bool {
+		before = append(before, shares)
+		return false
+	})
+	require.NotEmpty(t, before)
+	app.GovKeeper.ResetRunningTally(ctx, proposalID)
+	var after []types.TallyShares
+	app.GovKeeper.IterateTallyShares(ctx, proposalID, func(_ sdk.ValAddress, shares types.TallyShares) bool {
+		after = append(after, shares)
+		return false
+	})
+	require.Equal(t, before, after)
+
+	// the running tally is deleted with the votes
+	app.GovKeeper.DeleteVotes(ctx, proposalID)
+	app.GovKeeper.IterateTallyShares(ctx, proposalID, func(_ sdk.ValAddress, _ types.TallyShares) bool {
+		t.Fatal("running tally not deleted")
+		return true
+	})
+}
+
+func BenchmarkTally1000Voters(b *testing.B) {
+	benchmarkTally(b, 1000, false)
+}
+
+func BenchmarkTally10000Voters(b *testing.B) {
+	benchmarkTally(b, 10000, false)
+}
+
+func BenchmarkRecount1000Voters(b *testing.B) {
+	benchmarkTally(b, 1000, true)
+}
+
+func BenchmarkRecount10000Voters(b *testing.B) {
+	benchmarkTally(b, 10000, true)
+}
+
+// benchmarkTally tallies a proposal with n voters from the running tally, or
+// with a full recount of the votes as done before the running tally.
+func benchmarkTally(b *testing.B, n int, recount bool) {
+	b.ReportAllocs()
+
+	t := &testing.T{}
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	_, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+	validators := make([]stakingtypes.Validator, len(valAddrs[:3]))
+	for i, valAddr := range valAddrs[:3] {
+		validators[i], _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	}
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
+	if err != nil {
+		b.Fatal(err)
+	}
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	options := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
+	voters := simapp.AddTestAddrs(app, ctx, n, sdk.NewInt(1000))
+	for i, voter := range voters {
+		if _, err := app.StakingKeeper.Delegate(ctx, voter, sdk.NewInt(1000), stakingtypes.Unbonded, validators[i%len(validators)], true); err != nil {
+			b.Fatal(err)
+		}
+		if err := app.GovKeeper.AddVote(ctx, proposal.ProposalId, voter, types.NewNonSplitVoteOption(options[i%len(options)])); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	proposal, _ = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	handler := keeper.NewStakeTallyHandler(app.StakingKeeper)
+	params := app.GovKeeper.GetParams(ctx)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if recount {
+			handler.Tally(ctx, proposal, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), params)
+		} else {
+			app.GovKeeper.Tally(ctx, proposal)
+		}
+	}
+}
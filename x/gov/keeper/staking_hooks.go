@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks wrapper struct updating the running tally of the proposals in
+// voting period when the delegations of their voters change
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the governance keeper
+func (keeper Keeper) StakingHooks() StakingHooks { return StakingHooks{keeper} }
+
+// AfterDelegationModified updates the shares of the delegation in the running
+// tallies its delegator voted on
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return nil
+	}
+
+	h.k.updateVotersRunningTally(ctx, delAddr, valAddr, delegation.GetShares())
+	return nil
+}
+
+// BeforeDelegationRemoved removes the shares of the delegation from the
+// running tallies its delegator voted on
+func (h StakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.updateVotersRunningTally(ctx, delAddr, valAddr, sdk.ZeroDec())
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
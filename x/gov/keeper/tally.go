@@ -6,34 +6,59 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
-
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// Tally tallies the votes of a proposal with the TallyHandler of its type. An
+// expedited proposal must reach the ExpeditedThreshold to pass. The votes are
+// left in the store, see DeleteVotes.
+//
+// The proposals of the types without a TallyHandler are tallied from their
+// running tally rather than by going through every vote, with the same rules
+// as the StakeTallyHandler.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
-	results := make(map[types.VoteOption]sdk.Dec)
-	results[types.OptionYes] = sdk.ZeroDec()
-	results[types.OptionAbstain] = sdk.ZeroDec()
-	results[types.OptionNo] = sdk.ZeroDec()
-	results[types.OptionNoWithVeto] = sdk.ZeroDec()
+	params := keeper.GetParams(ctx)
+	if proposal.Expedited {
+		params.TallyParams.Threshold = params.TallyParams.ExpeditedThreshold
+	}
 
-	totalVotingPower := sdk.ZeroDec()
-	currValidators := make(map[string]types.ValidatorGovInfo)
+	handler, ok := keeper.tallyHandlers[proposal.ProposalType()]
+	if !ok {
+		return keeper.runningTally(ctx, proposal, params)
+	}
 
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			types.WeightedVoteOptions{},
-		)
+	votes := keeper.GetVotes(ctx, proposal.ProposalId)
+	return handler.Tally(ctx, proposal, votes, params)
+}
 
-		return false
-	})
+// TallyHandler returns the TallyHandler set for the given proposal type, or
+// the stake-weighted StakeTallyHandler if none is set.
+func (keeper Keeper) TallyHandler(proposalType string) types.TallyHandler {
+	if handler, ok := keeper.tallyHandlers[proposalType]; ok {
+		return handler
+	}
+
+	return NewStakeTallyHandler(keeper.sk)
+}
+
+// StakeTallyHandler is the default TallyHandler: votes are weighted by the
+// bonded stake of the voters, and the delegators who did not vote inherit the
+// vote of their validators.
+type StakeTallyHandler struct {
+	sk types.StakingKeeper
+}
+
+var _ types.TallyHandler = StakeTallyHandler{}
 
-	keeper.IterateVotes(ctx, proposal.ProposalId, func(vote types.Vote) bool {
+// NewStakeTallyHandler returns a new StakeTallyHandler.
+func NewStakeTallyHandler(sk types.StakingKeeper) StakeTallyHandler {
+	return StakeTallyHandler{sk: sk}
+}
+
+// Tally implements the TallyHandler interface.
+func (h StakeTallyHandler) Tally(ctx sdk.Context, _ types.Proposal, votes types.Votes, params types.Params) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+	results := types.NewEmptyTallyResultsMap()
+	totalVotingPower := sdk.ZeroDec()
+	currValidators := bondedValidators(ctx, h.sk)
+
+	for _, vote := range votes {
 		// if validator, just record it in the map
 		voter, err := sdk.AccAddressFromBech32(vote.Voter)
 
@@ -48,7 +73,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
-		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		h.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
 			if val, ok := currValidators[valAddrStr]; ok {
@@ -69,10 +94,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 
 			return false
 		})
-
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
-		return false
-	})
+	}
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
@@ -90,36 +112,122 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
 	tallyResults = types.NewTallyResultFromMap(results)
 
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+	totalBonded := h.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
 		return false, false, tallyResults
 	}
 
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
+	turnout := totalVotingPower.Quo(totalBonded.ToDec())
+	passes, burnDeposits = types.TallyOutcome(params, turnout, totalVotingPower, results)
+	return passes, burnDeposits, tallyResults
+}
+
+// VoterTallyHandler is a TallyHandler in which every voter votes with a power
+// derived from its own bonded stake only: delegators do not inherit the vote
+// of their validators, and validators only vote with their self-delegation.
+// The quorum is still computed from the bonded stake of the voters.
+type VoterTallyHandler struct {
+	sk types.StakingKeeper
+
+	// power returns the voting power of a voter with the given bonded stake.
+	power func(stake sdk.Dec) sdk.Dec
+}
+
+var _ types.TallyHandler = VoterTallyHandler{}
+
+// NewQuadraticTallyHandler returns a VoterTallyHandler implementing quadratic
+// voting: the voting power of a voter is the square root of its bonded stake.
+func NewQuadraticTallyHandler(sk types.StakingKeeper) VoterTallyHandler {
+	return VoterTallyHandler{
+		sk: sk,
+		power: func(stake sdk.Dec) sdk.Dec {
+			power, err := stake.ApproxSqrt()
+			if err != nil {
+				panic(err)
+			}
+
+			return power
+		},
 	}
+}
 
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
+// NewOneAddressOneVoteTallyHandler returns a VoterTallyHandler giving one vote
+// to each voter with a bonded stake, whatever its amount.
+func NewOneAddressOneVoteTallyHandler(sk types.StakingKeeper) VoterTallyHandler {
+	return VoterTallyHandler{
+		sk: sk,
+		power: func(sdk.Dec) sdk.Dec {
+			return sdk.OneDec()
+		},
 	}
+}
 
-	// If more than 1/3 of voters veto, proposal fails
-	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
-		return false, true, tallyResults
+// Tally implements the TallyHandler interface.
+func (h VoterTallyHandler) Tally(ctx sdk.Context, _ types.Proposal, votes types.Votes, params types.Params) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+	results := types.NewEmptyTallyResultsMap()
+	totalVotingPower := sdk.ZeroDec()
+	totalStake := sdk.ZeroDec()
+	currValidators := bondedValidators(ctx, h.sk)
+
+	for _, vote := range votes {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		stake := sdk.ZeroDec()
+		h.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			if val, ok := currValidators[delegation.GetValidatorAddr().String()]; ok {
+				// delegation shares * bonded / total shares
+				stake = stake.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+
+			return false
+		})
+
+		if !stake.IsPositive() {
+			continue
+		}
+
+		votingPower := h.power(stake)
+		for _, option := range vote.Options {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+		totalStake = totalStake.Add(stake)
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, false, tallyResults
+	tallyResults = types.NewTallyResultFromMap(results)
+
+	// If there is no staked coins, the proposal fails
+	totalBonded := h.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return false, false, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	turnout := totalStake.Quo(totalBonded.ToDec())
+	passes, burnDeposits = types.TallyOutcome(params, turnout, totalVotingPower, results)
+	return passes, burnDeposits, tallyResults
+}
+
+// bondedValidators returns the bonded validators by operator address.
+func bondedValidators(ctx sdk.Context, sk types.StakingKeeper) map[string]types.ValidatorGovInfo {
+	validators := make(map[string]types.ValidatorGovInfo)
+	sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		validators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			types.WeightedVoteOptions{},
+		)
+
+		return false
+	})
+
+	return validators
 }
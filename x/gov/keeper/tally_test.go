@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -20,7 +21,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	createValidators(t, ctx, app, []int64{5, 5, 5})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -44,7 +45,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -67,7 +68,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
 	tp := TestProposal
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -93,7 +94,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -117,7 +118,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -142,7 +143,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -168,7 +169,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -194,7 +195,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -221,7 +222,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	valAccAddr1, valAccAddr2 := valAccAddrs[0], valAccAddrs[1]
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -255,7 +256,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -291,7 +292,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -330,7 +331,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -372,7 +373,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -415,7 +416,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	app.StakingKeeper.Jail(ctx, sdk.ConsAddress(consAddr.Bytes()))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -448,7 +449,7 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	require.NoError(t, err)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = types.StatusVotingPeriod
@@ -473,3 +474,107 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyHandlers(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
+	require.NoError(t, err)
+
+	// addrs[3] has no bonded stake, so only its stake-weighted vote counts
+	yes, no := types.NewNonSplitVoteOption(types.OptionYes), types.NewNonSplitVoteOption(types.OptionNo)
+	votes := types.Votes{
+		types.NewVote(proposal.ProposalId, addrs[0], yes),
+		types.NewVote(proposal.ProposalId, addrs[1], yes),
+		types.NewVote(proposal.ProposalId, addrs[2], yes),
+		types.NewVote(proposal.ProposalId, addrs[3], no),
+		types.NewVote(proposal.ProposalId, addrs[4], no),
+	}
+	params := app.GovKeeper.GetParams(ctx)
+
+	testCases := []struct {
+		name      string
+		handler   types.TallyHandler
+		expPasses bool
+		expYes    sdk.Int
+		expNo     sdk.Int
+	}{
+		{
+			"stake-weighted",
+			keeper.NewStakeTallyHandler(app.StakingKeeper),
+			false,
+			app.StakingKeeper.TokensFromConsensusPower(ctx, 18),
+			app.StakingKeeper.TokensFromConsensusPower(ctx, 30),
+		},
+		{
+			// sqrt(5e6) + sqrt(6e6) + sqrt(7e6) > sqrt(30e6)
+			"quadratic",
+			keeper.NewQuadraticTallyHandler(app.StakingKeeper),
+			true,
+			sdk.NewInt(7331),
+			sdk.NewInt(5477),
+		},
+		{
+			"one address one vote",
+			keeper.NewOneAddressOneVoteTallyHandler(app.StakingKeeper),
+			true,
+			sdk.NewInt(3),
+			sdk.NewInt(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			passes, burnDeposits, tallyResults := tc.handler.Tally(ctx, proposal, votes, params)
+			require.Equal(t, tc.expPasses, passes)
+			require.False(t, burnDeposits)
+			require.Equal(t, tc.expYes, tallyResults.Yes)
+			require.Equal(t, tc.expNo, tallyResults.No)
+		})
+	}
+}
+
+func TestSetTallyHandler(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	// the validator with the most stake votes no, but is outnumbered
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	require.IsType(t, keeper.StakeTallyHandler{}, app.GovKeeper.TallyHandler(types.ProposalTypeText))
+	handler := keeper.NewOneAddressOneVoteTallyHandler(app.StakingKeeper)
+	app.GovKeeper.SetTallyHandler(types.ProposalTypeText, handler)
+	require.IsType(t, handler, app.GovKeeper.TallyHandler(types.ProposalTypeText))
+	require.IsType(t, keeper.StakeTallyHandler{}, app.GovKeeper.TallyHandler(types.ProposalTypeMessages))
+
+	require.Panics(t, func() { app.GovKeeper.SetTallyHandler(types.ProposalTypeText, handler) })
+	require.Panics(t, func() { app.GovKeeper.SetTallyHandler("Unknown", handler) })
+
+	passes, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.Equal(t, types.NewTallyResult(sdk.NewInt(2), sdk.ZeroInt(), sdk.NewInt(1), sdk.ZeroInt()), tallyResults)
+
+	// the votes are left in the store by the tally
+	require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 3)
+}
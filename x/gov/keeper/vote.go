@@ -24,8 +24,13 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		}
 	}
 
+	if oldVote, found := keeper.GetVote(ctx, proposalID, voterAddr); found {
+		keeper.removeVoteFromRunningTally(ctx, oldVote)
+	}
+
 	vote := types.NewVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
+	keeper.addVoteToRunningTally(ctx, vote)
 
 	// called after a vote on a proposal is cast
 	keeper.AfterProposalVote(ctx, proposalID, voterAddr)
@@ -125,6 +130,21 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// DeleteVotes deletes all the votes of a given proposalID, and its running
+// tally, from the store
+func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.deleteRunningTally(ctx, proposalID)
+
+	for _, vote := range keeper.GetVotes(ctx, proposalID) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.deleteVote(ctx, proposalID, voter)
+	}
+}
+
 // deleteVote deletes a vote from a given proposalID and voter from the store
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
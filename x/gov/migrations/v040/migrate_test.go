@@ -96,6 +96,11 @@ func TestMigrate(t *testing.T) {
 	// - ParameterChangeProposal has correct JSON.
 	expected := `{
 	"deposit_params": {
+		"burn_ratio": "0",
+		"burn_to_community_pool": false,
+		"burn_vote_quorum": false,
+		"burn_vote_veto": false,
+		"expedited_min_deposit": [],
 		"max_deposit_period": "0s",
 		"min_deposit": []
 	},
@@ -108,6 +113,7 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_text"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -135,6 +141,7 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_community"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -155,6 +162,7 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_cancel_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -182,6 +190,7 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_software_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -209,6 +218,7 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_param_change"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -225,12 +235,14 @@ func TestMigrate(t *testing.T) {
 	],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"expedited_threshold": "0",
 		"quorum": "0",
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
 	"votes": [],
 	"voting_params": {
+		"expedited_voting_period": "0s",
 		"voting_period": "0s"
 	}
 }`
@@ -49,6 +49,11 @@ func TestMigrateJSON(t *testing.T) {
 	// - Votes are all ADR-037 weighted votes with weight 1.
 	expected := `{
 	"deposit_params": {
+		"burn_ratio": "0",
+		"burn_to_community_pool": false,
+		"burn_vote_quorum": false,
+		"burn_vote_veto": false,
+		"expedited_min_deposit": [],
 		"max_deposit_period": "0s",
 		"min_deposit": []
 	},
@@ -56,6 +61,7 @@ func TestMigrateJSON(t *testing.T) {
 	"proposals": [],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"expedited_threshold": "0",
 		"quorum": "0",
 		"threshold": "0",
 		"veto_threshold": "0"
@@ -118,6 +124,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": "0s",
 		"voting_period": "0s"
 	}
 }`
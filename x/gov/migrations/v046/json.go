@@ -0,0 +1,26 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MigrateJSON accepts exported v0.43 x/gov genesis state and migrates it to
+// v0.46 x/gov genesis state. The migration includes:
+//
+// - Set the expedited params, as in MigrateParams.
+// - Set the deposit burn params, as in MigrateDepositBurnParams.
+func MigrateJSON(oldState *types.GenesisState) *types.GenesisState {
+	depositParams, votingParams, tallyParams := oldState.DepositParams, oldState.VotingParams, oldState.TallyParams
+	migrateExpeditedParams(&depositParams, &votingParams, &tallyParams)
+	migrateDepositBurnParams(&depositParams)
+
+	return &types.GenesisState{
+		StartingProposalId: oldState.StartingProposalId,
+		Deposits:           oldState.Deposits,
+		Votes:              oldState.Votes,
+		Proposals:          oldState.Proposals,
+		DepositParams:      depositParams,
+		VotingParams:       votingParams,
+		TallyParams:        tallyParams,
+	}
+}
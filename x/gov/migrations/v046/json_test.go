@@ -0,0 +1,39 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	voter := sdk.AccAddress("voter_______________")
+	oldState := &types.GenesisState{
+		StartingProposalId: 2,
+		Votes:              types.Votes{types.NewVote(1, voter, types.NewNonSplitVoteOption(types.OptionYes))},
+		DepositParams:      types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: time.Hour},
+		VotingParams:       types.VotingParams{VotingPeriod: time.Hour},
+		TallyParams: types.TallyParams{
+			Quorum:        types.DefaultQuorum,
+			Threshold:     types.DefaultThreshold,
+			VetoThreshold: types.DefaultVetoThreshold,
+		},
+	}
+
+	migrated := v046.MigrateJSON(oldState)
+	require.NoError(t, types.ValidateGenesis(migrated))
+
+	require.Equal(t, oldState.StartingProposalId, migrated.StartingProposalId)
+	require.Equal(t, oldState.Votes, migrated.Votes)
+	require.Equal(t, types.NewDepositParams(
+		minDeposit, time.Hour, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), true, true, sdk.OneDec(), false,
+	), migrated.DepositParams)
+	require.Equal(t, types.NewVotingParams(time.Hour, time.Hour), migrated.VotingParams)
+	require.Equal(t, types.DefaultExpeditedThreshold, migrated.TallyParams.ExpeditedThreshold)
+}
@@ -0,0 +1,6 @@
+package v046
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "gov"
+)
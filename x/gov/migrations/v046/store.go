@@ -0,0 +1,76 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// migrateExpeditedParams sets the expedited params from the v0.45 params.
+func migrateExpeditedParams(depositParams *types.DepositParams, votingParams *types.VotingParams, tallyParams *types.TallyParams) {
+	depositParams.ExpeditedMinDeposit = sdk.Coins{}
+	for _, coin := range depositParams.MinDeposit {
+		depositParams.ExpeditedMinDeposit = depositParams.ExpeditedMinDeposit.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(5)),
+		)
+	}
+
+	votingParams.ExpeditedVotingPeriod = types.DefaultExpeditedPeriod
+	if votingParams.VotingPeriod < votingParams.ExpeditedVotingPeriod {
+		votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod
+	}
+
+	tallyParams.ExpeditedThreshold = sdk.MaxDec(types.DefaultExpeditedThreshold, tallyParams.Threshold)
+}
+
+// migrateDepositBurnParams sets the deposit burn params so that the deposits
+// keep being burned as in v0.45.
+func migrateDepositBurnParams(depositParams *types.DepositParams) {
+	depositParams.BurnVoteQuorum = true
+	depositParams.BurnVoteVeto = true
+	depositParams.BurnRatio = types.DefaultBurnRatio
+	depositParams.BurnToCommunityPool = false
+}
+
+// MigrateParams performs in-place params migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Set the ExpeditedMinDeposit param to 5 times the MinDeposit.
+// - Set the ExpeditedVotingPeriod param to its default value, capped by the
+// VotingPeriod.
+// - Set the ExpeditedThreshold param to its default value, or to the Threshold
+// if it is higher.
+func MigrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var (
+		depositParams types.DepositParams
+		votingParams  types.VotingParams
+		tallyParams   types.TallyParams
+	)
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	migrateExpeditedParams(&depositParams, &votingParams, &tallyParams)
+
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	return nil
+}
+
+// MigrateDepositBurnParams performs in-place deposit params migrations from
+// v0.45 to v0.46. The migration includes:
+//
+// - Set the BurnVoteQuorum and BurnVoteVeto params to true, the BurnRatio
+// param to 1 and the BurnToCommunityPool param to false, so that the deposits
+// keep being burned as before.
+func MigrateDepositBurnParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	migrateDepositBurnParams(&depositParams)
+
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	return nil
+}
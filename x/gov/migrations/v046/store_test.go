@@ -0,0 +1,78 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// v0.45 params, without the expedited fields
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: time.Hour})
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{VotingPeriod: time.Hour})
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+		Quorum:        types.DefaultQuorum,
+		Threshold:     sdk.NewDecWithPrec(75, 2),
+		VetoThreshold: types.DefaultVetoThreshold,
+	})
+
+	require.NoError(t, v046.MigrateParams(ctx, paramSpace))
+
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 500), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), depositParams.ExpeditedMinDeposit)
+
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, time.Hour, votingParams.ExpeditedVotingPeriod)
+
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), tallyParams.ExpeditedThreshold)
+
+	// the deposit burn params are set by the following migration
+	require.NoError(t, v046.MigrateDepositBurnParams(ctx, paramSpace))
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	require.NoError(t, types.ValidateGenesis(types.NewGenesisState(1, depositParams, votingParams, tallyParams)))
+}
+
+func TestMigrateDepositBurnParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// deposit params without the burn fields
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    time.Hour,
+		ExpeditedMinDeposit: minDeposit,
+	})
+
+	require.NoError(t, v046.MigrateDepositBurnParams(ctx, paramSpace))
+
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, types.NewDepositParams(minDeposit, time.Hour, minDeposit, true, true, sdk.OneDec(), false), depositParams)
+}
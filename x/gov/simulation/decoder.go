@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -47,6 +48,18 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.TallySharesKeyPrefix):
+			var sharesA, sharesB types.TallyShares
+			cdc.MustUnmarshal(kvA.Value, &sharesA)
+			cdc.MustUnmarshal(kvB.Value, &sharesB)
+			return fmt.Sprintf("%v\n%v", sharesA, sharesB)
+
+		case bytes.Equal(kvA.Key[:1], types.VoterSharesKeyPrefix):
+			var sharesA, sharesB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &sharesA)
+			cdc.MustUnmarshal(kvB.Value, &sharesB)
+			return fmt.Sprintf("%v\n%v", sharesA, sharesB)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
@@ -19,6 +19,7 @@ import (
 var (
 	delPk1   = ed25519.GenPrivKey().PubKey()
 	delAddr1 = sdk.AccAddress(delPk1.Address())
+	valAddr1 = sdk.ValAddress(delPk1.Address())
 )
 
 func TestDecodeStore(t *testing.T) {
@@ -27,15 +28,17 @@ func TestDecodeStore(t *testing.T) {
 
 	endTime := time.Now().UTC()
 	content := types.ContentFromProposalType("test", "test", types.ProposalTypeText)
-	proposalA, err := types.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour))
+	proposalA, err := types.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour), false)
 	require.NoError(t, err)
-	proposalB, err := types.NewProposal(content, 2, endTime, endTime.Add(24*time.Hour))
+	proposalB, err := types.NewProposal(content, 2, endTime, endTime.Add(24*time.Hour), false)
 	require.NoError(t, err)
 
 	proposalIDBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
+	tallyShares := types.NewEmptyTallyShares().Add(sdk.OneDec(), vote.Options)
+	voterShares := sdk.DecProto{Dec: sdk.OneDec()}
 
 	proposalBzA, err := cdc.Marshal(&proposalA)
 	require.NoError(t, err)
@@ -72,6 +75,18 @@ func TestDecodeStore(t *testing.T) {
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			fmt.Sprintf("%v\n%v", vote, vote), false,
 		},
+		{
+			"tally shares",
+			kv.Pair{Key: types.ValidatorTallySharesKey(1, valAddr1), Value: cdc.MustMarshal(&tallyShares)},
+			kv.Pair{Key: types.ValidatorTallySharesKey(1, valAddr1), Value: cdc.MustMarshal(&tallyShares)},
+			fmt.Sprintf("%v\n%v", tallyShares, tallyShares), false,
+		},
+		{
+			"voter shares",
+			kv.Pair{Key: types.VoterDelegationSharesKey(1, delAddr1, valAddr1), Value: cdc.MustMarshal(&voterShares)},
+			kv.Pair{Key: types.VoterDelegationSharesKey(1, delAddr1, valAddr1), Value: cdc.MustMarshal(&voterShares)},
+			fmt.Sprintf("%v\n%v", voterShares, voterShares), false,
+		},
 		{
 			"other",
 			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
@@ -16,12 +16,19 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod        = "deposit_params_deposit_period"
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	DepositParamsBurnVoteQuorum       = "deposit_params_burn_vote_quorum"
+	DepositParamsBurnVoteVeto         = "deposit_params_burn_vote_veto"
+	DepositParamsBurnRatio            = "deposit_params_burn_ratio"
+	DepositParamsBurnToCommunityPool  = "deposit_params_burn_to_community_pool"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -34,11 +41,34 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit,
+// never lower than a DepositParamsMinDeposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1e3, 5e3))))
+}
+
+// GenDepositParamsBurn randomized DepositParamsBurnVoteQuorum,
+// DepositParamsBurnVoteVeto and DepositParamsBurnToCommunityPool
+func GenDepositParamsBurn(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenDepositParamsBurnRatio randomized DepositParamsBurnRatio
+func GenDepositParamsBurnRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 101)), 2)
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod,
+// not longer than votingPeriod
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, int(votingPeriod/time.Second)+1)) * time.Second
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -49,6 +79,12 @@ func GenTallyParamsThreshold(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 450, 550)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold,
+// never lower than a TallyParamsThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 550, 667)), 3)
+}
+
 // GenTallyParamsVeto randomized TallyParamsVeto
 func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
@@ -94,11 +130,53 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r) },
+	)
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
+	var burnVoteQuorum bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteQuorum, &burnVoteQuorum, simState.Rand,
+		func(r *rand.Rand) { burnVoteQuorum = GenDepositParamsBurn(r) },
+	)
+
+	var burnVoteVeto bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteVeto, &burnVoteVeto, simState.Rand,
+		func(r *rand.Rand) { burnVoteVeto = GenDepositParamsBurn(r) },
+	)
+
+	var burnRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnRatio, &burnRatio, simState.Rand,
+		func(r *rand.Rand) { burnRatio = GenDepositParamsBurnRatio(r) },
+	)
+
+	var burnToCommunityPool bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnToCommunityPool, &burnToCommunityPool, simState.Rand,
+		func(r *rand.Rand) { burnToCommunityPool = GenDepositParamsBurn(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
-		types.NewTallyParams(quorum, threshold, veto),
+		types.NewDepositParams(minDeposit, depositPeriod, expeditedMinDeposit, burnVoteQuorum, burnVoteVeto, burnRatio, burnToCommunityPool),
+		types.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		types.NewTallyParams(quorum, threshold, veto, expeditedThreshold),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -28,7 +28,9 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keyVotingParams,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf(`{"voting_period": "%d"}`, GenVotingParamsVotingPeriod(r))
+				votingPeriod := GenVotingParamsVotingPeriod(r)
+				return fmt.Sprintf(`{"voting_period": "%d", "expedited_voting_period": "%d"}`,
+					votingPeriod, GenVotingParamsExpeditedVotingPeriod(r, votingPeriod))
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyDepositParams,
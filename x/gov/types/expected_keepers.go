@@ -24,6 +24,7 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingtypes.DelegationI // get a particular delegation
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -49,6 +50,12 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected distribution keeper, used to send the
+// burned deposits to the community pool (noalias)
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // Event Hooks
 // These can be utilized to communicate between a governance keeper and another
 // keepers.
@@ -50,6 +50,12 @@ func ValidateGenesis(data *GenesisState) error {
 			threshold.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || expeditedThreshold.LT(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be between the vote threshold and one, is %s",
+			expeditedThreshold)
+	}
+
 	veto := data.TallyParams.VetoThreshold
 	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
@@ -61,6 +67,24 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	expeditedMinDeposit := data.DepositParams.ExpeditedMinDeposit
+	if !expeditedMinDeposit.IsValid() || !expeditedMinDeposit.IsAllGTE(data.DepositParams.MinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount not lower than the deposit amount, is %s",
+			expeditedMinDeposit.String())
+	}
+
+	burnRatio := data.DepositParams.BurnRatio
+	if burnRatio.IsNil() || burnRatio.IsNegative() || burnRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance deposit burn ratio should be positive and less or equal to one, is %s",
+			burnRatio)
+	}
+
+	expeditedPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedPeriod <= 0 || expeditedPeriod > data.VotingParams.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and not longer than the voting period, is %s",
+			expeditedPeriod)
+	}
+
 	return nil
 }
 
@@ -2,8 +2,11 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +23,96 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisExpedited(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*GenesisState)
+		expErr   bool
+	}{
+		{"default", func(*GenesisState) {}, false},
+		{
+			"expedited threshold lower than threshold",
+			func(gs *GenesisState) { gs.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(4, 1) },
+			true,
+		},
+		{
+			"expedited threshold above one",
+			func(gs *GenesisState) { gs.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(11, 1) },
+			true,
+		},
+		{
+			"expedited min deposit lower than min deposit",
+			func(gs *GenesisState) {
+				gs.DepositParams.ExpeditedMinDeposit = gs.DepositParams.MinDeposit.Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+			},
+			true,
+		},
+		{
+			"expedited min deposit in another denom",
+			func(gs *GenesisState) {
+				gs.DepositParams.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin("foo", DefaultExpeditedMinDepositTokens))
+			},
+			true,
+		},
+		{
+			"expedited voting period longer than voting period",
+			func(gs *GenesisState) {
+				gs.VotingParams.ExpeditedVotingPeriod = gs.VotingParams.VotingPeriod + time.Second
+			},
+			true,
+		},
+		{
+			"zero expedited voting period",
+			func(gs *GenesisState) { gs.VotingParams.ExpeditedVotingPeriod = 0 },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			tc.malleate(gs)
+
+			err := ValidateGenesis(gs)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, err == nil, validateDepositParams(gs.DepositParams) == nil &&
+				validateVotingParams(gs.VotingParams) == nil && validateTallyParams(gs.TallyParams) == nil)
+		})
+	}
+}
+
+func TestValidateGenesisBurnRatio(t *testing.T) {
+	testCases := []struct {
+		name      string
+		burnRatio sdk.Dec
+		expErr    bool
+	}{
+		{"zero", sdk.ZeroDec(), false},
+		{"half", sdk.NewDecWithPrec(5, 1), false},
+		{"one", sdk.OneDec(), false},
+		{"nil", sdk.Dec{}, true},
+		{"negative", sdk.NewDecWithPrec(-1, 1), true},
+		{"above one", sdk.NewDecWithPrec(11, 1), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.DepositParams.BurnRatio = tc.burnRatio
+
+			err := ValidateGenesis(gs)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, err == nil, validateDepositParams(gs.DepositParams) == nil)
+		})
+	}
+}
@@ -6,9 +6,9 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -200,6 +200,47 @@ func (m *TextProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextProposal proto.InternalMessageInfo
 
+// MessagesProposal defines a proposal whose messages are executed atomically,
+// with the gov module account as their only signer, once the proposal passes.
+type MessagesProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// messages are the sdk.Msgs executed when the proposal passes.
+	Messages []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MessagesProposal) Reset()      { *m = MessagesProposal{} }
+func (*MessagesProposal) ProtoMessage() {}
+func (*MessagesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+}
+func (m *MessagesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesProposal.Merge(m, src)
+}
+func (m *MessagesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagesProposal proto.InternalMessageInfo
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -211,7 +252,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +284,7 @@ var xxx_messageInfo_Deposit proto.InternalMessageInfo
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	ProposalId       uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"id"`
-	Content          *types1.Any                              `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content          *types.Any                               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Status           ProposalStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1beta1.ProposalStatus" json:"status,omitempty"`
 	FinalTallyResult TallyResult                              `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result"`
 	SubmitTime       time.Time                                `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
@@ -251,12 +292,16 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+	// expedited defines if the proposal is expedited: it has a shorter voting
+	// period and a higher threshold, and is converted into a regular proposal
+	// if it does not pass.
+	Expedited bool `protobuf:"varint,10,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +341,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,6 +370,51 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
+// TallyShares defines the delegation shares of the voters of a proposal
+// delegated to a validator, split by vote option. It is kept as a running tally
+// while the proposal is in its voting period.
+type TallyShares struct {
+	Yes        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=yes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes"`
+	Abstain    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=abstain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain"`
+	No         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=no,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no"`
+	NoWithVeto github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=no_with_veto,json=noWithVeto,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_with_veto"`
+	// total is the sum of the delegation shares of the voters, whatever their
+	// vote options.
+	Total github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total"`
+}
+
+func (m *TallyShares) Reset()      { *m = TallyShares{} }
+func (*TallyShares) ProtoMessage() {}
+func (*TallyShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+}
+func (m *TallyShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyShares.Merge(m, src)
+}
+func (m *TallyShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyShares proto.InternalMessageInfo
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -341,7 +431,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,12 +467,25 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expedited_min_deposit,omitempty"`
+	//  Whether the deposits of a proposal which does not reach the quorum are
+	//  burned. Default value: true.
+	BurnVoteQuorum bool `protobuf:"varint,4,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
+	//  Whether the deposits of a vetoed proposal are burned. Default value: true.
+	BurnVoteVeto bool `protobuf:"varint,5,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	//  Proportion of the deposits burned when the deposits of a proposal are
+	//  burned, the rest being refunded. Default value: 1.
+	BurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=burn_ratio,json=burnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_ratio,omitempty"`
+	//  Whether the burned deposits are sent to the community pool instead of
+	//  being destroyed. Default value: false.
+	BurnToCommunityPool bool `protobuf:"varint,7,opt,name=burn_to_community_pool,json=burnToCommunityPool,proto3" json:"burn_to_community_pool,omitempty"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,12 +518,14 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Length of the voting period of expedited proposals.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,12 +564,15 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass.
+	//  Default value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{10}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,9 +606,11 @@ func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
+	proto.RegisterType((*MessagesProposal)(nil), "cosmos.gov.v1beta1.MessagesProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
+	proto.RegisterType((*TallyShares)(nil), "cosmos.gov.v1beta1.TallyShares")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1beta1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta1.VotingParams")
@@ -510,92 +620,111 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xf7, 0xda, 0x8e, 0x93, 0x3c, 0x3b, 0xc9, 0x32, 0xe4, 0x0b, 0x8e, 0xbf, 0x7c, 0xed, 0x95,
-	0xbf, 0x12, 0x8d, 0x10, 0x71, 0x20, 0x95, 0x90, 0x1a, 0x7a, 0xb1, 0xe3, 0x4d, 0x6b, 0x14, 0xd9,
-	0xd6, 0x7a, 0x71, 0x04, 0x87, 0xae, 0x36, 0xde, 0xc1, 0xd9, 0xd6, 0xbb, 0x63, 0xbc, 0xe3, 0x90,
-	0xdc, 0x7a, 0xa9, 0x84, 0x7c, 0xe2, 0xc8, 0xc5, 0x12, 0x6a, 0x6f, 0x3d, 0xf5, 0xc0, 0x3f, 0xd0,
-	0x1b, 0xaa, 0x7a, 0xa0, 0x9c, 0x50, 0x0f, 0xa1, 0x04, 0xb5, 0xa2, 0xfc, 0x15, 0xd5, 0xce, 0xcc,
-	0x26, 0x1b, 0x27, 0x6a, 0x70, 0xe9, 0x29, 0xbb, 0x33, 0x9f, 0x1f, 0xf3, 0x9e, 0xdf, 0x7b, 0xb3,
-	0x81, 0x4b, 0x2d, 0xe2, 0x39, 0xc4, 0x5b, 0x6e, 0x93, 0x9d, 0xe5, 0x9d, 0xeb, 0x5b, 0x98, 0x9a,
-	0xd7, 0xfd, 0xe7, 0x42, 0xb7, 0x47, 0x28, 0x41, 0x88, 0xef, 0x16, 0xfc, 0x15, 0xb1, 0x9b, 0xc9,
-	0x0a, 0xc6, 0x96, 0xe9, 0xe1, 0x43, 0x4a, 0x8b, 0xd8, 0x2e, 0xe7, 0x64, 0xe6, 0xdb, 0xa4, 0x4d,
-	0xd8, 0xe3, 0xb2, 0xff, 0x24, 0x56, 0x73, 0x6d, 0x42, 0xda, 0x1d, 0xbc, 0xcc, 0xde, 0xb6, 0xfa,
-	0xf7, 0x96, 0xa9, 0xed, 0x60, 0x8f, 0x9a, 0x4e, 0x57, 0x00, 0x16, 0x46, 0x01, 0xa6, 0xbb, 0x27,
-	0xb6, 0xb2, 0xa3, 0x5b, 0x56, 0xbf, 0x67, 0x52, 0x9b, 0x04, 0x8e, 0x0b, 0xfc, 0x44, 0x06, 0x37,
-	0x15, 0x47, 0x66, 0x2f, 0xf9, 0x6f, 0x25, 0x40, 0x9b, 0xd8, 0x6e, 0x6f, 0x53, 0x6c, 0x35, 0x09,
-	0xc5, 0xb5, 0xae, 0xcf, 0x43, 0x37, 0x20, 0x41, 0xd8, 0x53, 0x5a, 0x52, 0xa4, 0xc5, 0xd9, 0x95,
-	0x6c, 0xe1, 0x64, 0xa0, 0x85, 0x23, 0xbc, 0x26, 0xd0, 0x48, 0x87, 0xc4, 0x03, 0xa6, 0x96, 0x8e,
-	0x2a, 0xd2, 0xe2, 0x74, 0xe9, 0xd3, 0x67, 0xfb, 0xb9, 0xc8, 0xaf, 0xfb, 0xb9, 0xcb, 0x6d, 0x9b,
-	0x6e, 0xf7, 0xb7, 0x0a, 0x2d, 0xe2, 0x08, 0x7f, 0xf1, 0x67, 0xc9, 0xb3, 0xbe, 0x5a, 0xa6, 0x7b,
-	0x5d, 0xec, 0x15, 0xca, 0xb8, 0xf5, 0xe2, 0xe9, 0x12, 0x08, 0xa3, 0x32, 0x6e, 0x69, 0x42, 0x2b,
-	0xbf, 0x09, 0x29, 0x1d, 0xef, 0xd2, 0x7a, 0x8f, 0x74, 0x89, 0x67, 0x76, 0xd0, 0x3c, 0x4c, 0x50,
-	0x9b, 0x76, 0x30, 0x3b, 0xdc, 0xb4, 0xc6, 0x5f, 0x90, 0x02, 0x49, 0x0b, 0x7b, 0xad, 0x9e, 0xcd,
-	0x0f, 0xce, 0x0e, 0xa0, 0x85, 0x97, 0x56, 0xe7, 0xde, 0x3e, 0xc9, 0x49, 0x3f, 0x3d, 0x5d, 0x9a,
-	0x5c, 0x23, 0x2e, 0xc5, 0x2e, 0xcd, 0xff, 0x22, 0xc1, 0x64, 0x19, 0x77, 0x89, 0x67, 0x53, 0x94,
-	0x83, 0x64, 0x57, 0x18, 0x18, 0xb6, 0xc5, 0xa4, 0xe3, 0x1a, 0x04, 0x4b, 0x15, 0x0b, 0xdd, 0x80,
-	0x69, 0x8b, 0x63, 0x49, 0x4f, 0x84, 0x97, 0x7e, 0xf1, 0x74, 0x69, 0x5e, 0x1c, 0xb8, 0x68, 0x59,
-	0x3d, 0xec, 0x79, 0x0d, 0xda, 0xb3, 0xdd, 0xb6, 0x76, 0x04, 0x45, 0x2d, 0x48, 0x98, 0x0e, 0xe9,
-	0xbb, 0x34, 0x1d, 0x53, 0x62, 0x8b, 0xc9, 0x95, 0x85, 0x20, 0x97, 0x7e, 0x81, 0x1c, 0x26, 0x73,
-	0x8d, 0xd8, 0x6e, 0xe9, 0x9a, 0x9f, 0xae, 0xef, 0x5f, 0xe5, 0x16, 0xdf, 0x23, 0x5d, 0x3e, 0xc1,
-	0xd3, 0x84, 0xf4, 0xea, 0xd4, 0xc3, 0x27, 0xb9, 0xc8, 0xdb, 0x27, 0xb9, 0x48, 0xfe, 0x87, 0x09,
-	0x98, 0x3a, 0xcc, 0xd4, 0x47, 0xa7, 0x04, 0x55, 0x4a, 0xbc, 0xdb, 0xcf, 0x45, 0x6d, 0xeb, 0x58,
-	0x70, 0x37, 0x61, 0xb2, 0xc5, 0x93, 0xc2, 0x42, 0x4b, 0xae, 0xcc, 0x17, 0x78, 0x51, 0x15, 0x82,
-	0xa2, 0x2a, 0x14, 0xdd, 0xbd, 0x52, 0x32, 0x94, 0x3d, 0x2d, 0x60, 0xa0, 0x55, 0x48, 0x78, 0xd4,
-	0xa4, 0x7d, 0x2f, 0x1d, 0x63, 0xd5, 0x92, 0x3f, 0xad, 0x5a, 0x82, 0x33, 0x35, 0x18, 0x52, 0x13,
-	0x0c, 0xd4, 0x00, 0x74, 0xcf, 0x76, 0xcd, 0x8e, 0x41, 0xcd, 0x4e, 0x67, 0xcf, 0xe8, 0x61, 0xaf,
-	0xdf, 0xa1, 0xe9, 0x38, 0x3b, 0x43, 0xee, 0x34, 0x1d, 0xdd, 0xc7, 0x69, 0x0c, 0x56, 0x8a, 0xfb,
-	0xf9, 0xd2, 0x64, 0x26, 0x10, 0x5a, 0x47, 0x2a, 0x24, 0xbd, 0xfe, 0x96, 0x63, 0x53, 0xc3, 0xef,
-	0xa2, 0xf4, 0x04, 0x53, 0xcb, 0x9c, 0x88, 0x48, 0x0f, 0x5a, 0xac, 0x34, 0xe5, 0x0b, 0x3d, 0x7a,
-	0x95, 0x93, 0x34, 0xe0, 0x44, 0x7f, 0x0b, 0x55, 0x41, 0x16, 0x3f, 0xa3, 0x81, 0x5d, 0x8b, 0x6b,
-	0x25, 0xc6, 0xd0, 0x9a, 0x15, 0x6c, 0xd5, 0xb5, 0x98, 0x5e, 0x17, 0x66, 0x28, 0xa1, 0x66, 0xc7,
-	0x10, 0xeb, 0xe9, 0xc9, 0x7f, 0xbf, 0x20, 0x52, 0xcc, 0x21, 0x28, 0xea, 0x3a, 0x9c, 0xdb, 0x21,
-	0xd4, 0x76, 0xdb, 0x86, 0x47, 0xcd, 0x9e, 0x48, 0xc7, 0xd4, 0x18, 0x21, 0xcc, 0x71, 0x7a, 0xc3,
-	0x67, 0xb3, 0x18, 0x36, 0x40, 0x2c, 0x1d, 0xa5, 0x64, 0x7a, 0x0c, 0xbd, 0x19, 0x4e, 0x16, 0x19,
-	0x59, 0x8d, 0xfb, 0x1d, 0x99, 0xff, 0x33, 0x0a, 0xc9, 0xf0, 0xcf, 0x57, 0x85, 0xd8, 0x1e, 0xf6,
-	0x78, 0x77, 0x8f, 0x35, 0x42, 0x2a, 0x2e, 0x0d, 0x8d, 0x90, 0x8a, 0x4b, 0x35, 0x5f, 0x08, 0x35,
-	0x61, 0xd2, 0xdc, 0xf2, 0xa8, 0x69, 0xbb, 0xff, 0x60, 0x2c, 0x9d, 0xd4, 0x0c, 0xc4, 0xd0, 0x06,
-	0x44, 0x5d, 0xc2, 0x6a, 0xfe, 0x43, 0x25, 0xa3, 0x2e, 0x41, 0x5f, 0x40, 0xca, 0x25, 0xc6, 0x03,
-	0x9b, 0x6e, 0x1b, 0x3b, 0x98, 0x12, 0xd6, 0x03, 0x1f, 0xaa, 0x0b, 0x2e, 0xd9, 0xb4, 0xe9, 0x76,
-	0x13, 0x53, 0x22, 0x72, 0xfd, 0xbb, 0x04, 0x71, 0x7f, 0x70, 0x9f, 0x3d, 0xef, 0x0a, 0x30, 0xb1,
-	0x43, 0x28, 0x3e, 0x7b, 0xd6, 0x71, 0x98, 0x3f, 0x05, 0xc4, 0x9d, 0x11, 0x7b, 0x9f, 0x3b, 0xa3,
-	0x14, 0x4d, 0x4b, 0x87, 0xf7, 0xc6, 0x3a, 0x4c, 0xf2, 0x27, 0x2f, 0x1d, 0x67, 0x3d, 0x71, 0xf9,
-	0x34, 0xf2, 0xc9, 0x8b, 0x4a, 0x4c, 0x80, 0x80, 0xbc, 0x3a, 0xf5, 0x38, 0x18, 0x83, 0x83, 0x28,
-	0xcc, 0x88, 0x2e, 0xa8, 0x9b, 0x3d, 0xd3, 0xf1, 0xd0, 0x37, 0x12, 0x24, 0x1d, 0xdb, 0x3d, 0x6c,
-	0x3e, 0xe9, 0xac, 0xe6, 0xab, 0xf8, 0xda, 0xef, 0xf6, 0x73, 0xff, 0x09, 0xb1, 0xae, 0x12, 0xc7,
-	0xa6, 0xd8, 0xe9, 0xd2, 0xbd, 0xb1, 0xba, 0x12, 0x1c, 0xdb, 0x0d, 0x7a, 0xf2, 0x3e, 0x20, 0xc7,
-	0xdc, 0x0d, 0x04, 0x8d, 0x2e, 0xee, 0xd9, 0xc4, 0x12, 0x53, 0x77, 0xe1, 0x44, 0x13, 0x95, 0xc5,
-	0x55, 0x5e, 0x5a, 0x14, 0xa7, 0xb9, 0x74, 0x92, 0x7c, 0x74, 0xa8, 0xc7, 0x7e, 0x8f, 0xc9, 0x8e,
-	0xb9, 0x1b, 0x84, 0xce, 0xf6, 0xf3, 0x1e, 0xa4, 0x9a, 0xac, 0xef, 0x44, 0x2a, 0x5a, 0x20, 0xfa,
-	0x30, 0x70, 0x97, 0xce, 0x72, 0xff, 0xbf, 0x70, 0xbf, 0x78, 0x8c, 0x37, 0x62, 0x9c, 0xe2, 0x9b,
-	0xc2, 0xf4, 0xc7, 0xa0, 0xab, 0x85, 0xe9, 0x5d, 0x48, 0xdc, 0xef, 0x93, 0x5e, 0xdf, 0x61, 0x6e,
-	0xa9, 0x52, 0x69, 0xbc, 0x6f, 0x83, 0x77, 0xfb, 0x39, 0x99, 0xf3, 0x8f, 0x5c, 0x35, 0xa1, 0x88,
-	0x5a, 0x30, 0x4d, 0xb7, 0x7b, 0xd8, 0xdb, 0x26, 0x1d, 0x9e, 0xca, 0x54, 0x49, 0x1d, 0x5b, 0xfe,
-	0xfc, 0xa1, 0x44, 0xc8, 0xe1, 0x48, 0x17, 0xdd, 0x87, 0x59, 0xbf, 0x31, 0x8d, 0x23, 0xa7, 0x18,
-	0x73, 0xba, 0x35, 0xb6, 0x53, 0xfa, 0xb8, 0x4e, 0xc8, 0x6e, 0xc6, 0xdf, 0xd1, 0x83, 0x8d, 0x2b,
-	0x7f, 0x48, 0x00, 0xa1, 0xcf, 0xb2, 0xab, 0x70, 0xb1, 0x59, 0xd3, 0x55, 0xa3, 0x56, 0xd7, 0x2b,
-	0xb5, 0xaa, 0x71, 0xbb, 0xda, 0xa8, 0xab, 0x6b, 0x95, 0xf5, 0x8a, 0x5a, 0x96, 0x23, 0x99, 0xb9,
-	0xc1, 0x50, 0x49, 0x72, 0xa0, 0xea, 0x6b, 0xa1, 0x3c, 0xcc, 0x85, 0xd1, 0x77, 0xd4, 0x86, 0x2c,
-	0x65, 0x66, 0x06, 0x43, 0x65, 0x9a, 0xa3, 0xee, 0x60, 0x0f, 0x5d, 0x81, 0xf3, 0x61, 0x4c, 0xb1,
-	0xd4, 0xd0, 0x8b, 0x95, 0xaa, 0x1c, 0xcd, 0x9c, 0x1b, 0x0c, 0x95, 0x19, 0x8e, 0x2b, 0x8a, 0x71,
-	0xa7, 0xc0, 0x6c, 0x18, 0x5b, 0xad, 0xc9, 0xb1, 0x4c, 0x6a, 0x30, 0x54, 0xa6, 0x38, 0xac, 0x4a,
-	0xd0, 0x0a, 0xa4, 0x8f, 0x23, 0x8c, 0xcd, 0x8a, 0xfe, 0xb9, 0xd1, 0x54, 0xf5, 0x9a, 0x1c, 0xcf,
-	0xcc, 0x0f, 0x86, 0x8a, 0x1c, 0x60, 0x83, 0xb1, 0x94, 0x89, 0x3f, 0xfc, 0x2e, 0x1b, 0xb9, 0xf2,
-	0x73, 0x14, 0x66, 0x8f, 0x7f, 0x21, 0xa0, 0x02, 0xfc, 0xb7, 0xae, 0xd5, 0xea, 0xb5, 0x46, 0x71,
-	0xc3, 0x68, 0xe8, 0x45, 0xfd, 0x76, 0x63, 0x24, 0x60, 0x16, 0x0a, 0x07, 0x57, 0xed, 0x0e, 0xba,
-	0x09, 0xd9, 0x51, 0x7c, 0x59, 0xad, 0xd7, 0x1a, 0x15, 0xdd, 0xa8, 0xab, 0x5a, 0xa5, 0x56, 0x96,
-	0xa5, 0xcc, 0xc5, 0xc1, 0x50, 0x39, 0xcf, 0x29, 0xc7, 0x3a, 0x04, 0x7d, 0x02, 0xff, 0x1b, 0x25,
-	0x37, 0x6b, 0x7a, 0xa5, 0xfa, 0x59, 0xc0, 0x8d, 0x66, 0x2e, 0x0c, 0x86, 0x0a, 0xe2, 0xdc, 0x66,
-	0xa8, 0xce, 0xd1, 0x55, 0xb8, 0x30, 0x4a, 0xad, 0x17, 0x1b, 0x0d, 0xb5, 0x2c, 0xc7, 0x32, 0xf2,
-	0x60, 0xa8, 0xa4, 0x38, 0xa7, 0x6e, 0x7a, 0x1e, 0xb6, 0xd0, 0x35, 0x48, 0x8f, 0xa2, 0x35, 0xf5,
-	0x96, 0xba, 0xa6, 0xab, 0x65, 0x39, 0x9e, 0x41, 0x83, 0xa1, 0x32, 0x2b, 0xbe, 0x90, 0xf0, 0x97,
-	0xb8, 0x45, 0xf1, 0xa9, 0xfa, 0xeb, 0xc5, 0xca, 0x86, 0x5a, 0x96, 0x27, 0xc2, 0xfa, 0xeb, 0xa6,
-	0xdd, 0xc1, 0x16, 0x4f, 0x67, 0xa9, 0xfa, 0xec, 0x75, 0x36, 0xf2, 0xf2, 0x75, 0x36, 0xf2, 0xf5,
-	0x41, 0x36, 0xf2, 0xec, 0x20, 0x2b, 0x3d, 0x3f, 0xc8, 0x4a, 0xbf, 0x1d, 0x64, 0xa5, 0x47, 0x6f,
-	0xb2, 0x91, 0xe7, 0x6f, 0xb2, 0x91, 0x97, 0x6f, 0xb2, 0x91, 0xbb, 0x7f, 0x3f, 0xbf, 0x76, 0xd9,
-	0xff, 0x3c, 0xac, 0x6c, 0xb7, 0x12, 0x6c, 0x22, 0x7c, 0xfc, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0x21, 0xa9, 0x1b, 0x0e, 0x0d, 0x00, 0x00,
+	// 1652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe6, 0xf2, 0x87, 0x44, 0x3d, 0x51, 0xf2, 0x66, 0xa4, 0xd8, 0x6b, 0xd6, 0xe1, 0x6e, 0xd9,
+	0x22, 0x15, 0x0c, 0x8b, 0x4a, 0x5c, 0x20, 0x40, 0x95, 0xf6, 0x40, 0x8a, 0xab, 0x86, 0x81, 0x4d,
+	0xb2, 0xcb, 0x0d, 0x0d, 0xe7, 0xd0, 0xc5, 0x92, 0x3b, 0xa1, 0xb6, 0xe1, 0xee, 0xd0, 0xdc, 0xa1,
+	0x22, 0xdd, 0x7c, 0x29, 0x10, 0xf0, 0x94, 0x63, 0x50, 0x80, 0x80, 0x91, 0xde, 0x7a, 0xf6, 0xb5,
+	0xb7, 0x1e, 0x8c, 0xa2, 0x28, 0xd2, 0x9c, 0x82, 0x1e, 0x98, 0xc6, 0x46, 0x8b, 0x54, 0x7f, 0x40,
+	0xaf, 0x2d, 0x76, 0x76, 0x96, 0x5c, 0xfe, 0x68, 0x14, 0xc6, 0x3a, 0x79, 0x39, 0xef, 0xfb, 0xbe,
+	0xf7, 0xe6, 0xbd, 0x37, 0x6f, 0x46, 0x86, 0x5b, 0x6d, 0xe2, 0x39, 0xc4, 0x3b, 0xe8, 0x90, 0xd3,
+	0x83, 0xd3, 0x37, 0x5b, 0x98, 0x9a, 0x6f, 0xfa, 0xdf, 0x85, 0x5e, 0x9f, 0x50, 0x82, 0x50, 0x60,
+	0x2d, 0xf8, 0x2b, 0xdc, 0x9a, 0xcd, 0x71, 0x46, 0xcb, 0xf4, 0xf0, 0x84, 0xd2, 0x26, 0xb6, 0x1b,
+	0x70, 0xb2, 0xbb, 0x1d, 0xd2, 0x21, 0xec, 0xf3, 0xc0, 0xff, 0xe2, 0xab, 0x72, 0x87, 0x90, 0x4e,
+	0x17, 0x1f, 0xb0, 0x5f, 0xad, 0xc1, 0x07, 0x07, 0xd4, 0x76, 0xb0, 0x47, 0x4d, 0xa7, 0xc7, 0x01,
+	0x37, 0xe7, 0x01, 0xa6, 0x7b, 0xce, 0x4d, 0xb9, 0x79, 0x93, 0x35, 0xe8, 0x9b, 0xd4, 0x26, 0xa1,
+	0xc7, 0x9b, 0x41, 0x44, 0x46, 0xe0, 0x94, 0x87, 0xcc, 0x7e, 0xe4, 0x3f, 0x13, 0x00, 0x3d, 0xc0,
+	0x76, 0xe7, 0x84, 0x62, 0xab, 0x49, 0x28, 0xae, 0xf5, 0x7c, 0x1e, 0x7a, 0x0b, 0xd6, 0x08, 0xfb,
+	0x92, 0x04, 0x45, 0xd8, 0xdb, 0xbe, 0x9b, 0x2b, 0x2c, 0x6e, 0xb4, 0x30, 0xc5, 0x6b, 0x1c, 0x8d,
+	0x74, 0x58, 0xfb, 0x88, 0xa9, 0x49, 0x71, 0x45, 0xd8, 0xdb, 0x28, 0xfd, 0xfc, 0xd9, 0x58, 0x8e,
+	0xfd, 0x7d, 0x2c, 0xbf, 0xde, 0xb1, 0xe9, 0xc9, 0xa0, 0x55, 0x68, 0x13, 0x87, 0xfb, 0xe7, 0xff,
+	0xec, 0x7b, 0xd6, 0x87, 0x07, 0xf4, 0xbc, 0x87, 0xbd, 0x42, 0x19, 0xb7, 0xbf, 0x78, 0xba, 0x0f,
+	0xdc, 0x51, 0x19, 0xb7, 0x35, 0xae, 0x95, 0x7f, 0x00, 0x19, 0x1d, 0x9f, 0xd1, 0x7a, 0x9f, 0xf4,
+	0x88, 0x67, 0x76, 0xd1, 0x2e, 0xa4, 0xa8, 0x4d, 0xbb, 0x98, 0x05, 0xb7, 0xa1, 0x05, 0x3f, 0x90,
+	0x02, 0x9b, 0x16, 0xf6, 0xda, 0x7d, 0x3b, 0x08, 0x9c, 0x05, 0xa0, 0x45, 0x97, 0x0e, 0xaf, 0x7d,
+	0xf3, 0x44, 0x16, 0xfe, 0xfc, 0x74, 0x7f, 0xfd, 0x88, 0xb8, 0x14, 0xbb, 0x34, 0xff, 0x3b, 0x01,
+	0xc4, 0xfb, 0xd8, 0xf3, 0xcc, 0x0e, 0xf6, 0x5e, 0x56, 0x1d, 0xfd, 0x02, 0xd2, 0x0e, 0xd7, 0x92,
+	0x12, 0x4a, 0x62, 0x6f, 0xf3, 0xee, 0x6e, 0x21, 0x28, 0x4c, 0x21, 0x2c, 0x4c, 0xa1, 0xe8, 0x9e,
+	0x97, 0x36, 0xfd, 0x08, 0x3c, 0xeb, 0xc3, 0xc2, 0x7d, 0xaf, 0xa3, 0x4d, 0x28, 0x8b, 0xc1, 0xfd,
+	0x4d, 0x80, 0xf5, 0x32, 0xee, 0x11, 0xcf, 0xa6, 0x48, 0x86, 0xcd, 0x1e, 0x8f, 0xcf, 0xb0, 0x2d,
+	0x16, 0x59, 0x52, 0x83, 0x70, 0xa9, 0x62, 0xa1, 0xb7, 0x60, 0xc3, 0x0a, 0xb0, 0xa4, 0xcf, 0x73,
+	0x2f, 0x7d, 0xf1, 0x74, 0x7f, 0x97, 0x67, 0xb3, 0x68, 0x59, 0x7d, 0xec, 0x79, 0x0d, 0xda, 0xb7,
+	0xdd, 0x8e, 0x36, 0x85, 0xa2, 0x36, 0xac, 0x99, 0x0e, 0x19, 0xb8, 0x94, 0x87, 0x7c, 0x33, 0x2c,
+	0xb4, 0xdf, 0xbd, 0x93, 0x4a, 0x1f, 0x11, 0xdb, 0x2d, 0xbd, 0xe1, 0xd7, 0xf2, 0x0f, 0x5f, 0xc9,
+	0x7b, 0xdf, 0xa1, 0x96, 0x3e, 0xc1, 0xd3, 0xb8, 0xf4, 0x61, 0xfa, 0xe3, 0x27, 0x72, 0xec, 0x9b,
+	0x27, 0x72, 0x2c, 0xff, 0xd7, 0x14, 0xa4, 0x27, 0x89, 0xfe, 0xc9, 0x92, 0x4d, 0x95, 0xd6, 0x2e,
+	0xc6, 0x72, 0xdc, 0xb6, 0x66, 0x36, 0xf7, 0x36, 0xac, 0xb7, 0x83, 0xa4, 0xb0, 0xad, 0x7d, 0x6b,
+	0x62, 0x79, 0xf6, 0xb4, 0x90, 0x81, 0x0e, 0x61, 0xcd, 0xa3, 0x26, 0x1d, 0xf8, 0x45, 0xf1, 0x5b,
+	0x39, 0xbf, 0xac, 0x95, 0xc3, 0x98, 0x1a, 0x0c, 0xa9, 0x71, 0x06, 0x6a, 0x00, 0xfa, 0xc0, 0x76,
+	0xcd, 0xae, 0x41, 0xcd, 0x6e, 0xf7, 0xdc, 0xe8, 0x63, 0x6f, 0xd0, 0xa5, 0x52, 0x92, 0xc5, 0x20,
+	0x2f, 0xd3, 0xd1, 0x7d, 0x9c, 0xc6, 0x60, 0xa5, 0xa4, 0x9f, 0x2f, 0x4d, 0x64, 0x02, 0x91, 0x75,
+	0xa4, 0xc2, 0xa6, 0x37, 0x68, 0x39, 0x36, 0x35, 0xfc, 0x23, 0x2e, 0xa5, 0x98, 0x5a, 0x76, 0x61,
+	0x47, 0x7a, 0x78, 0xfe, 0x4b, 0x69, 0x5f, 0xe8, 0x93, 0xaf, 0x64, 0x41, 0x83, 0x80, 0xe8, 0x9b,
+	0x50, 0x15, 0x44, 0x5e, 0x46, 0x03, 0xbb, 0x56, 0xa0, 0xb5, 0xb6, 0x82, 0xd6, 0x36, 0x67, 0xab,
+	0xae, 0xc5, 0xf4, 0x7a, 0xb0, 0x45, 0x09, 0x35, 0xbb, 0x06, 0x5f, 0x97, 0xd6, 0xaf, 0xbe, 0x21,
+	0x32, 0xcc, 0x43, 0xd8, 0xd4, 0x75, 0x78, 0xe5, 0x94, 0x50, 0xdb, 0xed, 0x18, 0x1e, 0x35, 0xfb,
+	0x3c, 0x1d, 0xe9, 0x15, 0xb6, 0x70, 0x2d, 0xa0, 0x37, 0x7c, 0x36, 0xdb, 0xc3, 0x3d, 0xe0, 0x4b,
+	0xd3, 0x94, 0x6c, 0xac, 0xa0, 0xb7, 0x15, 0x90, 0xc3, 0x8c, 0xdc, 0x82, 0x0d, 0x7c, 0xd6, 0xc3,
+	0x96, 0x4d, 0xb1, 0x25, 0x81, 0x22, 0xec, 0xa5, 0xb5, 0xe9, 0xc2, 0x61, 0xd2, 0x3f, 0xaf, 0xf9,
+	0x7f, 0xc7, 0x61, 0x33, 0x5a, 0xdc, 0x2a, 0x24, 0xce, 0xb1, 0x27, 0x09, 0x2b, 0x4f, 0xbf, 0x8a,
+	0x4b, 0x23, 0xd3, 0xaf, 0xe2, 0x52, 0xcd, 0x17, 0x42, 0x4d, 0x58, 0x37, 0x5b, 0x1e, 0x35, 0x6d,
+	0x57, 0x8a, 0x5f, 0x81, 0x66, 0x28, 0x86, 0xee, 0x41, 0xdc, 0x25, 0x52, 0xe2, 0x0a, 0x24, 0xe3,
+	0x2e, 0x41, 0xbf, 0x86, 0x8c, 0x4b, 0x8c, 0x8f, 0x6c, 0x7a, 0x62, 0x9c, 0x62, 0x4a, 0xa4, 0xe4,
+	0x15, 0xe8, 0x82, 0x4b, 0x1e, 0xd8, 0xf4, 0xa4, 0x89, 0x29, 0xe1, 0xb9, 0xfe, 0x63, 0x82, 0xe7,
+	0xba, 0x71, 0x62, 0xf6, 0xb1, 0xf7, 0xfd, 0x73, 0xbd, 0x78, 0xd3, 0xbc, 0x7c, 0xae, 0x17, 0x35,
+	0x5f, 0x3a, 0xd7, 0x8b, 0x92, 0x57, 0x93, 0xeb, 0x45, 0xdd, 0x48, 0xae, 0x91, 0x06, 0x29, 0x76,
+	0x4a, 0xa5, 0xd4, 0x15, 0x08, 0x07, 0x52, 0xbc, 0x7e, 0xff, 0x14, 0x20, 0xe9, 0xbf, 0x19, 0x2e,
+	0xbf, 0xcd, 0x0a, 0x90, 0x3a, 0x25, 0x14, 0x5f, 0x7e, 0x93, 0x05, 0x30, 0x7f, 0xc6, 0xf3, 0xe7,
+	0x4a, 0xe2, 0xbb, 0x3c, 0x57, 0x4a, 0x71, 0x49, 0x98, 0x3c, 0x59, 0x8e, 0x61, 0x3d, 0xf8, 0xf2,
+	0xa4, 0x24, 0x9b, 0x78, 0xaf, 0x2f, 0x23, 0x2f, 0xbe, 0x91, 0xf8, 0x7c, 0x0f, 0xc9, 0x87, 0xe9,
+	0x4f, 0xc3, 0x4b, 0xee, 0xbf, 0x29, 0xd8, 0xe2, 0x33, 0xae, 0x6e, 0xf6, 0x4d, 0xc7, 0x43, 0xbf,
+	0x15, 0x60, 0xd3, 0xb1, 0xdd, 0xc9, 0x68, 0x15, 0x2e, 0x1b, 0xad, 0x15, 0x5f, 0xfb, 0x62, 0x2c,
+	0xbf, 0x1a, 0x61, 0xdd, 0x21, 0x8e, 0x4d, 0xb1, 0xd3, 0xa3, 0xe7, 0x2b, 0xcd, 0x5c, 0x70, 0x6c,
+	0x37, 0x9c, 0xb8, 0x8f, 0x00, 0x39, 0xe6, 0x59, 0x28, 0x68, 0xf4, 0x70, 0xdf, 0x26, 0x16, 0xbf,
+	0x53, 0x6f, 0x2e, 0x8c, 0xc8, 0x32, 0x7f, 0x45, 0x96, 0xf6, 0x78, 0x34, 0xb7, 0x16, 0xc9, 0xd3,
+	0xa0, 0x3e, 0xf5, 0x27, 0xa8, 0xe8, 0x98, 0x67, 0xe1, 0xd6, 0x99, 0x1d, 0x7d, 0x26, 0xc0, 0xab,
+	0x93, 0xa1, 0x69, 0x44, 0x93, 0x70, 0xe9, 0x83, 0xa3, 0xc1, 0xdd, 0xca, 0x4b, 0xf9, 0xdf, 0x33,
+	0x1d, 0x3b, 0x13, 0xb1, 0xfb, 0xd3, 0xbc, 0xbc, 0x03, 0x62, 0x6b, 0xd0, 0x77, 0x0d, 0xbf, 0x9b,
+	0x8c, 0x47, 0x03, 0xd2, 0x1f, 0x38, 0xec, 0x5c, 0xa5, 0x4b, 0xb9, 0x8b, 0xb1, 0x9c, 0x9d, 0xb7,
+	0x4d, 0x5d, 0x6b, 0xdb, 0xbe, 0xcd, 0x6f, 0x8a, 0x5f, 0x31, 0x0b, 0x2a, 0xc1, 0xf6, 0x14, 0xcd,
+	0xce, 0x67, 0x8a, 0xe9, 0xdc, 0xba, 0x18, 0xcb, 0xd2, 0xac, 0x25, 0xa2, 0x92, 0x09, 0x55, 0xd8,
+	0x09, 0xc4, 0x00, 0x0c, 0xc9, 0xb2, 0xcf, 0xee, 0xf4, 0x4c, 0xe9, 0x78, 0xb5, 0x63, 0x78, 0x31,
+	0x96, 0x77, 0xa7, 0x1a, 0x11, 0x4f, 0x1b, 0xfe, 0xaa, 0xe6, 0x2f, 0xa2, 0x87, 0x70, 0x9d, 0x41,
+	0x28, 0x31, 0xda, 0xc4, 0x71, 0x06, 0xae, 0x4d, 0xcf, 0x8d, 0x1e, 0x21, 0x5d, 0x69, 0x9d, 0x85,
+	0xfc, 0xe3, 0x8b, 0xb1, 0xac, 0x2c, 0x47, 0x44, 0x04, 0x77, 0x7c, 0x84, 0x4e, 0x8e, 0x42, 0x7b,
+	0x9d, 0x90, 0x6e, 0xfe, 0x3f, 0x02, 0x64, 0x9a, 0xec, 0x2e, 0xe5, 0x07, 0xa0, 0x0d, 0xfc, 0x6e,
+	0x0d, 0x7b, 0x4e, 0xb8, 0xac, 0xe7, 0x7e, 0xc4, 0x8b, 0x7f, 0x63, 0x86, 0x37, 0xd7, 0x6e, 0x99,
+	0xc0, 0xc8, 0x5b, 0xed, 0xb1, 0x00, 0x37, 0xa6, 0xad, 0x32, 0xeb, 0xef, 0xd2, 0x1e, 0xdf, 0xe7,
+	0xfe, 0x7e, 0xf8, 0x7f, 0x14, 0xe6, 0x3c, 0x4f, 0x7b, 0xba, 0x19, 0x09, 0x21, 0xff, 0xa7, 0xf0,
+	0x8a, 0xe2, 0xfb, 0x7e, 0x1f, 0xd6, 0x78, 0x3b, 0x09, 0xac, 0x8c, 0xa5, 0x95, 0xcb, 0x28, 0x2e,
+	0xb4, 0x1c, 0x57, 0x44, 0x6d, 0xd8, 0xa0, 0x27, 0x7d, 0xec, 0x9d, 0x90, 0x6e, 0xb0, 0xbf, 0x4c,
+	0x49, 0x5d, 0x59, 0x7e, 0x67, 0x22, 0x11, 0x6d, 0x92, 0xc9, 0x22, 0x7a, 0x04, 0xdb, 0x7e, 0xaf,
+	0x1a, 0x53, 0x4f, 0x09, 0xe6, 0xe9, 0xdd, 0x95, 0x3d, 0x49, 0xb3, 0x3a, 0x11, 0x77, 0x5b, 0xbe,
+	0x45, 0x9f, 0xb8, 0x7c, 0x2c, 0xc0, 0xf4, 0x90, 0x46, 0x1c, 0x27, 0x99, 0xe3, 0xda, 0xca, 0x8e,
+	0x5f, 0x5b, 0x22, 0x16, 0xf1, 0x8e, 0x26, 0xe6, 0x49, 0x08, 0xb7, 0xff, 0x25, 0x00, 0x44, 0xfe,
+	0x1a, 0xbe, 0x03, 0x37, 0x9a, 0x35, 0x5d, 0x35, 0x6a, 0x75, 0xbd, 0x52, 0xab, 0x1a, 0xef, 0x55,
+	0x1b, 0x75, 0xf5, 0xa8, 0x72, 0x5c, 0x51, 0xcb, 0x62, 0x2c, 0x7b, 0x6d, 0x38, 0x52, 0x36, 0x03,
+	0xa0, 0xea, 0x0b, 0xa2, 0x3c, 0x5c, 0x8b, 0xa2, 0x1f, 0xaa, 0x0d, 0x51, 0xc8, 0x6e, 0x0d, 0x47,
+	0xca, 0x46, 0x80, 0x7a, 0x88, 0x3d, 0x74, 0x1b, 0x76, 0xa2, 0x98, 0x62, 0xa9, 0xa1, 0x17, 0x2b,
+	0x55, 0x31, 0x9e, 0x7d, 0x65, 0x38, 0x52, 0xb6, 0x02, 0x5c, 0x91, 0x3f, 0x1f, 0x14, 0xd8, 0x8e,
+	0x62, 0xab, 0x35, 0x31, 0x91, 0xcd, 0x0c, 0x47, 0x4a, 0x3a, 0x80, 0x55, 0x09, 0xba, 0x0b, 0xd2,
+	0x2c, 0xc2, 0x78, 0x50, 0xd1, 0xdf, 0x31, 0x9a, 0xaa, 0x5e, 0x13, 0x93, 0xd9, 0xdd, 0xe1, 0x48,
+	0x11, 0x43, 0x6c, 0x78, 0xcd, 0x67, 0x93, 0x1f, 0xff, 0x3e, 0x17, 0xbb, 0xfd, 0x97, 0x38, 0x6c,
+	0xcf, 0xfe, 0xed, 0x83, 0x0a, 0xf0, 0x83, 0xba, 0x56, 0xab, 0xd7, 0x1a, 0xc5, 0x7b, 0x46, 0x43,
+	0x2f, 0xea, 0xef, 0x35, 0xe6, 0x36, 0xcc, 0xb6, 0x12, 0x80, 0xab, 0x76, 0x17, 0xbd, 0x0d, 0xb9,
+	0x79, 0x7c, 0x59, 0xad, 0xd7, 0x1a, 0x15, 0xdd, 0xa8, 0xab, 0x5a, 0xa5, 0x56, 0x16, 0x85, 0xec,
+	0x8d, 0xe1, 0x48, 0xd9, 0x09, 0x28, 0xb3, 0xb7, 0xc3, 0xcf, 0xe0, 0xb5, 0x79, 0x72, 0xb3, 0xa6,
+	0x57, 0xaa, 0xbf, 0x0c, 0xb9, 0xf1, 0xec, 0xf5, 0xe1, 0x48, 0x41, 0x01, 0x37, 0x7a, 0xd4, 0xd0,
+	0x1d, 0xb8, 0x3e, 0x4f, 0xad, 0x17, 0x1b, 0x0d, 0xb5, 0x2c, 0x26, 0xb2, 0xe2, 0x70, 0xa4, 0x64,
+	0x02, 0x4e, 0xdd, 0xf4, 0x3c, 0x6c, 0xa1, 0x37, 0x40, 0x9a, 0x47, 0x6b, 0xea, 0xbb, 0xea, 0x91,
+	0xae, 0x96, 0xc5, 0x64, 0x16, 0x0d, 0x47, 0xca, 0x76, 0x80, 0xd7, 0xf0, 0x6f, 0x70, 0x9b, 0xe2,
+	0xa5, 0xfa, 0xc7, 0xc5, 0xca, 0x3d, 0xb5, 0x2c, 0xa6, 0xa2, 0xfa, 0xc7, 0xa6, 0xdd, 0xc5, 0x56,
+	0x90, 0xce, 0x52, 0xf5, 0xd9, 0xd7, 0xb9, 0xd8, 0x97, 0x5f, 0xe7, 0x62, 0x8f, 0x9f, 0xe7, 0x62,
+	0xcf, 0x9e, 0xe7, 0x84, 0xcf, 0x9f, 0xe7, 0x84, 0x7f, 0x3c, 0xcf, 0x09, 0x9f, 0xbc, 0xc8, 0xc5,
+	0x3e, 0x7f, 0x91, 0x8b, 0x7d, 0xf9, 0x22, 0x17, 0x7b, 0xff, 0xdb, 0x2f, 0xab, 0x33, 0xf6, 0x5f,
+	0x4d, 0xac, 0x81, 0x5b, 0x6b, 0x6c, 0x4e, 0xfd, 0xf4, 0x7f, 0x03, 0x00, 0x8e, 0xa6, 0x4c, 0xef,
+	0x85, 0x12, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -625,6 +754,41 @@ func (this *TextProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MessagesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessagesProposal)
+	if !ok {
+		that2, ok := that.(MessagesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *Proposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -676,6 +840,9 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -711,6 +878,42 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TallyShares) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TallyShares)
+	if !ok {
+		that2, ok := that.(TallyShares)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Yes.Equal(that1.Yes) {
+		return false
+	}
+	if !this.Abstain.Equal(that1.Abstain) {
+		return false
+	}
+	if !this.No.Equal(that1.No) {
+		return false
+	}
+	if !this.NoWithVeto.Equal(that1.NoWithVeto) {
+		return false
+	}
+	if !this.Total.Equal(that1.Total) {
+		return false
+	}
+	return true
+}
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -786,6 +989,57 @@ func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessagesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -855,6 +1109,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -999,6 +1263,79 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TallyShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NoWithVeto.Size()
+		i -= size
+		if _, err := m.NoWithVeto.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.No.Size()
+		i -= size
+		if _, err := m.No.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Abstain.Size()
+		i -= size
+		if _, err := m.Abstain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Yes.Size()
+		i -= size
+		if _, err := m.Yes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1073,17 +1410,71 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
-	if err7 != nil {
-		return 0, err7
+	if m.BurnToCommunityPool {
+		i--
+		if m.BurnToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i -= n7
-	i = encodeVarintGov(dAtA, i, uint64(n7))
 	i--
-	dAtA[i] = 0x12
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	dAtA[i] = 0x32
+	if m.BurnVoteVeto {
+		i--
+		if m.BurnVoteVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGov(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -1118,13 +1509,21 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
 	if err8 != nil {
 		return 0, err8
 	}
 	i -= n8
 	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1149,6 +1548,16 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExpeditedThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.VetoThreshold.Size()
 		i -= size
@@ -1224,6 +1633,29 @@ func (m *TextProposal) Size() (n int) {
 	return n
 }
 
+func (m *MessagesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1278,6 +1710,9 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1298,6 +1733,25 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *TallyShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Yes.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Abstain.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.No.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.NoWithVeto.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1337,6 +1791,23 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnVoteVeto {
+		n += 2
+	}
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.BurnToCommunityPool {
+		n += 2
+	}
 	return n
 }
 
@@ -1348,6 +1819,8 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1363,6 +1836,8 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.VetoThreshold.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1589,6 +2064,154 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MessagesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Deposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1698,7 +2321,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
+			m.Amount = append(m.Amount, types1.Coin{})
 			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1802,7 +2425,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Content == nil {
-				m.Content = &types1.Any{}
+				m.Content = &types.Any{}
 			}
 			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1955,7 +2578,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
 			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2026,6 +2649,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2233,7 +2876,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *TallyShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2256,15 +2899,235 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
 			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
@@ -2445,7 +3308,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
 			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2483,6 +3346,133 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types1.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteQuorum = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteVeto = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnToCommunityPool = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2566,6 +3556,39 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2715,6 +3738,39 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
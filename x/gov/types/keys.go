@@ -37,6 +37,10 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<proposalID_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: TallyShares
+//
+// - 0x31<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: sdk.DecProto
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -46,6 +50,9 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	TallySharesKeyPrefix = []byte{0x30}
+	VoterSharesKeyPrefix = []byte{0x31}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -107,6 +114,30 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// TallySharesKey gets the first part of the running tally key based on the
+// proposalID
+func TallySharesKey(proposalID uint64) []byte {
+	return append(TallySharesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorTallySharesKey key of the running tally of the voters delegating
+// to a validator
+func ValidatorTallySharesKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(TallySharesKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// VoterSharesKey gets the first part of the key of the delegation shares of a
+// voter counted in the running tally of a proposal
+func VoterSharesKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(append(VoterSharesKeyPrefix, GetProposalIDBytes(proposalID)...), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// VoterDelegationSharesKey key of the shares of a delegation of a voter
+// counted in the running tally of a proposal
+func VoterDelegationSharesKey(proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(VoterSharesKey(proposalID, voterAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -136,6 +167,27 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitValidatorTallySharesKey split the running tally key and returns the
+// proposal id and validator address
+func SplitValidatorTallySharesKey(key []byte) (proposalID uint64, valAddr sdk.ValAddress) {
+	proposalID, addr := splitKeyWithAddress(key)
+	return proposalID, sdk.ValAddress(addr)
+}
+
+// SplitVoterDelegationSharesKey split the voter delegation shares key and
+// returns the proposal id, voter address and validator address
+func SplitVoterDelegationSharesKey(key []byte) (proposalID uint64, voterAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// <prefix (1 Byte)><proposalID (8 bytes)><voterAddrLen (1 Byte)><voterAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 10)
+	proposalID = GetProposalIDFromBytes(key[1:9])
+	voterAddrLen := int(key[9])
+	kv.AssertKeyAtLeastLength(key, 11+voterAddrLen)
+	voterAddr = sdk.AccAddress(key[10 : 10+voterAddrLen])
+	kv.AssertKeyAtLeastLength(key, 12+voterAddrLen)
+	valAddr = sdk.ValAddress(key[11+voterAddrLen:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
@@ -59,3 +59,25 @@ func TestVoteKeys(t *testing.T) {
 	require.Equal(t, int(proposalID), 2)
 	require.Equal(t, addr, voterAddr)
 }
+
+func TestTallySharesKeys(t *testing.T) {
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	key := TallySharesKey(2)
+	proposalID := SplitProposalKey(key)
+	require.Equal(t, int(proposalID), 2)
+
+	key = ValidatorTallySharesKey(2, valAddr)
+	proposalID, validatorAddr := SplitValidatorTallySharesKey(key)
+	require.Equal(t, int(proposalID), 2)
+	require.Equal(t, valAddr, validatorAddr)
+
+	key = VoterDelegationSharesKey(2, addr, valAddr)
+	proposalID, voterAddr, validatorAddr := SplitVoterDelegationSharesKey(key)
+	require.Equal(t, int(proposalID), 2)
+	require.Equal(t, addr, voterAddr)
+	require.Equal(t, valAddr, validatorAddr)
+
+	// invalid key
+	require.Panics(t, func() { SplitVoterDelegationSharesKey(VoterSharesKey(2, addr)) })
+}
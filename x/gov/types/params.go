@@ -12,15 +12,19 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultExpeditedMinDepositTokens = DefaultMinDepositTokens.MulRaw(5)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultBurnRatio                 = sdk.OneDec()
 )
 
 // Parameter store key
@@ -40,10 +44,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins,
+	burnVoteQuorum, burnVoteVeto bool, burnRatio sdk.Dec, burnToCommunityPool bool,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
+		BurnVoteQuorum:      burnVoteQuorum,
+		BurnVoteVeto:        burnVoteVeto,
+		BurnRatio:           burnRatio,
+		BurnToCommunityPool: burnToCommunityPool,
 	}
 }
 
@@ -52,6 +64,11 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
+		true,
+		true,
+		DefaultBurnRatio,
+		false,
 	)
 }
 
@@ -63,7 +80,10 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit) && dp.BurnVoteQuorum == dp2.BurnVoteQuorum &&
+		dp.BurnVoteVeto == dp2.BurnVoteVeto && dp.BurnRatio.Equal(dp2.BurnRatio) &&
+		dp.BurnToCommunityPool == dp2.BurnToCommunityPool
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,27 +98,41 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !v.ExpeditedMinDeposit.IsAllGTE(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit %s must not be lower than the minimum deposit %s", v.ExpeditedMinDeposit, v.MinDeposit)
+	}
+	if v.BurnRatio.IsNil() || v.BurnRatio.IsNegative() {
+		return fmt.Errorf("burn ratio must not be negative: %s", v.BurnRatio)
+	}
+	if v.BurnRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn ratio too large: %s", v.BurnRatio)
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum,
-		Threshold:     threshold,
-		VetoThreshold: vetoThreshold,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		VetoThreshold:      vetoThreshold,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold)
 }
 
 // String implements stringer insterface
@@ -131,25 +165,32 @@ func validateTallyParams(i interface{}) error {
 	if v.VetoThreshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedThreshold.IsNil() || v.ExpeditedThreshold.LT(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must not be lower than the vote threshold: %s", v)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
 
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 // String implements stringer interface
@@ -167,6 +208,12 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod > v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must not be longer than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
 
 	return nil
 }
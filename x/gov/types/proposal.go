@@ -11,13 +11,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // DefaultStartingProposalID is 1
 const DefaultStartingProposalID uint64 = 1
 
 // NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time, expedited bool) (Proposal, error) {
 	msg, ok := content.(proto.Message)
 	if !ok {
 		return Proposal{}, fmt.Errorf("%T does not implement proto.Message", content)
@@ -36,6 +37,7 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 		TotalDeposit:     sdk.NewCoins(),
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		Expedited:        expedited,
 	}
 
 	return p, nil
@@ -180,7 +182,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText     string = "Text"
+	ProposalTypeMessages string = "Messages"
 )
 
 // Implements Content Interface
@@ -212,8 +215,79 @@ func (tp TextProposal) String() string {
 	return string(out)
 }
 
+// Implements Content Interface
+var (
+	_ Content                       = &MessagesProposal{}
+	_ types.UnpackInterfacesMessage = &MessagesProposal{}
+)
+
+// NewMessagesProposal creates a messages proposal Content executing msgs once
+// it passes.
+func NewMessagesProposal(title, description string, msgs []sdk.Msg) (*MessagesProposal, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessagesProposal{Title: title, Description: description, Messages: anys}, nil
+}
+
+// GetTitle returns the proposal title
+func (mp *MessagesProposal) GetTitle() string { return mp.Title }
+
+// GetDescription returns the proposal description
+func (mp *MessagesProposal) GetDescription() string { return mp.Description }
+
+// ProposalRoute returns the proposal router key
+func (mp *MessagesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "Messages"
+func (mp *MessagesProposal) ProposalType() string { return ProposalTypeMessages }
+
+// GetMsgs unpacks the messages of the proposal.
+func (mp *MessagesProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(mp.Messages, "proposal")
+}
+
+// ValidateBasic validates the content's title and description of the proposal
+// along with each of its messages.
+func (mp *MessagesProposal) ValidateBasic() error {
+	if err := ValidateAbstract(mp); err != nil {
+		return err
+	}
+
+	if len(mp.Messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal must contain at least one message")
+	}
+
+	msgs, err := mp.GetMsgs()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, err.Error())
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "message %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (mp MessagesProposal) String() string {
+	out, _ := yaml.Marshal(mp)
+	return string(out)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (mp MessagesProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, mp.Messages)
+}
+
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:     {},
+	ProposalTypeMessages: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
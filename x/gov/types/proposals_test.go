@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +25,34 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestMessagesProposalValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("foo_________________")
+	validMsg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1)))
+	invalidMsg := banktypes.NewMsgSend(addr, addr, sdk.Coins{})
+
+	tests := []struct {
+		name   string
+		title  string
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"valid", "title", []sdk.Msg{validMsg}, false},
+		{"blank title", "", []sdk.Msg{validMsg}, true},
+		{"no messages", "title", nil, true},
+		{"invalid message", "title", []sdk.Msg{validMsg, invalidMsg}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := NewMessagesProposal(tt.title, "description", tt.msgs)
+			require.NoError(t, err)
+
+			err = content.ValidateBasic()
+			if tt.expErr {
+				require.ErrorIs(t, err, ErrInvalidProposalContent)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,11 +1,53 @@
 package types
 
 import (
+	"fmt"
+
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// TallyHandler tallies the votes cast on a proposal. A handler can be set for
+// each proposal type on the gov keeper, the proposals of the other types being
+// tallied by the stake-weighted default handler.
+type TallyHandler interface {
+	// Tally returns whether the proposal passes, whether its deposits must be
+	// burned, and the result of the votes.
+	Tally(ctx sdk.Context, proposal Proposal, votes Votes, params Params) (passes bool, burnDeposits bool, tallyResults TallyResult)
+}
+
+// TallyOutcome applies the quorum, veto and threshold rules of the tally
+// params to the voting power cast on each option, and the burn rules of the
+// deposit params to the failed proposals. turnout is the fraction of the
+// bonded stake that voted and totalVotingPower the sum of results.
+func TallyOutcome(params Params, turnout, totalVotingPower sdk.Dec, results map[VoteOption]sdk.Dec) (passes bool, burnDeposits bool) {
+	tallyParams := params.TallyParams
+
+	// If there is not enough quorum of votes, the proposal fails
+	if turnout.LT(tallyParams.Quorum) {
+		return false, params.DepositParams.BurnVoteQuorum
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
+		return false, params.DepositParams.BurnVoteVeto
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false
+}
+
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
 	Address             sdk.ValAddress      // address of the validator operator
@@ -38,6 +80,17 @@ func NewTallyResult(yes, abstain, no, noWithVeto sdk.Int) TallyResult {
 	}
 }
 
+// NewEmptyTallyResultsMap returns an Option -> Dec map with a zero entry for
+// every valid vote option.
+func NewEmptyTallyResultsMap() map[VoteOption]sdk.Dec {
+	return map[VoteOption]sdk.Dec{
+		OptionYes:        sdk.ZeroDec(),
+		OptionAbstain:    sdk.ZeroDec(),
+		OptionNo:         sdk.ZeroDec(),
+		OptionNoWithVeto: sdk.ZeroDec(),
+	}
+}
+
 // NewTallyResultFromMap creates a new TallyResult instance from a Option -> Dec map
 func NewTallyResultFromMap(results map[VoteOption]sdk.Dec) TallyResult {
 	return NewTallyResult(
@@ -66,3 +119,77 @@ func (tr TallyResult) String() string {
 	out, _ := yaml.Marshal(tr)
 	return string(out)
 }
+
+// NewEmptyTallyShares returns a TallyShares with no shares.
+func NewEmptyTallyShares() TallyShares {
+	return TallyShares{
+		Yes:        sdk.ZeroDec(),
+		Abstain:    sdk.ZeroDec(),
+		No:         sdk.ZeroDec(),
+		NoWithVeto: sdk.ZeroDec(),
+		Total:      sdk.ZeroDec(),
+	}
+}
+
+// Add returns ts with the delegation shares of a voter added, split according
+// to its vote options.
+func (ts TallyShares) Add(shares sdk.Dec, options WeightedVoteOptions) TallyShares {
+	for _, option := range options {
+		ts.setOption(option.Option, ts.getOption(option.Option).Add(shares.Mul(option.Weight)))
+	}
+	ts.Total = ts.Total.Add(shares)
+
+	return ts
+}
+
+// Sub returns ts with the delegation shares of a voter removed, split
+// according to its vote options. It is the exact inverse of Add.
+func (ts TallyShares) Sub(shares sdk.Dec, options WeightedVoteOptions) TallyShares {
+	for _, option := range options {
+		ts.setOption(option.Option, ts.getOption(option.Option).Sub(shares.Mul(option.Weight)))
+	}
+	ts.Total = ts.Total.Sub(shares)
+
+	return ts
+}
+
+// IsZero returns true if ts holds no shares.
+func (ts TallyShares) IsZero() bool {
+	return ts.Yes.IsZero() && ts.Abstain.IsZero() && ts.No.IsZero() && ts.NoWithVeto.IsZero() && ts.Total.IsZero()
+}
+
+// String implements stringer interface
+func (ts TallyShares) String() string {
+	out, _ := yaml.Marshal(ts)
+	return string(out)
+}
+
+func (ts TallyShares) getOption(option VoteOption) sdk.Dec {
+	switch option {
+	case OptionYes:
+		return ts.Yes
+	case OptionAbstain:
+		return ts.Abstain
+	case OptionNo:
+		return ts.No
+	case OptionNoWithVeto:
+		return ts.NoWithVeto
+	default:
+		panic(fmt.Sprintf("invalid vote option %s", option))
+	}
+}
+
+func (ts *TallyShares) setOption(option VoteOption, shares sdk.Dec) {
+	switch option {
+	case OptionYes:
+		ts.Yes = shares
+	case OptionAbstain:
+		ts.Abstain = shares
+	case OptionNo:
+		ts.No = shares
+	case OptionNoWithVeto:
+		ts.NoWithVeto = shares
+	default:
+		panic(fmt.Sprintf("invalid vote option %s", option))
+	}
+}
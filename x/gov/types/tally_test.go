@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTallyOutcomeBurnDeposits(t *testing.T) {
+	vetoed := map[VoteOption]sdk.Dec{
+		OptionYes:        sdk.NewDec(1),
+		OptionAbstain:    sdk.ZeroDec(),
+		OptionNo:         sdk.ZeroDec(),
+		OptionNoWithVeto: sdk.NewDec(1),
+	}
+
+	testCases := []struct {
+		name           string
+		burnVoteQuorum bool
+		burnVoteVeto   bool
+		turnout        sdk.Dec
+		expBurn        bool
+	}{
+		{"no quorum, burn", true, false, sdk.NewDecWithPrec(1, 1), true},
+		{"no quorum, no burn", false, true, sdk.NewDecWithPrec(1, 1), false},
+		{"vetoed, burn", false, true, sdk.OneDec(), true},
+		{"vetoed, no burn", true, false, sdk.OneDec(), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.DepositParams.BurnVoteQuorum = tc.burnVoteQuorum
+			params.DepositParams.BurnVoteVeto = tc.burnVoteVeto
+
+			passes, burnDeposits := TallyOutcome(params, tc.turnout, sdk.NewDec(2), vetoed)
+			require.False(t, passes)
+			require.Equal(t, tc.expBurn, burnDeposits)
+		})
+	}
+}
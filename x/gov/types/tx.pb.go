@@ -38,6 +38,8 @@ type MsgSubmitProposal struct {
 	Content        *types.Any                               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
 	Proposer       string                                   `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expedited defines if the proposal is expedited.
+	Expedited bool `protobuf:"varint,4,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()      { *m = MsgSubmitProposal{} }
@@ -363,48 +365,49 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/tx.proto", fileDescriptor_3c053992595e3dce) }
 
 var fileDescriptor_3c053992595e3dce = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x93, 0xd2, 0xd0, 0x17, 0xd4, 0x52, 0x2b, 0x12, 0x49, 0x8a, 0xec, 0x28, 0x88, 0x2a,
-	0x12, 0x8a, 0xdd, 0x06, 0xd4, 0x01, 0xa6, 0xba, 0x08, 0xc1, 0x10, 0x01, 0xae, 0x04, 0x12, 0x4b,
-	0x49, 0xe2, 0xeb, 0xd5, 0xa2, 0xf1, 0xb3, 0x72, 0x97, 0xa8, 0xdd, 0x18, 0x99, 0x10, 0x23, 0x63,
-	0x67, 0xe6, 0xb2, 0xf1, 0x07, 0x54, 0x4c, 0x15, 0x13, 0x03, 0x2a, 0xa8, 0x5d, 0x10, 0x82, 0xff,
-	0x01, 0xc5, 0x77, 0xe7, 0x42, 0xeb, 0xa6, 0xe5, 0xc7, 0x94, 0xdc, 0x7d, 0xdf, 0xf7, 0xde, 0xfb,
-	0xde, 0xbd, 0x3b, 0xc3, 0x4c, 0x07, 0x59, 0x17, 0x99, 0x43, 0x71, 0xe0, 0x0c, 0xe6, 0xdb, 0x84,
-	0xb7, 0xe6, 0x1d, 0xbe, 0x61, 0x47, 0x3d, 0xe4, 0x68, 0x18, 0x02, 0xb4, 0x29, 0x0e, 0x6c, 0x09,
-	0x96, 0x4d, 0x29, 0x68, 0xb7, 0x18, 0x49, 0x14, 0x1d, 0x0c, 0x42, 0xa1, 0x29, 0x5f, 0x4e, 0x09,
-	0x38, 0xd4, 0x0b, 0xb4, 0x24, 0xd0, 0x95, 0x78, 0xe5, 0xc8, 0xf0, 0x02, 0x2a, 0x50, 0xa4, 0x28,
-	0xf6, 0x87, 0xff, 0x94, 0x80, 0x22, 0xd2, 0x75, 0xe2, 0xc4, 0xab, 0x76, 0x7f, 0xd5, 0x69, 0x85,
-	0x9b, 0x02, 0xaa, 0xbe, 0xcc, 0xc0, 0x74, 0x93, 0xd1, 0xe5, 0x7e, 0xbb, 0x1b, 0xf0, 0x07, 0x3d,
-	0x8c, 0x90, 0xb5, 0xd6, 0x8d, 0x5b, 0x90, 0xeb, 0x60, 0xc8, 0x49, 0xc8, 0x8b, 0x7a, 0x45, 0xaf,
-	0xe5, 0x1b, 0x05, 0x5b, 0x84, 0xb0, 0x55, 0x08, 0x7b, 0x31, 0xdc, 0x74, 0xf3, 0xef, 0xb7, 0xeb,
-	0xb9, 0x25, 0x41, 0xf4, 0x94, 0xc2, 0xe0, 0x30, 0x15, 0x84, 0x01, 0x0f, 0x5a, 0xeb, 0x2b, 0x3e,
-	0x89, 0x90, 0x05, 0xbc, 0x98, 0xa9, 0x64, 0x6b, 0xf9, 0x46, 0xc9, 0x96, 0xb5, 0x0e, 0x6d, 0xab,
-	0x5e, 0xd8, 0x4b, 0x18, 0x84, 0xee, 0xdc, 0xce, 0x9e, 0xa5, 0xbd, 0xf9, 0x6c, 0xd5, 0x68, 0xc0,
-	0xd7, 0xfa, 0x6d, 0xbb, 0x83, 0x5d, 0x69, 0x4c, 0xfe, 0xd4, 0x99, 0xff, 0xcc, 0xe1, 0x9b, 0x11,
-	0x61, 0xb1, 0x80, 0x79, 0x93, 0x32, 0xc7, 0x6d, 0x91, 0xc2, 0xb8, 0x01, 0xe7, 0xa3, 0xb8, 0x7c,
-	0xd2, 0x2b, 0x66, 0x2b, 0x7a, 0x6d, 0xc2, 0x2d, 0x7e, 0xd8, 0xae, 0x17, 0x64, 0xc6, 0x45, 0xdf,
-	0xef, 0x11, 0xc6, 0x96, 0x79, 0x2f, 0x08, 0xa9, 0x97, 0x30, 0x6f, 0x5e, 0x7c, 0xb1, 0x65, 0x69,
-	0xaf, 0xb7, 0x2c, 0xed, 0xeb, 0x96, 0xa5, 0x3d, 0xff, 0x54, 0xd1, 0xaa, 0x4d, 0x28, 0x1d, 0xeb,
-	0x87, 0x47, 0x58, 0x84, 0x21, 0x23, 0xc6, 0x1c, 0xe4, 0x23, 0xb9, 0xb7, 0x12, 0xf8, 0x71, 0x6f,
-	0xc6, 0xdc, 0xa9, 0x6f, 0x7b, 0xd6, 0xaf, 0xdb, 0x1e, 0xa8, 0xc5, 0x3d, 0xbf, 0xfa, 0x56, 0x87,
-	0x5c, 0x93, 0xd1, 0x47, 0xc8, 0xff, 0x42, 0x6d, 0xd8, 0x70, 0x6e, 0x80, 0x9c, 0xf4, 0x8a, 0x99,
-	0x53, 0x1c, 0x09, 0x9a, 0xb1, 0x00, 0xe3, 0x18, 0xf1, 0x00, 0xc3, 0xb8, 0x05, 0x93, 0x0d, 0xd3,
-	0x3e, 0x3e, 0x7c, 0xf6, 0xb0, 0x96, 0xfb, 0x31, 0xcb, 0x93, 0xec, 0x94, 0x36, 0x4c, 0xc3, 0x94,
-	0x2c, 0x5b, 0x99, 0xaf, 0xbe, 0xd3, 0x93, 0xbd, 0xc7, 0x24, 0xa0, 0x6b, 0x9c, 0xf8, 0x86, 0x95,
-	0x62, 0xe9, 0x9f, 0x1c, 0xdc, 0x81, 0x9c, 0xa8, 0x89, 0x15, 0xb3, 0xf1, 0xd0, 0xcc, 0xa6, 0x59,
-	0x50, 0xf9, 0x0f, 0xad, 0xb8, 0x63, 0xc3, 0x09, 0xf2, 0x94, 0x38, 0xc5, 0x51, 0x09, 0x2e, 0x1d,
-	0xa9, 0x3e, 0x71, 0xf6, 0x43, 0x07, 0x68, 0x32, 0xaa, 0x46, 0xe9, 0xcf, 0xcf, 0x69, 0x01, 0x26,
-	0xe4, 0xa8, 0xe3, 0xe9, 0x4e, 0x0f, 0xa9, 0x46, 0x07, 0xc6, 0x5b, 0x5d, 0xec, 0x87, 0x5c, 0x9a,
-	0xfd, 0xaf, 0x37, 0x44, 0x86, 0x4e, 0x69, 0x45, 0x01, 0x8c, 0x43, 0xbb, 0xaa, 0x0b, 0x8d, 0xef,
-	0x19, 0xc8, 0x36, 0x19, 0x35, 0x56, 0x61, 0xf2, 0xc8, 0x73, 0x70, 0x35, 0xed, 0x0c, 0x8e, 0xdd,
-	0x92, 0x72, 0xfd, 0x4c, 0xb4, 0xe4, 0x32, 0xdd, 0x85, 0xb1, 0xf8, 0x5a, 0xcc, 0x9c, 0x20, 0x1b,
-	0x82, 0xe5, 0x2b, 0x23, 0xc0, 0x24, 0xd2, 0x53, 0xb8, 0xf0, 0xdb, 0x54, 0x8e, 0x12, 0x29, 0x52,
-	0xf9, 0xda, 0x19, 0x48, 0x49, 0x86, 0x87, 0x90, 0x53, 0xd3, 0x61, 0x9e, 0xa0, 0x93, 0x78, 0x79,
-	0x76, 0x34, 0xae, 0x42, 0xba, 0xee, 0xce, 0xbe, 0xa9, 0xef, 0xee, 0x9b, 0xfa, 0x97, 0x7d, 0x53,
-	0x7f, 0x75, 0x60, 0x6a, 0xbb, 0x07, 0xa6, 0xf6, 0xf1, 0xc0, 0xd4, 0x9e, 0x8c, 0x3e, 0xe2, 0x8d,
-	0xf8, 0xab, 0x10, 0x1f, 0x74, 0x7b, 0x3c, 0x7e, 0x8e, 0xaf, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x8a, 0xac, 0xbf, 0x61, 0x81, 0x06, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x6f, 0x13, 0x4b,
+	0x10, 0xbe, 0xb3, 0xfd, 0xe2, 0x64, 0xfc, 0x94, 0xbc, 0xac, 0x2c, 0xbd, 0xb3, 0x13, 0xdd, 0x59,
+	0x46, 0x44, 0x96, 0x90, 0xef, 0x12, 0x83, 0x52, 0x40, 0x95, 0x0b, 0x42, 0x50, 0x58, 0xc0, 0x45,
+	0x02, 0x89, 0x26, 0xd8, 0xbe, 0xcd, 0x66, 0x45, 0x7c, 0x7b, 0xf2, 0xae, 0xad, 0xa4, 0xa3, 0xa4,
+	0xa4, 0xa4, 0x4c, 0x8d, 0x28, 0x43, 0xc7, 0x1f, 0x10, 0x51, 0x45, 0x54, 0x14, 0x28, 0xa0, 0xa4,
+	0x41, 0x08, 0xfe, 0x07, 0xe4, 0xbb, 0xdd, 0x33, 0x24, 0x17, 0x27, 0xfc, 0xa8, 0xec, 0x9d, 0x6f,
+	0xbe, 0x99, 0xf9, 0x66, 0x67, 0xf6, 0x60, 0xae, 0xc3, 0x78, 0x97, 0x71, 0x87, 0xb0, 0x81, 0x33,
+	0x58, 0x6a, 0x63, 0xd1, 0x5a, 0x72, 0xc4, 0xb6, 0x1d, 0xf6, 0x98, 0x60, 0x08, 0xc5, 0xa0, 0x4d,
+	0xd8, 0xc0, 0x96, 0x60, 0xd9, 0x94, 0x84, 0x76, 0x8b, 0xe3, 0x84, 0xd1, 0x61, 0x34, 0x88, 0x39,
+	0xe5, 0xf9, 0x94, 0x80, 0x43, 0x7e, 0x8c, 0x96, 0x62, 0x74, 0x3d, 0x3a, 0x39, 0x32, 0x7c, 0x0c,
+	0x15, 0x09, 0x23, 0x2c, 0xb6, 0x0f, 0xff, 0x29, 0x02, 0x61, 0x8c, 0x6c, 0x61, 0x27, 0x3a, 0xb5,
+	0xfb, 0x1b, 0x4e, 0x2b, 0xd8, 0x89, 0xa1, 0xea, 0xab, 0x0c, 0xcc, 0x36, 0x39, 0x59, 0xeb, 0xb7,
+	0xbb, 0x54, 0xdc, 0xeb, 0xb1, 0x90, 0xf1, 0xd6, 0x16, 0xba, 0x01, 0xf9, 0x0e, 0x0b, 0x04, 0x0e,
+	0x84, 0xa1, 0x57, 0xf4, 0x5a, 0xa1, 0x51, 0xb4, 0xe3, 0x10, 0xb6, 0x0a, 0x61, 0xaf, 0x04, 0x3b,
+	0x6e, 0xe1, 0xed, 0x5e, 0x3d, 0xbf, 0x1a, 0x3b, 0x7a, 0x8a, 0x81, 0x04, 0xcc, 0xd0, 0x80, 0x0a,
+	0xda, 0xda, 0x5a, 0xf7, 0x71, 0xc8, 0x38, 0x15, 0x46, 0xa6, 0x92, 0xad, 0x15, 0x1a, 0x25, 0x5b,
+	0xd6, 0x3a, 0x94, 0xad, 0x7a, 0x61, 0xaf, 0x32, 0x1a, 0xb8, 0x8b, 0xfb, 0x87, 0x96, 0xf6, 0xf2,
+	0xa3, 0x55, 0x23, 0x54, 0x6c, 0xf6, 0xdb, 0x76, 0x87, 0x75, 0xa5, 0x30, 0xf9, 0x53, 0xe7, 0xfe,
+	0x13, 0x47, 0xec, 0x84, 0x98, 0x47, 0x04, 0xee, 0x4d, 0xcb, 0x1c, 0x37, 0xe3, 0x14, 0xe8, 0x1a,
+	0x4c, 0x86, 0x51, 0xf9, 0xb8, 0x67, 0x64, 0x2b, 0x7a, 0x6d, 0xca, 0x35, 0xde, 0xed, 0xd5, 0x8b,
+	0x32, 0xe3, 0x8a, 0xef, 0xf7, 0x30, 0xe7, 0x6b, 0xa2, 0x47, 0x03, 0xe2, 0x25, 0x9e, 0x68, 0x1e,
+	0xa6, 0xf0, 0x76, 0x88, 0x7d, 0x2a, 0xb0, 0x6f, 0xe4, 0x2a, 0x7a, 0x6d, 0xd2, 0x1b, 0x19, 0xae,
+	0xff, 0xf7, 0x6c, 0xd7, 0xd2, 0x5e, 0xec, 0x5a, 0xda, 0xe7, 0x5d, 0x4b, 0x7b, 0xfa, 0xa1, 0xa2,
+	0x55, 0x9b, 0x50, 0x3a, 0xd5, 0x2d, 0x0f, 0xf3, 0x90, 0x05, 0x1c, 0xa3, 0x45, 0x28, 0x84, 0xd2,
+	0xb6, 0x4e, 0xfd, 0xa8, 0x73, 0x39, 0x77, 0xe6, 0xcb, 0xa1, 0xf5, 0xa3, 0xd9, 0x03, 0x75, 0xb8,
+	0xe3, 0x57, 0x5f, 0xeb, 0x90, 0x6f, 0x72, 0xf2, 0x80, 0x89, 0xdf, 0x60, 0x23, 0x1b, 0xfe, 0x19,
+	0x30, 0x81, 0x7b, 0x46, 0xe6, 0x1c, 0xbd, 0xb1, 0x1b, 0x5a, 0x86, 0x09, 0x16, 0x0a, 0xca, 0x82,
+	0xa8, 0x41, 0xd3, 0x0d, 0xd3, 0x3e, 0x3d, 0x9a, 0xf6, 0xb0, 0x96, 0xbb, 0x91, 0x97, 0x27, 0xbd,
+	0x53, 0xda, 0x30, 0x0b, 0x33, 0xb2, 0x6c, 0x25, 0xbe, 0xfa, 0x46, 0x4f, 0x6c, 0x0f, 0x31, 0x25,
+	0x9b, 0x02, 0xfb, 0xc8, 0x4a, 0x91, 0xf4, 0x47, 0x0a, 0x6e, 0x41, 0x3e, 0xae, 0x89, 0x1b, 0xd9,
+	0x68, 0xa4, 0x16, 0xd2, 0x24, 0xa8, 0xfc, 0x23, 0x29, 0x6e, 0x6e, 0x38, 0x5f, 0x9e, 0x22, 0xa7,
+	0x28, 0x2a, 0xc1, 0xff, 0x27, 0xaa, 0x4f, 0x94, 0x7d, 0xd3, 0x01, 0x9a, 0x9c, 0xa8, 0x41, 0xfb,
+	0xf5, 0x7b, 0x5a, 0x86, 0x29, 0xb9, 0x08, 0xec, 0x7c, 0xa5, 0x23, 0x57, 0xd4, 0x81, 0x89, 0x56,
+	0x97, 0xf5, 0x03, 0x61, 0x64, 0xff, 0xfe, 0xfe, 0xc8, 0xd0, 0x29, 0xad, 0x28, 0x02, 0x1a, 0xc9,
+	0x55, 0x5d, 0x68, 0x7c, 0xcd, 0x40, 0xb6, 0xc9, 0x09, 0xda, 0x80, 0xe9, 0x13, 0x8f, 0xc5, 0xe5,
+	0xb4, 0x3b, 0x38, 0xb5, 0x25, 0xe5, 0xfa, 0x85, 0xdc, 0x92, 0x65, 0xba, 0x0d, 0xb9, 0x68, 0x2d,
+	0xe6, 0xce, 0xa0, 0x0d, 0xc1, 0xf2, 0xa5, 0x31, 0x60, 0x12, 0xe9, 0x31, 0xfc, 0xfb, 0xd3, 0x54,
+	0x8e, 0x23, 0x29, 0xa7, 0xf2, 0x95, 0x0b, 0x38, 0x25, 0x19, 0xee, 0x43, 0x5e, 0x4d, 0x87, 0x79,
+	0x06, 0x4f, 0xe2, 0xe5, 0x85, 0xf1, 0xb8, 0x0a, 0xe9, 0xba, 0xfb, 0x47, 0xa6, 0x7e, 0x70, 0x64,
+	0xea, 0x9f, 0x8e, 0x4c, 0xfd, 0xf9, 0xb1, 0xa9, 0x1d, 0x1c, 0x9b, 0xda, 0xfb, 0x63, 0x53, 0x7b,
+	0x34, 0xfe, 0x8a, 0xb7, 0xa3, 0x6f, 0x46, 0x74, 0xd1, 0xed, 0x89, 0xe8, 0xb1, 0xbe, 0xfa, 0x7d,
+	0x00, 0x42, 0x78, 0xbf, 0x15, 0x9f, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -627,6 +630,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -929,6 +942,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1171,6 +1187,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,33 @@
+package group
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateGroupRequest{},
+		&MsgUpdateGroupMembersRequest{},
+		&MsgUpdateGroupAdminRequest{},
+		&MsgUpdateGroupMetadataRequest{},
+		&MsgCreateGroupAccountRequest{},
+		&MsgUpdateGroupAccountAdminRequest{},
+		&MsgUpdateGroupAccountDecisionPolicyRequest{},
+		&MsgUpdateGroupAccountMetadataRequest{},
+		&MsgCreateProposalRequest{},
+		&MsgVoteRequest{},
+		&MsgExecRequest{},
+	)
+
+	registry.RegisterInterface(
+		"cosmos.group.v1beta1.DecisionPolicy",
+		(*DecisionPolicy)(nil),
+		&ThresholdDecisionPolicy{},
+		&PercentageDecisionPolicy{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
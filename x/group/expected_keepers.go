@@ -0,0 +1,24 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	// NewAccount returns a new account with the next account number. Does not save the new account to the store.
+	NewAccount(sdk.Context, authtypes.AccountI) authtypes.AccountI
+
+	// GetAccount retrieves an account from the store.
+	GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI
+
+	// SetAccount sets an account in the store.
+	SetAccount(sdk.Context, authtypes.AccountI)
+}
+
+// BankKeeper defines the contract needed to be fulfilled for banking and supply
+// dependencies.
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
@@ -0,0 +1,95 @@
+package group
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state with default values.
+func NewGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (s GenesisState) Validate() error {
+	groups := make(map[uint64]GroupInfo)
+	groupAccounts := make(map[string]GroupAccountInfo)
+	groupMembers := make(map[uint64]GroupMember)
+	proposals := make(map[uint64]Proposal)
+
+	for _, g := range s.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "Group validation failed")
+		}
+		groups[g.GroupId] = *g
+	}
+
+	for _, g := range s.GroupAccounts {
+		// check that group with group account's GroupId exists
+		if _, exists := groups[g.GroupId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group account's group id %d", g.GroupId))
+		}
+
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "Group account validation failed")
+		}
+		groupAccounts[g.Address] = *g
+	}
+
+	for _, g := range s.GroupMembers {
+		// check that group with group member's GroupId exists
+		if _, exists := groups[g.GroupId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group member's group id %d", g.GroupId))
+		}
+
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "Group member validation failed")
+		}
+		groupMembers[g.GroupId] = *g
+	}
+
+	for _, p := range s.Proposals {
+		// check that group account with proposal address exists
+		if _, exists := groupAccounts[p.Address]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group account %s of proposal %d", p.Address, p.ProposalId))
+		}
+
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "Proposal validation failed")
+		}
+		proposals[p.ProposalId] = *p
+	}
+
+	for _, v := range s.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "Vote validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[v.ProposalId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal id %d of vote", v.ProposalId))
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, g := range s.GroupAccounts {
+		err := g.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	for _, p := range s.Proposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
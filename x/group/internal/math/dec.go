@@ -20,6 +20,15 @@ type Dec struct {
 	dec apd.Decimal
 }
 
+// dec128Context is the apd.Context used for operations that may not be exact,
+// such as division. It matches the precision of the IEEE 754 decimal128 format.
+var dec128Context = apd.Context{
+	Precision:   34,
+	MaxExponent: apd.MaxExponent,
+	MinExponent: apd.MinExponent,
+	Traps:       apd.DefaultTraps,
+}
+
 func NewDecFromString(s string) (Dec, error) {
 	d, _, err := apd.NewFromString(s)
 	if err != nil {
@@ -28,6 +37,28 @@ func NewDecFromString(s string) (Dec, error) {
 	return Dec{*d}, nil
 }
 
+func NewPositiveDecFromString(s string) (Dec, error) {
+	d, err := NewDecFromString(s)
+	if err != nil {
+		return Dec{}, errors.ErrInvalidDecString.Wrap(err.Error())
+	}
+	if !d.IsPositive() {
+		return Dec{}, errors.ErrInvalidDecString.Wrapf("expected a positive decimal, got %s", s)
+	}
+	return d, nil
+}
+
+func NewNonNegativeDecFromString(s string) (Dec, error) {
+	d, err := NewDecFromString(s)
+	if err != nil {
+		return Dec{}, errors.ErrInvalidDecString.Wrap(err.Error())
+	}
+	if d.IsNegative() {
+		return Dec{}, errors.ErrInvalidDecString.Wrapf("expected a non-negative decimal, got %s", s)
+	}
+	return d, nil
+}
+
 func NewDecFromInt64(x int64) Dec {
 	var res Dec
 	res.dec.SetInt64(x)
@@ -50,6 +81,26 @@ func (x Dec) Sub(y Dec) (Dec, error) {
 	return z, sdkerrors.Wrap(err, "decimal subtraction error")
 }
 
+// Quo returns a new Dec with value `x/y` (formatted as decimal128, 34 digit precision) without mutating any
+// argument and error if there is an overflow or a division by zero.
+func (x Dec) Quo(y Dec) (Dec, error) {
+	var z Dec
+	_, err := dec128Context.Quo(&z.dec, &x.dec, &y.dec)
+	return z, sdkerrors.Wrap(err, "decimal quotient error")
+}
+
+// Mul returns a new Dec with value `x*y` (formatted as decimal128, 34 digit precision) without mutating any
+// argument and error if there is an overflow.
+func (x Dec) Mul(y Dec) (Dec, error) {
+	var z Dec
+	_, err := dec128Context.Mul(&z.dec, &x.dec, &y.dec)
+	return z, sdkerrors.Wrap(err, "decimal multiplication error")
+}
+
+func (x Dec) String() string {
+	return x.dec.Text('f')
+}
+
 func (x Dec) Int64() (int64, error) {
 	return x.dec.Int64()
 }
@@ -66,6 +117,14 @@ func (x Dec) IsNegative() bool {
 	return x.dec.Negative && !x.dec.IsZero()
 }
 
+func (x Dec) IsZero() bool {
+	return x.dec.IsZero()
+}
+
+func (x Dec) IsPositive() bool {
+	return !x.dec.Negative && !x.dec.IsZero()
+}
+
 // Add adds x and y
 func Add(x Dec, y Dec) (Dec, error) {
 	return x.Add(y)
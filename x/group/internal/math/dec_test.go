@@ -75,6 +75,21 @@ func TestDec(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, res.IsEqual(minusFivePointZero))
 
+	res, err = four.Quo(two)
+	require.NoError(t, err)
+	require.True(t, res.IsEqual(two))
+
+	res, err = two.Mul(two)
+	require.NoError(t, err)
+	require.True(t, res.IsEqual(four))
+
+	res, err = one.Quo(three)
+	require.NoError(t, err)
+	require.Equal(t, "0.3333333333333333333333333333333333", res.String())
+
+	_, err = one.Quo(zero)
+	require.Error(t, err)
+
 	require.False(t, zero.IsNegative())
 	require.False(t, one.IsNegative())
 	require.True(t, minusOne.IsNegative())
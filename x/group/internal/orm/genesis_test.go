@@ -0,0 +1,90 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestImportExportTableData(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	table, err := NewAutoUInt64Table(AutoUInt64TablePrefix, AutoUInt64TableSeqPrefix, &testdata.TableModel{}, cdc)
+	require.NoError(t, err)
+
+	ctx := NewMockContext()
+	store := ctx.KVStore(sdk.NewKVStoreKey("test"))
+
+	tms := []*testdata.TableModel{
+		{
+			Id:       1,
+			Name:     "my test 1",
+			Metadata: []byte("metadata 1"),
+		},
+		{
+			Id:       2,
+			Name:     "my test 2",
+			Metadata: []byte("metadata 2"),
+		},
+	}
+
+	err = table.Import(store, tms, 2)
+	require.NoError(t, err)
+
+	for _, g := range tms {
+		var loaded testdata.TableModel
+		_, err := table.GetOne(store, g.Id, &loaded)
+		require.NoError(t, err)
+
+		require.Equal(t, g, &loaded)
+	}
+
+	var exported []*testdata.TableModel
+	seq, err := table.Export(store, &exported)
+	require.NoError(t, err)
+	require.Equal(t, seq, uint64(2))
+
+	for i, g := range exported {
+		require.Equal(t, tms[i], g)
+	}
+
+	// importing again on a non empty store fails because the sequence is already set
+	err = table.Import(store, tms, 2)
+	require.Error(t, err)
+
+	// next created row continues after the imported sequence value
+	id, err := table.Create(store, &testdata.TableModel{Id: 3, Name: "my test 3"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), id)
+}
+
+func TestImportPrimaryKeyTableClearsData(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	table, err := NewPrimaryKeyTable(PrimaryKeyTablePrefix, &testdata.TableModel{}, cdc)
+	require.NoError(t, err)
+
+	ctx := NewMockContext()
+	store := ctx.KVStore(sdk.NewKVStoreKey("test"))
+
+	require.NoError(t, table.Create(store, &testdata.TableModel{Id: 10, Name: "stale"}))
+
+	tms := []*testdata.TableModel{{Id: 1, Name: "my test 1"}}
+	require.NoError(t, table.Import(store, tms, 0))
+
+	var exported []*testdata.TableModel
+	seq, err := table.Export(store, &exported)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), seq)
+	require.Equal(t, tms, exported)
+
+	require.Error(t, table.Import(store, []testdata.TableModel{{Id: 1, Name: "my test 1"}}, 0))
+	require.Error(t, table.Import(store, testdata.TableModel{Id: 1}, 0))
+}
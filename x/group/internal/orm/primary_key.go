@@ -5,7 +5,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ Indexable = &PrimaryKeyTable{}
+var (
+	_ Indexable       = &PrimaryKeyTable{}
+	_ TableExportable = &PrimaryKeyTable{}
+)
 
 // PrimaryKeyTable provides simpler object style orm methods without passing database RowIDs.
 // Entries are persisted and loaded with a reference to their unique primary key.
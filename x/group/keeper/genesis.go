@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// InitGenesis initializes the group module's genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState group.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	store := ctx.KVStore(k.key)
+
+	if err := k.groupTable.Import(store, genesisState.Groups, genesisState.GroupSeq); err != nil {
+		panic(sdkerrors.Wrap(err, "groups"))
+	}
+
+	if err := k.groupMemberTable.Import(store, genesisState.GroupMembers, 0); err != nil {
+		panic(sdkerrors.Wrap(err, "group members"))
+	}
+
+	if err := k.groupAccountTable.Import(store, genesisState.GroupAccounts, 0); err != nil {
+		panic(sdkerrors.Wrap(err, "group accounts"))
+	}
+
+	if err := k.groupAccountSeq.InitVal(store, genesisState.GroupAccountSeq); err != nil {
+		panic(sdkerrors.Wrap(err, "group account seq"))
+	}
+
+	if err := k.proposalTable.Import(store, genesisState.Proposals, genesisState.ProposalSeq); err != nil {
+		panic(sdkerrors.Wrap(err, "proposals"))
+	}
+
+	if err := k.voteTable.Import(store, genesisState.Votes, 0); err != nil {
+		panic(sdkerrors.Wrap(err, "votes"))
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) *group.GenesisState {
+	genesisState := group.NewGenesisState()
+
+	var groups []*group.GroupInfo
+
+	store := ctx.KVStore(k.key)
+
+	groupSeq, err := k.groupTable.Export(store, &groups)
+	if err != nil {
+		panic(fmt.Errorf("error exporting genesis: %w", err))
+	}
+	genesisState.Groups = groups
+	genesisState.GroupSeq = groupSeq
+
+	var groupMembers []*group.GroupMember
+	_, err = k.groupMemberTable.Export(store, &groupMembers)
+	if err != nil {
+		panic(fmt.Errorf("error exporting genesis: %w", err))
+	}
+	genesisState.GroupMembers = groupMembers
+
+	var groupAccounts []*group.GroupAccountInfo
+	_, err = k.groupAccountTable.Export(store, &groupAccounts)
+	if err != nil {
+		panic(fmt.Errorf("error exporting genesis: %w", err))
+	}
+	genesisState.GroupAccounts = groupAccounts
+	genesisState.GroupAccountSeq = k.groupAccountSeq.CurVal(store)
+
+	var proposals []*group.Proposal
+	proposalSeq, err := k.proposalTable.Export(store, &proposals)
+	if err != nil {
+		panic(fmt.Errorf("error exporting genesis: %w", err))
+	}
+	genesisState.Proposals = proposals
+	genesisState.ProposalSeq = proposalSeq
+
+	var votes []*group.Vote
+	_, err = k.voteTable.Export(store, &votes)
+	if err != nil {
+		panic(fmt.Errorf("error exporting genesis: %w", err))
+	}
+	genesisState.Votes = votes
+
+	return genesisState
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	cdc    *codec.ProtoCodec
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+var (
+	memberAddr       = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	adminAddr        = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	groupAccountAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+)
+
+func (s *GenesisTestSuite) SetupSuite() {
+	app := simapp.Setup(s.T(), false)
+
+	key := sdk.NewKVStoreKey(group.StoreKey)
+	s.ctx = testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	s.cdc = codec.NewProtoCodec(app.InterfaceRegistry())
+	s.keeper = keeper.NewKeeper(key, s.cdc, middleware.NewMsgServiceRouter(app.InterfaceRegistry()), app.AccountKeeper)
+}
+
+func (s *GenesisTestSuite) TestInitExportGenesis() {
+	groupAccount, err := group.NewGroupAccountInfo(groupAccountAddr, 1, adminAddr, []byte("account metadata"), 1, group.NewThresholdDecisionPolicy("1", time.Hour), nil)
+	s.Require().NoError(err)
+
+	genesisState := &group.GenesisState{
+		GroupSeq: 2,
+		Groups: []*group.GroupInfo{
+			{GroupId: 1, Admin: adminAddr.String(), Metadata: []byte("1"), Version: 1, TotalWeight: "1"},
+			{GroupId: 2, Admin: adminAddr.String(), Metadata: []byte("2"), Version: 2, TotalWeight: "2"},
+		},
+		GroupMembers: []*group.GroupMember{
+			{GroupId: 1, Member: &group.Member{Address: memberAddr.String(), Weight: "1", Metadata: []byte("member metadata")}},
+			{GroupId: 2, Member: &group.Member{Address: memberAddr.String(), Weight: "2", Metadata: []byte("member metadata")}},
+		},
+		GroupAccountSeq: 1,
+		GroupAccounts:   []*group.GroupAccountInfo{&groupAccount},
+		ProposalSeq:     0,
+	}
+
+	genesisBytes, err := s.cdc.MarshalJSON(genesisState)
+	s.Require().NoError(err)
+
+	genesisData := map[string]json.RawMessage{
+		group.ModuleName: genesisBytes,
+	}
+
+	s.keeper.InitGenesis(s.ctx, s.cdc, genesisData[group.ModuleName])
+
+	for i, g := range genesisState.Groups {
+		res, err := s.keeper.GroupInfo(sdk.WrapSDKContext(s.ctx), &group.QueryGroupInfoRequest{
+			GroupId: g.GroupId,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(g, res.Info)
+
+		membersRes, err := s.keeper.GroupMembers(sdk.WrapSDKContext(s.ctx), &group.QueryGroupMembersRequest{
+			GroupId: g.GroupId,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(len(membersRes.Members), 1)
+		s.Require().Equal(membersRes.Members[0], genesisState.GroupMembers[i])
+	}
+
+	exported := s.keeper.ExportGenesis(s.ctx, s.cdc)
+	s.Require().Equal(genesisState.GroupSeq, exported.GroupSeq)
+	s.Require().Equal(genesisState.Groups, exported.Groups)
+	s.Require().Equal(genesisState.GroupMembers, exported.GroupMembers)
+	s.Require().Equal(genesisState.GroupAccountSeq, exported.GroupAccountSeq)
+	s.Require().Len(exported.GroupAccounts, 1)
+	s.Require().Equal(groupAccount.Address, exported.GroupAccounts[0].Address)
+	s.Require().Equal(groupAccount.GetDecisionPolicy(), exported.GroupAccounts[0].GetDecisionPolicy())
+	s.Require().Empty(exported.Proposals)
+	s.Require().Empty(exported.Votes)
+
+	// creating a new group after import continues from the imported sequence
+	res, err := s.keeper.CreateGroup(sdk.WrapSDKContext(s.ctx), &group.MsgCreateGroupRequest{
+		Admin:   adminAddr.String(),
+		Members: []group.Member{{Address: memberAddr.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), res.GroupId)
+}
+
+func (s *GenesisTestSuite) TestValidateGenesis() {
+	s.Require().NoError(group.NewGenesisState().Validate())
+
+	unknownGroup := &group.GenesisState{
+		GroupMembers: []*group.GroupMember{
+			{GroupId: 1, Member: &group.Member{Address: memberAddr.String(), Weight: "1"}},
+		},
+	}
+	s.Require().Error(unknownGroup.Validate())
+}
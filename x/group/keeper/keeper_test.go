@@ -0,0 +1,871 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	keeper      keeper.Keeper
+	queryClient group.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	group.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.keeper = app.GroupKeeper
+	s.queryClient = group.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 6, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestCreateGroup() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr3 := addrs[2]
+	addr5 := addrs[4]
+	addr6 := addrs[5]
+
+	members := []group.Member{{
+		Address:  addr5.String(),
+		Weight:   "1",
+		Metadata: nil,
+	}, {
+		Address:  addr6.String(),
+		Weight:   "2",
+		Metadata: nil,
+	}}
+
+	expGroups := []*group.GroupInfo{
+		{
+			GroupId:     1,
+			Version:     1,
+			Admin:       addr1.String(),
+			TotalWeight: "3",
+			Metadata:    nil,
+		},
+	}
+
+	specs := []struct {
+		name      string
+		req       *group.MsgCreateGroupRequest
+		expErr    bool
+		expGroups []*group.GroupInfo
+	}{
+		{
+			name: "all good",
+			req: &group.MsgCreateGroupRequest{
+				Admin:    addr1.String(),
+				Members:  members,
+				Metadata: nil,
+			},
+			expGroups: expGroups,
+		},
+		{
+			name: "group metadata",
+			req: &group.MsgCreateGroupRequest{
+				Admin:    addr1.String(),
+				Members:  members,
+				Metadata: []byte("metadata"),
+			},
+			expGroups: append(expGroups, &group.GroupInfo{
+				GroupId:     2,
+				Version:     1,
+				Admin:       addr1.String(),
+				TotalWeight: "3",
+				Metadata:    []byte("metadata"),
+			}),
+		},
+		{
+			name: "zero member weight",
+			req: &group.MsgCreateGroupRequest{
+				Admin: addr1.String(),
+				Members: []group.Member{{
+					Address:  addr3.String(),
+					Weight:   "0",
+					Metadata: nil,
+				}},
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid member weight",
+			req: &group.MsgCreateGroupRequest{
+				Admin: addr1.String(),
+				Members: []group.Member{{
+					Address:  addr3.String(),
+					Weight:   "-1",
+					Metadata: nil,
+				}},
+			},
+			expErr: true,
+		},
+	}
+	var seq uint32
+	for _, spec := range specs {
+		spec := spec
+		s.Run(spec.name, func() {
+			res, err := s.keeper.CreateGroup(sdk.WrapSDKContext(s.ctx), spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				_, err := s.keeper.GroupInfo(sdk.WrapSDKContext(s.ctx), &group.QueryGroupInfoRequest{GroupId: uint64(seq + 1)})
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			id := res.GroupId
+
+			seq++
+			s.Assert().Equal(uint64(seq), id)
+
+			// then all data persisted
+			loadedGroupRes, err := s.keeper.GroupInfo(sdk.WrapSDKContext(s.ctx), &group.QueryGroupInfoRequest{GroupId: id})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.req.Admin, loadedGroupRes.Info.Admin)
+			s.Assert().Equal(spec.req.Metadata, loadedGroupRes.Info.Metadata)
+			s.Assert().Equal(id, loadedGroupRes.Info.GroupId)
+			s.Assert().Equal(uint64(1), loadedGroupRes.Info.Version)
+
+			// and members are stored as well
+			membersRes, err := s.keeper.GroupMembers(sdk.WrapSDKContext(s.ctx), &group.QueryGroupMembersRequest{GroupId: id})
+			s.Require().NoError(err)
+			loadedMembers := membersRes.Members
+			s.Require().Equal(len(members), len(loadedMembers))
+			for i := range loadedMembers {
+				s.Assert().Equal(members[i].Metadata, loadedMembers[i].Member.Metadata)
+				s.Assert().Equal(members[i].Address, loadedMembers[i].Member.Address)
+				s.Assert().Equal(members[i].Weight, loadedMembers[i].Member.Weight)
+				s.Assert().Equal(id, loadedMembers[i].GroupId)
+			}
+
+			// query groups by admin
+			groupsRes, err := s.keeper.GroupsByAdmin(sdk.WrapSDKContext(s.ctx), &group.QueryGroupsByAdminRequest{Admin: addr1.String()})
+			s.Require().NoError(err)
+			loadedGroups := groupsRes.Groups
+			s.Require().Equal(len(spec.expGroups), len(loadedGroups))
+			for i := range loadedGroups {
+				s.Assert().Equal(spec.expGroups[i].Metadata, loadedGroups[i].Metadata)
+				s.Assert().Equal(spec.expGroups[i].Admin, loadedGroups[i].Admin)
+				s.Assert().Equal(spec.expGroups[i].TotalWeight, loadedGroups[i].TotalWeight)
+				s.Assert().Equal(spec.expGroups[i].GroupId, loadedGroups[i].GroupId)
+				s.Assert().Equal(spec.expGroups[i].Version, loadedGroups[i].Version)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateGroupAdmin() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr3 := addrs[2]
+	addr4 := addrs[3]
+
+	members := []group.Member{{
+		Address:  addr1.String(),
+		Weight:   "1",
+		Metadata: nil,
+	}}
+	oldAdmin := addr2.String()
+	newAdmin := addr3.String()
+	groupRes, err := s.keeper.CreateGroup(sdk.WrapSDKContext(s.ctx), &group.MsgCreateGroupRequest{
+		Admin:    oldAdmin,
+		Members:  members,
+		Metadata: nil,
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	specs := map[string]struct {
+		req       *group.MsgUpdateGroupAdminRequest
+		expStored *group.GroupInfo
+		expErr    bool
+	}{
+		"with correct admin": {
+			req: &group.MsgUpdateGroupAdminRequest{
+				GroupId:  groupID,
+				Admin:    oldAdmin,
+				NewAdmin: newAdmin,
+			},
+			expStored: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       newAdmin,
+				Metadata:    nil,
+				TotalWeight: "1",
+				Version:     2,
+			},
+		},
+		"with wrong admin": {
+			req: &group.MsgUpdateGroupAdminRequest{
+				GroupId:  groupID,
+				Admin:    addr4.String(),
+				NewAdmin: newAdmin,
+			},
+			expErr: true,
+			expStored: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       oldAdmin,
+				Metadata:    nil,
+				TotalWeight: "1",
+				Version:     1,
+			},
+		},
+		"with unknown groupID": {
+			req: &group.MsgUpdateGroupAdminRequest{
+				GroupId:  999,
+				Admin:    oldAdmin,
+				NewAdmin: newAdmin,
+			},
+			expErr: true,
+			expStored: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       oldAdmin,
+				Metadata:    nil,
+				TotalWeight: "1",
+				Version:     1,
+			},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx, _ := s.ctx.CacheContext()
+			_, err := s.keeper.UpdateGroupAdmin(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// then
+			res, err := s.keeper.GroupInfo(sdk.WrapSDKContext(ctx), &group.QueryGroupInfoRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expStored, res.Info)
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateGroupMetadata() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr3 := addrs[2]
+
+	oldAdmin := addr1.String()
+	groupID := s.createGroup(oldAdmin, []group.Member{{Address: addr3.String(), Weight: "1"}})
+
+	specs := map[string]struct {
+		req       *group.MsgUpdateGroupMetadataRequest
+		expErr    bool
+		expStored *group.GroupInfo
+	}{
+		"with correct admin": {
+			req: &group.MsgUpdateGroupMetadataRequest{
+				GroupId:  groupID,
+				Admin:    oldAdmin,
+				Metadata: []byte{1, 2, 3},
+			},
+			expStored: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       oldAdmin,
+				Metadata:    []byte{1, 2, 3},
+				TotalWeight: "1",
+				Version:     2,
+			},
+		},
+		"with wrong admin": {
+			req: &group.MsgUpdateGroupMetadataRequest{
+				GroupId:  groupID,
+				Admin:    addr3.String(),
+				Metadata: []byte{1, 2, 3},
+			},
+			expErr: true,
+		},
+		"with unknown groupid": {
+			req: &group.MsgUpdateGroupMetadataRequest{
+				GroupId:  999,
+				Admin:    oldAdmin,
+				Metadata: []byte{1, 2, 3},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx, _ := s.ctx.CacheContext()
+			_, err := s.keeper.UpdateGroupMetadata(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// then
+			res, err := s.keeper.GroupInfo(sdk.WrapSDKContext(ctx), &group.QueryGroupInfoRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expStored, res.Info)
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateGroupMembers() {
+	addrs := s.addrs
+	addr3 := addrs[2]
+	addr4 := addrs[3]
+	addr5 := addrs[4]
+	addr6 := addrs[5]
+
+	member1 := addr5.String()
+	member2 := addr6.String()
+	members := []group.Member{{
+		Address:  member1,
+		Weight:   "1",
+		Metadata: nil,
+	}}
+
+	myAdmin := addr4.String()
+	groupID := s.createGroup(myAdmin, members)
+
+	specs := map[string]struct {
+		req        *group.MsgUpdateGroupMembersRequest
+		expErr     bool
+		expGroup   *group.GroupInfo
+		expMembers []*group.GroupMember
+	}{
+		"add new member": {
+			req: &group.MsgUpdateGroupMembersRequest{
+				GroupId: groupID,
+				Admin:   myAdmin,
+				MemberUpdates: []group.Member{{
+					Address: member2,
+					Weight:  "2",
+				}},
+			},
+			expGroup: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       myAdmin,
+				TotalWeight: "3",
+				Version:     2,
+			},
+			expMembers: []*group.GroupMember{
+				{
+					Member:  &group.Member{Address: member2, Weight: "2"},
+					GroupId: groupID,
+				},
+				{
+					Member:  &group.Member{Address: member1, Weight: "1"},
+					GroupId: groupID,
+				},
+			},
+		},
+		"update member": {
+			req: &group.MsgUpdateGroupMembersRequest{
+				GroupId: groupID,
+				Admin:   myAdmin,
+				MemberUpdates: []group.Member{{
+					Address:  member1,
+					Weight:   "2",
+					Metadata: []byte{1, 2, 3},
+				}},
+			},
+			expGroup: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       myAdmin,
+				TotalWeight: "2",
+				Version:     2,
+			},
+			expMembers: []*group.GroupMember{
+				{
+					GroupId: groupID,
+					Member:  &group.Member{Address: member1, Weight: "2", Metadata: []byte{1, 2, 3}},
+				},
+			},
+		},
+		"remove existing member": {
+			req: &group.MsgUpdateGroupMembersRequest{
+				GroupId: groupID,
+				Admin:   myAdmin,
+				MemberUpdates: []group.Member{{
+					Address: member1,
+					Weight:  "0",
+				}},
+			},
+			expGroup: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       myAdmin,
+				TotalWeight: "0",
+				Version:     2,
+			},
+			expMembers: []*group.GroupMember{},
+		},
+		"remove unknown member": {
+			req: &group.MsgUpdateGroupMembersRequest{
+				GroupId: groupID,
+				Admin:   myAdmin,
+				MemberUpdates: []group.Member{{
+					Address: addr4.String(),
+					Weight:  "0",
+				}},
+			},
+			expErr: true,
+		},
+		"with wrong admin": {
+			req: &group.MsgUpdateGroupMembersRequest{
+				GroupId: groupID,
+				Admin:   addr3.String(),
+				MemberUpdates: []group.Member{{
+					Address: member1,
+					Weight:  "2",
+				}},
+			},
+			expErr: true,
+		},
+		"with unknown groupID": {
+			req: &group.MsgUpdateGroupMembersRequest{
+				GroupId: 999,
+				Admin:   myAdmin,
+				MemberUpdates: []group.Member{{
+					Address: member1,
+					Weight:  "2",
+				}},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx, _ := s.ctx.CacheContext()
+			_, err := s.keeper.UpdateGroupMembers(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// then
+			res, err := s.keeper.GroupInfo(sdk.WrapSDKContext(ctx), &group.QueryGroupInfoRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expGroup, res.Info)
+
+			// and members persisted
+			membersRes, err := s.keeper.GroupMembers(sdk.WrapSDKContext(ctx), &group.QueryGroupMembersRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			loadedMembers := membersRes.Members
+			s.Require().Equal(len(spec.expMembers), len(loadedMembers))
+			// we reorder members by address to be able to compare them
+			sortedMembers := map[string]*group.GroupMember{}
+			for i := range loadedMembers {
+				sortedMembers[loadedMembers[i].Member.Address] = loadedMembers[i]
+			}
+			for _, exp := range spec.expMembers {
+				loaded, ok := sortedMembers[exp.Member.Address]
+				s.Require().True(ok)
+				s.Assert().Equal(exp.Member.Metadata, loaded.Member.Metadata)
+				s.Assert().Equal(exp.Member.Weight, loaded.Member.Weight)
+				s.Assert().Equal(exp.GroupId, loaded.GroupId)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGroupsByAdminPagination() {
+	admin := s.addrs[0].String()
+	member := []group.Member{{Address: s.addrs[1].String(), Weight: "1"}}
+	for i := 0; i < 3; i++ {
+		s.createGroup(admin, member)
+	}
+
+	res, err := s.queryClient.GroupsByAdmin(sdk.WrapSDKContext(s.ctx), &group.QueryGroupsByAdminRequest{
+		Admin:      admin,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Groups, 2)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = s.queryClient.GroupsByAdmin(sdk.WrapSDKContext(s.ctx), &group.QueryGroupsByAdminRequest{
+		Admin:      admin,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Groups, 1)
+	s.Require().Equal(uint64(3), res.Groups[0].GroupId)
+}
+
+func (s *TestSuite) TestCreateGroupAccount() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr4 := addrs[3]
+
+	groupID := s.createGroup(addr1.String(), []group.Member{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "2"},
+	})
+
+	specs := map[string]struct {
+		req    *group.MsgCreateGroupAccountRequest
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		"all good": {
+			req: &group.MsgCreateGroupAccountRequest{
+				Admin:    addr1.String(),
+				Metadata: nil,
+				GroupId:  groupID,
+			},
+			policy: group.NewThresholdDecisionPolicy("1", time.Second),
+		},
+		"all good with percentage decision policy": {
+			req: &group.MsgCreateGroupAccountRequest{
+				Admin:    addr1.String(),
+				Metadata: nil,
+				GroupId:  groupID,
+			},
+			policy: group.NewPercentageDecisionPolicy("0.5", time.Second),
+		},
+		"group id does not exists": {
+			req: &group.MsgCreateGroupAccountRequest{
+				Admin:    addr1.String(),
+				Metadata: nil,
+				GroupId:  9999,
+			},
+			policy: group.NewThresholdDecisionPolicy("1", time.Second),
+			expErr: true,
+		},
+		"admin not group admin": {
+			req: &group.MsgCreateGroupAccountRequest{
+				Admin:    addr4.String(),
+				Metadata: nil,
+				GroupId:  groupID,
+			},
+			policy: group.NewThresholdDecisionPolicy("1", time.Second),
+			expErr: true,
+		},
+		"threshold greater than group total weight": {
+			req: &group.MsgCreateGroupAccountRequest{
+				Admin:    addr1.String(),
+				Metadata: nil,
+				GroupId:  groupID,
+			},
+			policy: group.NewThresholdDecisionPolicy("4", time.Second),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			err := spec.req.SetDecisionPolicy(spec.policy)
+			s.Require().NoError(err)
+
+			res, err := s.keeper.CreateGroupAccount(sdk.WrapSDKContext(s.ctx), spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			addr := res.Address
+
+			// then all data persisted
+			groupAccountRes, err := s.keeper.GroupAccountInfo(sdk.WrapSDKContext(s.ctx), &group.QueryGroupAccountInfoRequest{Address: addr})
+			s.Require().NoError(err)
+
+			groupAccount := groupAccountRes.Info
+			s.Assert().Equal(addr, groupAccount.Address)
+			s.Assert().Equal(groupID, groupAccount.GroupId)
+			s.Assert().Equal(spec.req.Admin, groupAccount.Admin)
+			s.Assert().Equal(spec.req.Metadata, groupAccount.Metadata)
+			s.Assert().Equal(uint64(1), groupAccount.Version)
+			s.Assert().Equal(spec.policy, groupAccount.GetDecisionPolicy())
+
+			// and the account was created in x/auth
+			accAddr, err := sdk.AccAddressFromBech32(addr)
+			s.Require().NoError(err)
+			s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, accAddr))
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateGroupAccountDecisionPolicy() {
+	addrs := s.addrs
+	admin := addrs[0]
+	groupID := s.createGroup(admin.String(), []group.Member{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "2"},
+	})
+	groupAccountAddr := s.createGroupAccount(admin, groupID, group.NewThresholdDecisionPolicy("1", time.Second))
+
+	specs := map[string]struct {
+		admin  sdk.AccAddress
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		"all good": {
+			admin:  admin,
+			policy: group.NewPercentageDecisionPolicy("0.5", time.Minute),
+		},
+		"not group account admin": {
+			admin:  addrs[3],
+			policy: group.NewThresholdDecisionPolicy("2", time.Second),
+			expErr: true,
+		},
+		"threshold greater than group total weight": {
+			admin:  admin,
+			policy: group.NewThresholdDecisionPolicy("10", time.Second),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx, _ := s.ctx.CacheContext()
+			req, err := group.NewMsgUpdateGroupAccountDecisionPolicyRequest(spec.admin, groupAccountAddr, spec.policy)
+			s.Require().NoError(err)
+
+			_, err = s.keeper.UpdateGroupAccountDecisionPolicy(sdk.WrapSDKContext(ctx), req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.keeper.GroupAccountInfo(sdk.WrapSDKContext(ctx), &group.QueryGroupAccountInfoRequest{Address: groupAccountAddr.String()})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.policy, res.Info.GetDecisionPolicy())
+			s.Assert().Equal(uint64(2), res.Info.Version)
+		})
+	}
+}
+
+func (s *TestSuite) TestProposalLifecycle() {
+	addrs := s.addrs
+	admin := addrs[0]
+	member1 := addrs[1]
+	member2 := addrs[2]
+	recipient := addrs[4]
+
+	groupID := s.createGroup(admin.String(), []group.Member{
+		{Address: member1.String(), Weight: "1"},
+		{Address: member2.String(), Weight: "2"},
+	})
+	groupAccountAddr := s.createGroupAccount(admin, groupID, group.NewThresholdDecisionPolicy("2", time.Hour))
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, groupAccountAddr, sdk.NewCoins(sdk.NewInt64Coin("test", 10000))))
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: groupAccountAddr.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+	}
+
+	s.Run("proposer not a group member", func() {
+		req, err := group.NewMsgCreateProposalRequest(groupAccountAddr.String(), []string{addrs[3].String()}, []sdk.Msg{msgSend}, nil, group.Exec_EXEC_UNSPECIFIED)
+		s.Require().NoError(err)
+		_, err = s.keeper.CreateProposal(sdk.WrapSDKContext(s.ctx), req)
+		s.Require().Error(err)
+	})
+
+	s.Run("msg not signed by the group account", func() {
+		otherSend := &banktypes.MsgSend{
+			FromAddress: member1.String(),
+			ToAddress:   recipient.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+		}
+		req, err := group.NewMsgCreateProposalRequest(groupAccountAddr.String(), []string{member1.String()}, []sdk.Msg{otherSend}, nil, group.Exec_EXEC_UNSPECIFIED)
+		s.Require().NoError(err)
+		_, err = s.keeper.CreateProposal(sdk.WrapSDKContext(s.ctx), req)
+		s.Require().Error(err)
+	})
+
+	s.Run("vote, tally and exec", func() {
+		ctx, _ := s.ctx.CacheContext()
+		goCtx := sdk.WrapSDKContext(ctx)
+		proposalID := s.createProposal(ctx, groupAccountAddr, member1, msgSend, group.Exec_EXEC_UNSPECIFIED)
+
+		res, err := s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		proposal := res.Proposal
+		s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+		s.Assert().Equal(group.ProposalResultUnfinalized, proposal.Result)
+		s.Assert().Equal(group.NewTally(), proposal.VoteState)
+		s.Assert().Equal(ctx.BlockTime().Add(time.Hour), proposal.Timeout)
+
+		// Not enough yes votes yet, the proposal stays open.
+		_, err = s.keeper.Vote(goCtx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: member1.String(), Choice: group.Choice_CHOICE_YES})
+		s.Require().NoError(err)
+		res, err = s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Assert().Equal(group.ProposalStatusSubmitted, res.Proposal.Status)
+		s.Assert().Equal("1", res.Proposal.VoteState.YesCount)
+
+		// The same member can't vote twice.
+		_, err = s.keeper.Vote(goCtx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: member1.String(), Choice: group.Choice_CHOICE_NO})
+		s.Require().Error(err)
+
+		// Non members can't vote.
+		_, err = s.keeper.Vote(goCtx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: addrs[3].String(), Choice: group.Choice_CHOICE_YES})
+		s.Require().Error(err)
+
+		// The threshold is reached and the proposal is accepted.
+		_, err = s.keeper.Vote(goCtx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: member2.String(), Choice: group.Choice_CHOICE_YES})
+		s.Require().NoError(err)
+		res, err = s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Assert().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+		s.Assert().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+		s.Assert().Equal(group.ProposalExecutorResultNotRun, res.Proposal.ExecutorResult)
+
+		votesRes, err := s.keeper.VotesByProposal(goCtx, &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Assert().Len(votesRes.Votes, 2)
+
+		_, err = s.keeper.Exec(goCtx, &group.MsgExecRequest{ProposalId: proposalID, Signer: member1.String()})
+		s.Require().NoError(err)
+		res, err = s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Assert().Equal(group.ProposalExecutorResultSuccess, res.Proposal.ExecutorResult)
+		s.Assert().Equal(int64(100), s.app.BankKeeper.GetBalance(ctx, recipient, "test").Amount.Int64())
+		s.Assert().Equal(int64(9900), s.app.BankKeeper.GetBalance(ctx, groupAccountAddr, "test").Amount.Int64())
+	})
+
+	s.Run("rejected proposal is not executed", func() {
+		ctx, _ := s.ctx.CacheContext()
+		goCtx := sdk.WrapSDKContext(ctx)
+		proposalID := s.createProposal(ctx, groupAccountAddr, member1, msgSend, group.Exec_EXEC_UNSPECIFIED)
+
+		_, err := s.keeper.Vote(goCtx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: member2.String(), Choice: group.Choice_CHOICE_NO})
+		s.Require().NoError(err)
+		_, err = s.keeper.Exec(goCtx, &group.MsgExecRequest{ProposalId: proposalID, Signer: member1.String()})
+		s.Require().NoError(err)
+
+		res, err := s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Assert().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+		s.Assert().Equal(group.ProposalResultRejected, res.Proposal.Result)
+		s.Assert().Equal(group.ProposalExecutorResultNotRun, res.Proposal.ExecutorResult)
+		s.Assert().True(s.app.BankKeeper.GetBalance(ctx, recipient, "test").IsZero())
+	})
+
+	s.Run("exec try on creation", func() {
+		ctx, _ := s.ctx.CacheContext()
+		goCtx := sdk.WrapSDKContext(ctx)
+		proposalID := s.createProposal(ctx, groupAccountAddr, member2, msgSend, group.Exec_EXEC_TRY)
+
+		res, err := s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Assert().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+		s.Assert().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+		s.Assert().Equal(group.ProposalExecutorResultSuccess, res.Proposal.ExecutorResult)
+		s.Assert().Equal(int64(100), s.app.BankKeeper.GetBalance(ctx, recipient, "test").Amount.Int64())
+	})
+
+	s.Run("vote after timeout", func() {
+		ctx, _ := s.ctx.CacheContext()
+		proposalID := s.createProposal(ctx, groupAccountAddr, member1, msgSend, group.Exec_EXEC_UNSPECIFIED)
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		_, err := s.keeper.Vote(sdk.WrapSDKContext(ctx), &group.MsgVoteRequest{ProposalId: proposalID, Voter: member2.String(), Choice: group.Choice_CHOICE_YES})
+		s.Require().Error(err)
+	})
+
+	s.Run("group modified after submission", func() {
+		ctx, _ := s.ctx.CacheContext()
+		goCtx := sdk.WrapSDKContext(ctx)
+		proposalID := s.createProposal(ctx, groupAccountAddr, member1, msgSend, group.Exec_EXEC_UNSPECIFIED)
+
+		_, err := s.keeper.UpdateGroupMetadata(goCtx, &group.MsgUpdateGroupMetadataRequest{GroupId: groupID, Admin: admin.String(), Metadata: []byte("new")})
+		s.Require().NoError(err)
+
+		_, err = s.keeper.Vote(goCtx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: member2.String(), Choice: group.Choice_CHOICE_YES})
+		s.Require().ErrorIs(err, group.ErrModified)
+
+		_, err = s.keeper.Exec(goCtx, &group.MsgExecRequest{ProposalId: proposalID, Signer: member1.String()})
+		s.Require().NoError(err)
+		res, err := s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Assert().Equal(group.ProposalStatusAborted, res.Proposal.Status)
+	})
+}
+
+func (s *TestSuite) TestPercentageDecisionPolicyProposal() {
+	addrs := s.addrs
+	admin := addrs[0]
+	member1 := addrs[1]
+	member2 := addrs[2]
+
+	groupID := s.createGroup(admin.String(), []group.Member{
+		{Address: member1.String(), Weight: "1"},
+		{Address: member2.String(), Weight: "2"},
+	})
+	groupAccountAddr := s.createGroupAccount(admin, groupID, group.NewPercentageDecisionPolicy("0.66", time.Hour))
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: groupAccountAddr.String(),
+		ToAddress:   addrs[4].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+	}
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	proposalID := s.createProposal(s.ctx, groupAccountAddr, member1, msgSend, group.Exec_EXEC_UNSPECIFIED)
+
+	// 2/3 of the total weight is above the 66% required.
+	_, err := s.keeper.Vote(goCtx, &group.MsgVoteRequest{ProposalId: proposalID, Voter: member2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+
+	res, err := s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+}
+
+func (s *TestSuite) createGroupAccount(admin sdk.AccAddress, groupID uint64, policy group.DecisionPolicy) sdk.AccAddress {
+	req, err := group.NewMsgCreateGroupAccountRequest(admin, groupID, nil, policy)
+	s.Require().NoError(err)
+	res, err := s.keeper.CreateGroupAccount(sdk.WrapSDKContext(s.ctx), req)
+	s.Require().NoError(err)
+	addr, err := sdk.AccAddressFromBech32(res.Address)
+	s.Require().NoError(err)
+	return addr
+}
+
+func (s *TestSuite) createProposal(ctx sdk.Context, groupAccount, proposer sdk.AccAddress, msg sdk.Msg, exec group.Exec) uint64 {
+	req, err := group.NewMsgCreateProposalRequest(groupAccount.String(), []string{proposer.String()}, []sdk.Msg{msg}, nil, exec)
+	s.Require().NoError(err)
+	res, err := s.keeper.CreateProposal(sdk.WrapSDKContext(ctx), req)
+	s.Require().NoError(err)
+	return res.ProposalId
+}
+
+func (s *TestSuite) createGroup(admin string, members []group.Member) uint64 {
+	res, err := s.keeper.CreateGroup(sdk.WrapSDKContext(s.ctx), &group.MsgCreateGroupRequest{
+		Admin:   admin,
+		Members: members,
+	})
+	s.Require().NoError(err)
+	return res.GroupId
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// doExecuteMsgs routes the messages to the registered handlers. Messages are limited to those that require no authZ or
+// by the group account only. Otherwise this would give access to other peoples accounts as the ante handler is bypassed.
+func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal group.Proposal, groupAccount sdk.AccAddress) ([]sdk.Result, error) {
+	msgs := proposal.GetMsgs()
+
+	results := make([]sdk.Result, len(msgs))
+	if err := ensureMsgAuthZ(msgs, groupAccount); err != nil {
+		return nil, err
+	}
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %q", sdk.MsgTypeURL(msg))
+		}
+		r, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %q at position %d", sdk.MsgTypeURL(msg), i)
+		}
+		if r != nil {
+			results[i] = *r
+		}
+	}
+	return results, nil
+}
+
+// ensureMsgAuthZ checks that if a message requires signers that all of them are equal to the given group account.
+func ensureMsgAuthZ(msgs []sdk.Msg, groupAccount sdk.AccAddress) error {
+	for i := range msgs {
+		for _, acct := range msgs[i].GetSigners() {
+			if !groupAccount.Equals(acct) {
+				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg does not have group account authorization")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,414 @@
+package group
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroupRequest{}
+	_ sdk.Msg = &MsgUpdateGroupMembersRequest{}
+	_ sdk.Msg = &MsgUpdateGroupAdminRequest{}
+	_ sdk.Msg = &MsgUpdateGroupMetadataRequest{}
+	_ sdk.Msg = &MsgCreateGroupAccountRequest{}
+	_ sdk.Msg = &MsgUpdateGroupAccountAdminRequest{}
+	_ sdk.Msg = &MsgUpdateGroupAccountDecisionPolicyRequest{}
+	_ sdk.Msg = &MsgUpdateGroupAccountMetadataRequest{}
+	_ sdk.Msg = &MsgCreateProposalRequest{}
+	_ sdk.Msg = &MsgVoteRequest{}
+	_ sdk.Msg = &MsgExecRequest{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgCreateGroupAccountRequest{}
+	_ codectypes.UnpackInterfacesMessage = MsgUpdateGroupAccountDecisionPolicyRequest{}
+	_ codectypes.UnpackInterfacesMessage = MsgCreateProposalRequest{}
+)
+
+// GetSigners returns the expected signers for a MsgCreateGroupRequest.
+func (m MsgCreateGroupRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCreateGroupRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	return validateMembers(m.Members, false)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupMembersRequest.
+func (m MsgUpdateGroupMembersRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupMembersRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if len(m.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+	return validateMembers(m.MemberUpdates, true)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupAdminRequest.
+func (m MsgUpdateGroupAdminRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupAdminRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	newAdmin, err := sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+	}
+	if admin.Equals(newAdmin) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupMetadataRequest.
+func (m MsgUpdateGroupMetadataRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupMetadataRequest) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgCreateGroupAccountRequest creates a new MsgCreateGroupAccountRequest.
+//nolint:interfacer
+func NewMsgCreateGroupAccountRequest(admin sdk.AccAddress, group uint64, metadata []byte, decisionPolicy DecisionPolicy) (*MsgCreateGroupAccountRequest, error) {
+	m := &MsgCreateGroupAccountRequest{
+		Admin:    admin.String(),
+		GroupId:  group,
+		Metadata: metadata,
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDecisionPolicy returns the cached DecisionPolicy from the request, if any.
+func (m *MsgCreateGroupAccountRequest) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// SetDecisionPolicy packs the given DecisionPolicy into the request.
+func (m *MsgCreateGroupAccountRequest) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgCreateGroupAccountRequest.
+func (m MsgCreateGroupAccountRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCreateGroupAccountRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	return validateDecisionPolicy(m.GetDecisionPolicy())
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateGroupAccountRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupAccountAdminRequest.
+func (m MsgUpdateGroupAccountAdminRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupAccountAdminRequest) ValidateBasic() error {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	newAdmin, err := sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group account address: %s", err)
+	}
+	if admin.Equals(newAdmin) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+// NewMsgUpdateGroupAccountDecisionPolicyRequest creates a new MsgUpdateGroupAccountDecisionPolicyRequest.
+//nolint:interfacer
+func NewMsgUpdateGroupAccountDecisionPolicyRequest(admin sdk.AccAddress, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupAccountDecisionPolicyRequest, error) {
+	m := &MsgUpdateGroupAccountDecisionPolicyRequest{
+		Admin:   admin.String(),
+		Address: address.String(),
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDecisionPolicy returns the cached DecisionPolicy from the request, if any.
+func (m *MsgUpdateGroupAccountDecisionPolicyRequest) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := m.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// SetDecisionPolicy packs the given DecisionPolicy into the request.
+func (m *MsgUpdateGroupAccountDecisionPolicyRequest) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupAccountDecisionPolicyRequest.
+func (m MsgUpdateGroupAccountDecisionPolicyRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupAccountDecisionPolicyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group account address: %s", err)
+	}
+	return validateDecisionPolicy(m.GetDecisionPolicy())
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgUpdateGroupAccountDecisionPolicyRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupAccountMetadataRequest.
+func (m MsgUpdateGroupAccountMetadataRequest) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupAccountMetadataRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group account address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgCreateProposalRequest creates a new MsgCreateProposalRequest.
+func NewMsgCreateProposalRequest(address string, proposers []string, msgs []sdk.Msg, metadata []byte, exec Exec) (*MsgCreateProposalRequest, error) {
+	m := &MsgCreateProposalRequest{
+		Address:   address,
+		Proposers: proposers,
+		Metadata:  metadata,
+		Exec:      exec,
+	}
+	err := m.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetMsgs unpacks the proposal messages.
+func (m MsgCreateProposalRequest) GetMsgs() []sdk.Msg {
+	msgs, err := tx.GetMsgs(m.Msgs, "proposal")
+	if err != nil {
+		panic(err)
+	}
+	return msgs
+}
+
+// SetMsgs packs the given messages into the request.
+func (m *MsgCreateProposalRequest) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Msgs = anys
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgCreateProposalRequest.
+func (m MsgCreateProposalRequest) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(m.Proposers))
+	for i, proposer := range m.Proposers {
+		addrs[i], _ = sdk.AccAddressFromBech32(proposer)
+	}
+	return addrs
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCreateProposalRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group account address: %s", err)
+	}
+	if len(m.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+
+	index := make(map[string]struct{}, len(m.Proposers))
+	for _, proposer := range m.Proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address %s: %s", proposer, err)
+		}
+		if _, exists := index[proposer]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", proposer)
+		}
+		index[proposer] = struct{}{}
+	}
+
+	msgs := m.GetMsgs()
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateProposalRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, m.Msgs)
+}
+
+// GetSigners returns the expected signers for a MsgVoteRequest.
+func (m MsgVoteRequest) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(m.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgVoteRequest) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if m.Choice == Choice_CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "choice")
+	}
+	if _, ok := Choice_name[int32(m.Choice)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "choice")
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgExecRequest.
+func (m MsgExecRequest) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgExecRequest) ValidateBasic() error {
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+	return nil
+}
+
+// validateMembers checks that the members have valid addresses and weights
+// and that there are no duplicates. Zero weights are only accepted when
+// allowZeroWeight is set, i.e. for member updates where they mean removal.
+func validateMembers(members []Member, allowZeroWeight bool) error {
+	index := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		if err := member.ValidateBasic(); err != nil {
+			return err
+		}
+		if !allowZeroWeight {
+			if _, err := math.NewPositiveDecFromString(member.Weight); err != nil {
+				return sdkerrors.Wrap(err, "member weight")
+			}
+		}
+		if _, exists := index[member.Address]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", member.Address)
+		}
+		index[member.Address] = struct{}{}
+	}
+	return nil
+}
+
+func validateDecisionPolicy(policy DecisionPolicy) error {
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	return policy.ValidateBasic()
+}
+
+func packDecisionPolicy(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrPackAny.Wrapf("can't proto marshal %T", decisionPolicy)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
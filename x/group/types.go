@@ -1,15 +1,14 @@
 package group
 
 import (
-	"fmt"
 	"time"
 
-	proto "github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/types"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 type DecisionPolicyResult struct {
@@ -22,11 +21,184 @@ type DecisionPolicy interface {
 	codec.ProtoMarshaler
 
 	ValidateBasic() error
-	GetTimeout() types.Duration
+	GetTimeout() time.Duration
 	Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error)
 	Validate(g GroupInfo) error
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &ThresholdDecisionPolicy{}
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy
+func NewThresholdDecisionPolicy(threshold string, timeout time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{threshold, timeout}
+}
+
+// ValidateBasic does basic validation on the threshold and timeout of a ThresholdDecisionPolicy.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+
+	if p.Timeout <= time.Duration(0) {
+		return sdkerrors.Wrap(ErrInvalid, "timeout")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if p.Timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	threshold, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "threshold")
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	if yesCount.Cmp(threshold) >= 0 {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	// The proposal can't pass anymore if the remaining undecided weight
+	// can't bring the yes votes up to the threshold.
+	undecided, err := undecidedWeight(tally, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	sum, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if sum.Cmp(threshold) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate returns an error if the threshold is greater than the total weight
+// of the group, in which case no proposal could ever pass.
+func (p *ThresholdDecisionPolicy) Validate(g GroupInfo) error {
+	threshold, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+	if threshold.Cmp(totalWeight) > 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "threshold %s is greater than group total weight %s", p.Threshold, g.TotalWeight)
+	}
+	return nil
+}
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &PercentageDecisionPolicy{}
+
+// NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, timeout time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{percentage, timeout}
+}
+
+// ValidateBasic does basic validation on the percentage and timeout of a PercentageDecisionPolicy.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage")
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) > 0 {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+
+	if p.Timeout <= time.Duration(0) {
+		return sdkerrors.Wrap(ErrInvalid, "timeout")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the share of yes votes in the total
+// weight of the group equals or exceeds the percentage before the timeout.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if p.Timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "percentage")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+	// A group without any weight can't reach any percentage.
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+
+	yesPercentage, err := yesCount.Quo(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesPercentage.Cmp(percentage) >= 0 {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	// The proposal can't pass anymore if the remaining undecided weight
+	// can't bring the yes votes up to the percentage.
+	undecided, err := undecidedWeight(tally, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	sum, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	sumPercentage, err := sum.Quo(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if sumPercentage.Cmp(percentage) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate returns an error if the group has no weight, in which case no
+// percentage of it could ever be reached.
+func (p *PercentageDecisionPolicy) Validate(g GroupInfo) error {
+	totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+	if totalWeight.IsZero() {
+		return sdkerrors.Wrap(ErrInvalid, "group total weight must be positive")
+	}
+	return nil
+}
+
+// undecidedWeight returns the weight of the group members that haven't voted yet.
+func undecidedWeight(tally Tally, totalPower string) (math.Dec, error) {
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return math.Dec{}, sdkerrors.Wrap(err, "total power")
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return math.Dec{}, err
+	}
+	return math.SubNonNegative(totalPowerDec, totalCounts)
+}
+
 // NewGroupAccountInfo creates a new GroupAccountInfo instance
 func NewGroupAccountInfo(address sdk.AccAddress, group uint64, admin sdk.AccAddress, metadata []byte,
 	version uint64, decisionPolicy DecisionPolicy, derivationKey []byte) (GroupAccountInfo, error) {
@@ -48,11 +220,7 @@ func NewGroupAccountInfo(address sdk.AccAddress, group uint64, admin sdk.AccAddr
 }
 
 func (g *GroupAccountInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
-	msg, ok := decisionPolicy.(proto.Message)
-	if !ok {
-		return fmt.Errorf("can't proto marshal %T", msg)
-	}
-	any, err := codectypes.NewAnyWithValue(msg)
+	any, err := packDecisionPolicy(decisionPolicy)
 	if err != nil {
 		return err
 	}
@@ -94,3 +262,293 @@ func unpackGroupAccounts(unpacker codectypes.AnyUnpacker, accs []*GroupAccountIn
 
 	return nil
 }
+
+// ValidateBasic performs stateless validation of a member.
+func (m Member) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", err)
+	}
+	if _, err := math.NewNonNegativeDecFromString(m.Weight); err != nil {
+		return sdkerrors.Wrap(err, "member weight")
+	}
+	return nil
+}
+
+var _ orm.Validateable = GroupInfo{}
+
+// PrimaryKeyFields returns the fields of the object that will make up
+// the primary key of the group table.
+func (g GroupInfo) PrimaryKeyFields() []interface{} {
+	return []interface{}{g.GroupId}
+}
+
+// ValidateBasic performs stateless validation of the group info.
+func (g GroupInfo) ValidateBasic() error {
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group's GroupId")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := math.NewNonNegativeDecFromString(g.TotalWeight); err != nil {
+		return sdkerrors.Wrap(err, "total weight")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+	return nil
+}
+
+var _ orm.PrimaryKeyed = &GroupMember{}
+
+// PrimaryKeyFields returns the fields of the object that will make up
+// the primary key of the group member table.
+func (g GroupMember) PrimaryKeyFields() []interface{} {
+	addr, err := sdk.AccAddressFromBech32(g.Member.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []interface{}{g.GroupId, addr.Bytes()}
+}
+
+// ValidateBasic performs stateless validation of the group member.
+func (g GroupMember) ValidateBasic() error {
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group member's group id")
+	}
+	if g.Member == nil {
+		return sdkerrors.Wrap(ErrEmpty, "group member")
+	}
+	if err := g.Member.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "group member")
+	}
+	if _, err := math.NewPositiveDecFromString(g.Member.Weight); err != nil {
+		return sdkerrors.Wrap(err, "group member weight")
+	}
+	return nil
+}
+
+var _ orm.PrimaryKeyed = &GroupAccountInfo{}
+
+// PrimaryKeyFields returns the fields of the object that will make up
+// the primary key of the group account table.
+func (g GroupAccountInfo) PrimaryKeyFields() []interface{} {
+	addr, err := sdk.AccAddressFromBech32(g.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []interface{}{addr.Bytes()}
+}
+
+// ValidateBasic performs stateless validation of the group account info.
+func (g GroupAccountInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group account address: %s", err)
+	}
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group account's group id")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group account version")
+	}
+	policy := g.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "group account's decision policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "group account's decision policy")
+	}
+	return nil
+}
+
+var _ orm.PrimaryKeyed = &Proposal{}
+
+// PrimaryKeyFields returns the fields of the object that will make up
+// the primary key of the proposal table.
+func (p Proposal) PrimaryKeyFields() []interface{} {
+	return []interface{}{p.ProposalId}
+}
+
+// ValidateBasic performs stateless validation of the proposal.
+func (p Proposal) ValidateBasic() error {
+	if p.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid group account address: %s", err)
+	}
+	if len(p.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	for _, proposer := range p.Proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address %s: %s", proposer, err)
+		}
+	}
+	if p.SubmittedAt.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	}
+	if p.GroupVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal group version")
+	}
+	if p.GroupAccountVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal group account version")
+	}
+	if p.Status == ProposalStatusInvalid {
+		return sdkerrors.Wrap(ErrEmpty, "proposal status")
+	}
+	if p.Result == ProposalResultInvalid {
+		return sdkerrors.Wrap(ErrEmpty, "proposal result")
+	}
+	if p.ExecutorResult == ProposalExecutorResultInvalid {
+		return sdkerrors.Wrap(ErrEmpty, "proposal executor result")
+	}
+	if err := p.VoteState.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "proposal vote state")
+	}
+	if p.Timeout.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "proposal timeout")
+	}
+	return nil
+}
+
+var _ orm.PrimaryKeyed = &Vote{}
+
+// PrimaryKeyFields returns the fields of the object that will make up
+// the primary key of the vote table.
+func (v Vote) PrimaryKeyFields() []interface{} {
+	addr, err := sdk.AccAddressFromBech32(v.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return []interface{}{v.ProposalId, addr.Bytes()}
+}
+
+// ValidateBasic performs stateless validation of the vote.
+func (v Vote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "voter proposal id")
+	}
+	if v.Choice == Choice_CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "voter choice")
+	}
+	if _, ok := Choice_name[int32(v.Choice)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "voter choice")
+	}
+	if v.SubmittedAt.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	}
+	return nil
+}
+
+// NewTally creates a Tally with all counts set to zero.
+func NewTally() Tally {
+	return Tally{
+		YesCount:     "0",
+		NoCount:      "0",
+		AbstainCount: "0",
+		VetoCount:    "0",
+	}
+}
+
+// ValidateBasic checks that all counts of the tally are non-negative decimals.
+func (t Tally) ValidateBasic() error {
+	if _, err := t.GetYesCount(); err != nil {
+		return sdkerrors.Wrap(err, "yes count")
+	}
+	if _, err := t.GetNoCount(); err != nil {
+		return sdkerrors.Wrap(err, "no count")
+	}
+	if _, err := t.GetAbstainCount(); err != nil {
+		return sdkerrors.Wrap(err, "abstain count")
+	}
+	if _, err := t.GetVetoCount(); err != nil {
+		return sdkerrors.Wrap(err, "veto count")
+	}
+	return nil
+}
+
+func (t Tally) GetYesCount() (math.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.YesCount)
+}
+
+func (t Tally) GetNoCount() (math.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.NoCount)
+}
+
+func (t Tally) GetAbstainCount() (math.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.AbstainCount)
+}
+
+func (t Tally) GetVetoCount() (math.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.VetoCount)
+}
+
+// TotalCounts returns the sum of all weighted votes.
+func (t Tally) TotalCounts() (math.Dec, error) {
+	yesCount, err := t.GetYesCount()
+	if err != nil {
+		return math.Dec{}, sdkerrors.Wrap(err, "yes count")
+	}
+	noCount, err := t.GetNoCount()
+	if err != nil {
+		return math.Dec{}, sdkerrors.Wrap(err, "no count")
+	}
+	abstainCount, err := t.GetAbstainCount()
+	if err != nil {
+		return math.Dec{}, sdkerrors.Wrap(err, "abstain count")
+	}
+	vetoCount, err := t.GetVetoCount()
+	if err != nil {
+		return math.Dec{}, sdkerrors.Wrap(err, "veto count")
+	}
+
+	totalCounts := math.NewDecFromInt64(0)
+	for _, count := range []math.Dec{yesCount, noCount, abstainCount, vetoCount} {
+		totalCounts, err = totalCounts.Add(count)
+		if err != nil {
+			return math.Dec{}, err
+		}
+	}
+	return totalCounts, nil
+}
+
+// Add adds the weight of the given vote to the count of its choice.
+func (t *Tally) Add(vote Vote, weight string) error {
+	w, err := math.NewNonNegativeDecFromString(weight)
+	if err != nil {
+		return err
+	}
+
+	add := func(count string) (string, error) {
+		c, err := math.NewNonNegativeDecFromString(count)
+		if err != nil {
+			return "", err
+		}
+		sum, err := c.Add(w)
+		if err != nil {
+			return "", err
+		}
+		return sum.String(), nil
+	}
+
+	switch vote.Choice {
+	case Choice_CHOICE_YES:
+		t.YesCount, err = add(t.YesCount)
+	case Choice_CHOICE_NO:
+		t.NoCount, err = add(t.NoCount)
+	case Choice_CHOICE_ABSTAIN:
+		t.AbstainCount, err = add(t.AbstainCount)
+	case Choice_CHOICE_VETO:
+		t.VetoCount, err = add(t.VetoCount)
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", vote.Choice.String())
+	}
+	return err
+}
@@ -104,7 +104,7 @@ func (x Proposal_Status) String() string {
 }
 
 func (Proposal_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 0}
+	return fileDescriptor_e091dfce5c49c8b6, []int{7, 0}
 }
 
 // Result defines types of proposal results.
@@ -140,7 +140,7 @@ func (x Proposal_Result) String() string {
 }
 
 func (Proposal_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 1}
+	return fileDescriptor_e091dfce5c49c8b6, []int{7, 1}
 }
 
 // ExecutorResult defines types of proposal executor results.
@@ -176,7 +176,7 @@ func (x Proposal_ExecutorResult) String() string {
 }
 
 func (Proposal_ExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 2}
+	return fileDescriptor_e091dfce5c49c8b6, []int{7, 2}
 }
 
 // Member represents a group member with an account address,
@@ -346,6 +346,62 @@ func (m *ThresholdDecisionPolicy) GetTimeout() time.Duration {
 	return 0
 }
 
+// PercentageDecisionPolicy implements the DecisionPolicy interface
+type PercentageDecisionPolicy struct {
+	// percentage is the minimum percentage of the weighted sum of yes votes must meet for a proposal to succeed.
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
+}
+
+func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPolicy{} }
+func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*PercentageDecisionPolicy) ProtoMessage()    {}
+func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e091dfce5c49c8b6, []int{3}
+}
+func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentageDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentageDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PercentageDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentageDecisionPolicy.Merge(m, src)
+}
+func (m *PercentageDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentageDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentageDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercentageDecisionPolicy proto.InternalMessageInfo
+
+func (m *PercentageDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *PercentageDecisionPolicy) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// group_id is the unique ID of the group.
@@ -367,7 +423,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{3}
+	return fileDescriptor_e091dfce5c49c8b6, []int{4}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +499,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{4}
+	return fileDescriptor_e091dfce5c49c8b6, []int{5}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +566,7 @@ func (m *GroupAccountInfo) Reset()         { *m = GroupAccountInfo{} }
 func (m *GroupAccountInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupAccountInfo) ProtoMessage()    {}
 func (*GroupAccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{5}
+	return fileDescriptor_e091dfce5c49c8b6, []int{6}
 }
 func (m *GroupAccountInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +637,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6}
+	return fileDescriptor_e091dfce5c49c8b6, []int{7}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,7 +682,7 @@ func (m *Tally) Reset()         { *m = Tally{} }
 func (m *Tally) String() string { return proto.CompactTextString(m) }
 func (*Tally) ProtoMessage()    {}
 func (*Tally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{7}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8}
 }
 func (m *Tally) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +729,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{8}
+	return fileDescriptor_e091dfce5c49c8b6, []int{9}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,6 +801,7 @@ func init() {
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1beta1.Member")
 	proto.RegisterType((*Members)(nil), "cosmos.group.v1beta1.Members")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1beta1.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1beta1.PercentageDecisionPolicy")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1beta1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1beta1.GroupMember")
 	proto.RegisterType((*GroupAccountInfo)(nil), "cosmos.group.v1beta1.GroupAccountInfo")
@@ -756,89 +813,91 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1beta1/types.proto", fileDescriptor_e091dfce5c49c8b6) }
 
 var fileDescriptor_e091dfce5c49c8b6 = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	// 1330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
 	0x14, 0xf7, 0xda, 0x8e, 0xff, 0x3c, 0x27, 0x8e, 0x35, 0xa4, 0xed, 0xc6, 0x69, 0x9d, 0xad, 0x4b,
 	0xa5, 0x08, 0x14, 0x5b, 0x09, 0x7f, 0x0e, 0x15, 0xad, 0xb0, 0x9d, 0x4d, 0x31, 0xa4, 0x76, 0xd8,
-	0xb5, 0x03, 0xf4, 0x80, 0xb5, 0xde, 0x9d, 0x3a, 0x4b, 0xed, 0x1d, 0x6b, 0x77, 0x36, 0xd4, 0x7c,
-	0x82, 0xe2, 0x53, 0x8f, 0x70, 0xb0, 0x54, 0x89, 0x13, 0x27, 0x2e, 0xfd, 0x10, 0x15, 0x5c, 0x2a,
-	0x4e, 0x88, 0x03, 0xa0, 0xf6, 0xc2, 0x99, 0x4f, 0x80, 0x76, 0x66, 0xb6, 0x89, 0x5b, 0xc7, 0x69,
-	0x11, 0x27, 0xef, 0xbc, 0xf9, 0xfd, 0xe6, 0xbd, 0xf7, 0x7b, 0x6f, 0xfe, 0x18, 0x14, 0x93, 0x78,
-	0x03, 0xe2, 0x95, 0x7b, 0x2e, 0xf1, 0x87, 0xe5, 0xa3, 0xad, 0x2e, 0xa6, 0xc6, 0x56, 0x99, 0x8e,
-	0x86, 0xd8, 0x2b, 0x0d, 0x5d, 0x42, 0x09, 0x5a, 0xe1, 0x88, 0x12, 0x43, 0x94, 0x04, 0x22, 0xbf,
-	0xd2, 0x23, 0x3d, 0xc2, 0x00, 0xe5, 0xe0, 0x8b, 0x63, 0xf3, 0x85, 0x1e, 0x21, 0xbd, 0x3e, 0x2e,
-	0xb3, 0x51, 0xd7, 0xbf, 0x53, 0xb6, 0x7c, 0xd7, 0xa0, 0x36, 0x71, 0xc4, 0xfc, 0xfa, 0x8b, 0xf3,
-	0xd4, 0x1e, 0x60, 0x8f, 0x1a, 0x83, 0xa1, 0x00, 0xac, 0x72, 0x67, 0x1d, 0xbe, 0xb2, 0xf0, 0x2c,
-	0xa6, 0x5e, 0xe4, 0x1a, 0xce, 0xe8, 0x4c, 0x56, 0x71, 0x08, 0x89, 0x5b, 0x78, 0xd0, 0xc5, 0x2e,
-	0xda, 0x86, 0xa4, 0x61, 0x59, 0x2e, 0xf6, 0x3c, 0x59, 0x52, 0xa4, 0x8d, 0x74, 0x55, 0xfe, 0xf5,
-	0xd1, 0x66, 0x98, 0x5c, 0x85, 0xcf, 0xe8, 0xd4, 0xb5, 0x9d, 0x9e, 0x16, 0x02, 0xd1, 0x79, 0x48,
-	0x7c, 0x8d, 0xed, 0xde, 0x21, 0x95, 0xa3, 0x01, 0x45, 0x13, 0x23, 0x94, 0x87, 0xd4, 0x00, 0x53,
-	0xc3, 0x32, 0xa8, 0x21, 0xc7, 0x14, 0x69, 0x63, 0x51, 0x7b, 0x3e, 0x2e, 0xde, 0x84, 0x24, 0xf7,
-	0xe8, 0xa1, 0x0f, 0x20, 0x39, 0xe0, 0x9f, 0xb2, 0xa4, 0xc4, 0x36, 0x32, 0xdb, 0x17, 0x4b, 0xb3,
-	0xc4, 0x2c, 0x71, 0x7c, 0x35, 0xfe, 0xf8, 0x8f, 0xf5, 0x88, 0x16, 0x52, 0x8a, 0x63, 0x09, 0x2e,
-	0xb4, 0x0e, 0x5d, 0xec, 0x1d, 0x92, 0xbe, 0xb5, 0x83, 0x4d, 0xdb, 0xb3, 0x89, 0xb3, 0x4f, 0xfa,
-	0xb6, 0x39, 0x42, 0x17, 0x21, 0x4d, 0xc3, 0x29, 0x9e, 0x8e, 0x76, 0x6c, 0x40, 0xd7, 0x21, 0x19,
-	0x08, 0x4b, 0x7c, 0x1e, 0x77, 0x66, 0x7b, 0xb5, 0xc4, 0xc5, 0x2b, 0x85, 0xe2, 0x95, 0x76, 0x44,
-	0x61, 0xaa, 0xa9, 0xc0, 0xe9, 0x77, 0x7f, 0xae, 0x4b, 0x5a, 0xc8, 0xb9, 0x86, 0x7e, 0x7e, 0xb4,
-	0x99, 0x9d, 0x76, 0x58, 0xfc, 0x49, 0x82, 0xf4, 0xcd, 0x20, 0xe8, 0xba, 0x73, 0x87, 0xa0, 0x55,
-	0x48, 0xb1, 0x0c, 0x3a, 0x36, 0xf7, 0x1e, 0xd7, 0x92, 0x6c, 0x5c, 0xb7, 0x50, 0x09, 0x16, 0x0c,
-	0x6b, 0x60, 0x3b, 0x5c, 0xb1, 0x39, 0x22, 0x73, 0xd8, 0x3c, 0x29, 0x91, 0x0c, 0xc9, 0x23, 0xec,
-	0x06, 0x51, 0xc8, 0x71, 0xee, 0x45, 0x0c, 0xd1, 0x65, 0x58, 0xa4, 0x84, 0x1a, 0xfd, 0x8e, 0x28,
-	0xcf, 0x02, 0x93, 0x20, 0xc3, 0x6c, 0x9f, 0x31, 0x53, 0xf1, 0x4b, 0xc8, 0xb0, 0x80, 0x45, 0xf9,
-	0xe7, 0x84, 0xfc, 0x2e, 0x24, 0xb8, 0xe6, 0x42, 0xad, 0xb9, 0x55, 0xd2, 0x04, 0xb6, 0xf8, 0x4b,
-	0x14, 0x72, 0xcc, 0x41, 0xc5, 0x34, 0x89, 0xef, 0x50, 0x26, 0xcc, 0x7f, 0x69, 0xb2, 0x93, 0x91,
-	0x45, 0x4f, 0x11, 0x33, 0xf6, 0xfa, 0x62, 0xc6, 0x4f, 0x17, 0x73, 0x61, 0x5a, 0xcc, 0x4f, 0x61,
-	0xd9, 0x12, 0xd5, 0xee, 0x0c, 0x59, 0xb9, 0xe5, 0x04, 0x13, 0x62, 0xe5, 0xa5, 0xb6, 0xa9, 0x38,
-	0xa3, 0xea, 0x8c, 0xf6, 0xd0, 0xb2, 0xd6, 0x74, 0x7f, 0x5e, 0x85, 0xac, 0x85, 0x5d, 0xfb, 0x88,
-	0xf5, 0x58, 0xe7, 0x2e, 0x1e, 0xc9, 0x49, 0x16, 0xce, 0xd2, 0xb1, 0xf5, 0x13, 0x3c, 0xba, 0x96,
-	0xba, 0xff, 0x70, 0x3d, 0xf2, 0xf7, 0xc3, 0x75, 0xa9, 0xf8, 0x63, 0x06, 0x52, 0xfb, 0x2e, 0x19,
-	0x12, 0xcf, 0xe8, 0xa3, 0x75, 0xc8, 0x0c, 0xc5, 0xf7, 0x71, 0xb9, 0x20, 0x34, 0xd5, 0xad, 0x93,
-	0x32, 0x47, 0x5f, 0x55, 0xe6, 0x79, 0x8d, 0xf6, 0x3e, 0xa4, 0xf9, 0xea, 0xc1, 0x56, 0x8d, 0x2b,
-	0xb1, 0xb9, 0x2b, 0x1e, 0x43, 0xd1, 0x4d, 0x58, 0xf4, 0xfc, 0xee, 0xc0, 0xa6, 0x14, 0x5b, 0x1d,
-	0x83, 0xb7, 0x61, 0x66, 0x3b, 0xff, 0x92, 0x6c, 0xad, 0xf0, 0x98, 0xe3, 0xdb, 0xed, 0x41, 0xb0,
-	0xdd, 0x32, 0xcf, 0x99, 0x15, 0x8a, 0xae, 0xc0, 0x12, 0xef, 0x81, 0xb0, 0x44, 0x09, 0x96, 0xf3,
-	0x22, 0x33, 0x1e, 0x88, 0x3a, 0x6d, 0xc3, 0x39, 0x0e, 0x32, 0x78, 0xc7, 0x3d, 0x07, 0x27, 0x19,
-	0xf8, 0x8d, 0xde, 0x89, 0x6e, 0x0c, 0x39, 0xd7, 0x21, 0xe1, 0x51, 0x83, 0xfa, 0x9e, 0x9c, 0x52,
-	0xa4, 0x8d, 0xec, 0xf6, 0xd5, 0xd9, 0xbd, 0x1d, 0x4a, 0x5f, 0xd2, 0x19, 0x58, 0x13, 0xa4, 0x80,
-	0xee, 0x62, 0xcf, 0xef, 0x53, 0x39, 0xfd, 0x4a, 0x74, 0x8d, 0x81, 0x35, 0x41, 0x42, 0x1f, 0x02,
-	0x1c, 0x11, 0x8a, 0x3b, 0xc1, 0x6a, 0x58, 0x06, 0xa6, 0xce, 0xda, 0xec, 0x25, 0x5a, 0x46, 0xbf,
-	0x3f, 0x12, 0x47, 0x60, 0x3a, 0x20, 0x05, 0x91, 0x60, 0x74, 0xe3, 0xf8, 0x28, 0xcb, 0xbc, 0x86,
-	0xb8, 0x21, 0x09, 0x1d, 0xc0, 0x32, 0xbe, 0x87, 0x4d, 0x9f, 0x12, 0xb7, 0x23, 0x32, 0x59, 0x64,
-	0x99, 0x6c, 0x9e, 0x91, 0x89, 0x2a, 0x58, 0x22, 0xa3, 0x2c, 0x9e, 0x1a, 0xa3, 0x0d, 0x88, 0x0f,
-	0xbc, 0x9e, 0x27, 0x2f, 0xb1, 0x73, 0x7d, 0xe6, 0x46, 0xd1, 0x18, 0xa2, 0xf8, 0x44, 0x82, 0x04,
-	0x57, 0x15, 0x6d, 0x01, 0xd2, 0x5b, 0x95, 0x56, 0x5b, 0xef, 0xb4, 0x1b, 0xfa, 0xbe, 0x5a, 0xab,
-	0xef, 0xd6, 0xd5, 0x9d, 0x5c, 0x24, 0xbf, 0x3a, 0x9e, 0x28, 0xe7, 0x42, 0xcf, 0x1c, 0x5b, 0x77,
-	0x8e, 0x8c, 0xbe, 0x6d, 0xa1, 0x2d, 0xc8, 0x09, 0x8a, 0xde, 0xae, 0xde, 0xaa, 0xb7, 0x5a, 0xea,
-	0x4e, 0x4e, 0xca, 0xaf, 0x8d, 0x27, 0xca, 0x85, 0x69, 0x82, 0x1e, 0x76, 0x13, 0x7a, 0x1b, 0x96,
-	0x04, 0xa5, 0xb6, 0xd7, 0xd4, 0xd5, 0x9d, 0x5c, 0x34, 0x2f, 0x8f, 0x27, 0xca, 0xca, 0x34, 0xbe,
-	0xd6, 0x27, 0x1e, 0xb6, 0xd0, 0x26, 0x64, 0x05, 0xb8, 0x52, 0x6d, 0x6a, 0xc1, 0xea, 0xb1, 0x59,
-	0xe1, 0x54, 0xba, 0xc4, 0xa5, 0xd8, 0xca, 0xc7, 0xef, 0xff, 0x50, 0x88, 0x14, 0x7f, 0x97, 0x20,
-	0x21, 0x74, 0xd8, 0x02, 0xa4, 0xa9, 0x7a, 0x7b, 0xaf, 0x35, 0x2f, 0x25, 0x8e, 0x0d, 0x53, 0x7a,
-	0xef, 0x04, 0x65, 0xb7, 0xde, 0xa8, 0xec, 0xd5, 0x6f, 0xb3, 0xa4, 0x2e, 0x8d, 0x27, 0xca, 0xea,
-	0x34, 0xa5, 0xed, 0xdc, 0xb1, 0x1d, 0xa3, 0x6f, 0x7f, 0x83, 0x2d, 0x54, 0x86, 0x65, 0x41, 0xab,
-	0xd4, 0x6a, 0xea, 0x7e, 0x8b, 0x25, 0x96, 0x1f, 0x4f, 0x94, 0xf3, 0xd3, 0x9c, 0x8a, 0x69, 0xe2,
-	0x21, 0x9d, 0x22, 0x68, 0xea, 0xc7, 0x6a, 0x8d, 0xe7, 0x36, 0x83, 0xa0, 0xe1, 0xaf, 0xb0, 0x79,
-	0x9c, 0xdc, 0xf7, 0x51, 0xc8, 0x4e, 0x17, 0x1f, 0x55, 0x61, 0x4d, 0xfd, 0x5c, 0xad, 0xb5, 0x5b,
-	0x4d, 0xad, 0x33, 0x33, 0xdb, 0xcb, 0xe3, 0x89, 0x72, 0x29, 0x5c, 0x75, 0x9a, 0x1c, 0x66, 0x7d,
-	0x1d, 0x2e, 0xbc, 0xb8, 0x46, 0xa3, 0xd9, 0xea, 0x68, 0xed, 0x46, 0x4e, 0xca, 0x2b, 0xe3, 0x89,
-	0x72, 0x71, 0x36, 0xbf, 0x41, 0xa8, 0xe6, 0x3b, 0xe8, 0xc6, 0xcb, 0x74, 0xbd, 0x5d, 0xab, 0xa9,
-	0xba, 0x9e, 0x8b, 0xce, 0x73, 0xaf, 0xfb, 0xa6, 0x19, 0x9c, 0x7e, 0x33, 0xf8, 0xbb, 0x95, 0xfa,
-	0x5e, 0x5b, 0x53, 0x73, 0xb1, 0x79, 0xfc, 0x5d, 0xc3, 0xee, 0xfb, 0x2e, 0xe6, 0xda, 0x5c, 0x8b,
-	0x07, 0xe7, 0x75, 0xf1, 0x5b, 0x09, 0x16, 0xd8, 0x76, 0x45, 0x6b, 0x90, 0x1e, 0x61, 0xaf, 0xc3,
-	0x4e, 0x1c, 0xf1, 0x0c, 0x49, 0x8d, 0xb0, 0x57, 0x0b, 0xc6, 0xc1, 0xbd, 0xe6, 0x10, 0x31, 0xc7,
-	0x9f, 0x4f, 0x49, 0x87, 0xf0, 0xa9, 0x2b, 0xb0, 0x64, 0x74, 0x3d, 0x6a, 0xd8, 0x8e, 0x98, 0x67,
-	0xf7, 0x9b, 0xb6, 0x28, 0x8c, 0x1c, 0x74, 0x09, 0xe0, 0x08, 0xd3, 0x70, 0x85, 0x38, 0x7f, 0xe4,
-	0x04, 0x16, 0x36, 0x2d, 0x62, 0xf9, 0x47, 0x82, 0xf8, 0x01, 0xa1, 0xf8, 0xec, 0x3b, 0xa3, 0x04,
-	0x0b, 0xc1, 0xb1, 0xe2, 0x9e, 0xfd, 0x30, 0x61, 0xb0, 0xe0, 0x55, 0x60, 0x1e, 0x12, 0xdb, 0xc4,
-	0x2c, 0xb8, 0xec, 0x69, 0xaf, 0x82, 0x1a, 0xc3, 0x68, 0x02, 0x3b, 0xf7, 0x06, 0xfe, 0xbf, 0x6e,
-	0x8b, 0xb7, 0x2c, 0x48, 0x70, 0xb7, 0xe8, 0x3c, 0xa0, 0xda, 0x47, 0xcd, 0x7a, 0x4d, 0x9d, 0x6e,
-	0x48, 0xb4, 0x04, 0x69, 0x61, 0x6f, 0x34, 0x73, 0x12, 0xca, 0x02, 0x88, 0xe1, 0x17, 0xaa, 0x9e,
-	0x8b, 0x22, 0x04, 0x59, 0x31, 0xae, 0x54, 0xf5, 0x56, 0xa5, 0xde, 0xc8, 0xc5, 0xd0, 0x32, 0x64,
-	0x84, 0xed, 0x40, 0x6d, 0x35, 0x73, 0xf1, 0xea, 0x8d, 0xc7, 0x4f, 0x0b, 0xd2, 0x93, 0xa7, 0x05,
-	0xe9, 0xaf, 0xa7, 0x05, 0xe9, 0xc1, 0xb3, 0x42, 0xe4, 0xc9, 0xb3, 0x42, 0xe4, 0xb7, 0x67, 0x85,
-	0xc8, 0xed, 0x37, 0x7b, 0x36, 0x3d, 0xf4, 0xbb, 0x25, 0x93, 0x0c, 0xc4, 0x6b, 0x5b, 0xfc, 0x6c,
-	0x7a, 0xd6, 0xdd, 0xf2, 0x3d, 0xfe, 0x67, 0xa2, 0x9b, 0x60, 0x09, 0xbd, 0xf3, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x16, 0xa0, 0x18, 0x41, 0x63, 0x0c, 0x00, 0x00,
+	0xb5, 0x03, 0xf4, 0x80, 0xb5, 0xde, 0x9d, 0x3a, 0x4b, 0xed, 0x1d, 0x6b, 0x77, 0x1c, 0x6a, 0x3e,
+	0x41, 0xb1, 0x84, 0xd4, 0x23, 0x1c, 0x2c, 0x55, 0xe2, 0xc4, 0x89, 0x4b, 0x3f, 0x44, 0x05, 0x97,
+	0x8a, 0x13, 0xe2, 0x00, 0xa8, 0xbd, 0x70, 0xe6, 0x13, 0xa0, 0x9d, 0x99, 0x4d, 0xe2, 0xc4, 0x71,
+	0x5a, 0x04, 0xa7, 0x78, 0xde, 0xfb, 0xfd, 0xe6, 0xbd, 0xf7, 0x7b, 0x6f, 0x67, 0x26, 0xa0, 0x98,
+	0xc4, 0xeb, 0x11, 0xaf, 0xd8, 0x71, 0xc9, 0xa0, 0x5f, 0x3c, 0xd8, 0x68, 0x63, 0x6a, 0x6c, 0x14,
+	0xe9, 0xb0, 0x8f, 0xbd, 0x42, 0xdf, 0x25, 0x94, 0xa0, 0x25, 0x8e, 0x28, 0x30, 0x44, 0x41, 0x20,
+	0xb2, 0x4b, 0x1d, 0xd2, 0x21, 0x0c, 0x50, 0xf4, 0x7f, 0x71, 0x6c, 0x36, 0xd7, 0x21, 0xa4, 0xd3,
+	0xc5, 0x45, 0xb6, 0x6a, 0x0f, 0xee, 0x15, 0xad, 0x81, 0x6b, 0x50, 0x9b, 0x38, 0xc2, 0xbf, 0x7a,
+	0xd2, 0x4f, 0xed, 0x1e, 0xf6, 0xa8, 0xd1, 0xeb, 0x0b, 0xc0, 0x32, 0x0f, 0xd6, 0xe2, 0x3b, 0x8b,
+	0xc8, 0xc2, 0x75, 0x92, 0x6b, 0x38, 0xc3, 0x73, 0x59, 0xf9, 0x3e, 0xc4, 0xee, 0xe0, 0x5e, 0x1b,
+	0xbb, 0x68, 0x13, 0xe2, 0x86, 0x65, 0xb9, 0xd8, 0xf3, 0x64, 0x49, 0x91, 0xd6, 0x92, 0x65, 0xf9,
+	0x97, 0x27, 0xeb, 0x41, 0x71, 0x25, 0xee, 0xd1, 0xa9, 0x6b, 0x3b, 0x1d, 0x2d, 0x00, 0xa2, 0x8b,
+	0x10, 0xfb, 0x12, 0xdb, 0x9d, 0x7d, 0x2a, 0x87, 0x7d, 0x8a, 0x26, 0x56, 0x28, 0x0b, 0x89, 0x1e,
+	0xa6, 0x86, 0x65, 0x50, 0x43, 0x8e, 0x28, 0xd2, 0xda, 0xbc, 0x76, 0xb8, 0xce, 0xdf, 0x86, 0x38,
+	0x8f, 0xe8, 0xa1, 0xf7, 0x20, 0xde, 0xe3, 0x3f, 0x65, 0x49, 0x89, 0xac, 0xa5, 0x36, 0x2f, 0x17,
+	0xa6, 0x89, 0x59, 0xe0, 0xf8, 0x72, 0xf4, 0xe9, 0xef, 0xab, 0x21, 0x2d, 0xa0, 0xe4, 0x47, 0x12,
+	0x5c, 0x6a, 0xec, 0xbb, 0xd8, 0xdb, 0x27, 0x5d, 0x6b, 0x0b, 0x9b, 0xb6, 0x67, 0x13, 0x67, 0x97,
+	0x74, 0x6d, 0x73, 0x88, 0x2e, 0x43, 0x92, 0x06, 0x2e, 0x5e, 0x8e, 0x76, 0x64, 0x40, 0x37, 0x21,
+	0xee, 0x0b, 0x4b, 0x06, 0x3c, 0xef, 0xd4, 0xe6, 0x72, 0x81, 0x8b, 0x57, 0x08, 0xc4, 0x2b, 0x6c,
+	0x89, 0xc6, 0x94, 0x13, 0x7e, 0xd0, 0x6f, 0xff, 0x58, 0x95, 0xb4, 0x80, 0x73, 0x03, 0xfd, 0xf4,
+	0x64, 0x3d, 0x3d, 0x19, 0x30, 0xff, 0x8d, 0x04, 0xf2, 0x2e, 0x76, 0x4d, 0xec, 0x50, 0xa3, 0x83,
+	0x4f, 0x64, 0x93, 0x03, 0xe8, 0x1f, 0xfa, 0x44, 0x3a, 0xc7, 0x2c, 0xff, 0x47, 0x3e, 0x3f, 0x4a,
+	0x90, 0xbc, 0xed, 0x8b, 0x58, 0x75, 0xee, 0x11, 0xb4, 0x0c, 0x09, 0xa6, 0x68, 0xcb, 0xe6, 0x6a,
+	0x44, 0xb5, 0x38, 0x5b, 0x57, 0x2d, 0x54, 0x80, 0x39, 0xc3, 0xea, 0xd9, 0x8e, 0x1c, 0x3e, 0xa7,
+	0xe9, 0x1c, 0x36, 0xab, 0xb5, 0x48, 0x86, 0xf8, 0x01, 0x76, 0xfd, 0x2c, 0xe4, 0x28, 0x8f, 0x22,
+	0x96, 0xe8, 0x2a, 0xcc, 0x53, 0x42, 0x8d, 0x6e, 0x4b, 0x8c, 0xcb, 0x1c, 0xd3, 0x20, 0xc5, 0x6c,
+	0x9f, 0x30, 0x53, 0xfe, 0x73, 0x48, 0xb1, 0x84, 0xc5, 0x38, 0xce, 0x48, 0xf9, 0x6d, 0x88, 0xf1,
+	0x19, 0x10, 0x6a, 0xcd, 0x9c, 0x1a, 0x4d, 0x60, 0xf3, 0x3f, 0x87, 0x21, 0xc3, 0x02, 0x94, 0x4c,
+	0x93, 0x0c, 0x1c, 0xca, 0x84, 0xf9, 0x37, 0x43, 0x7f, 0x3c, 0xb3, 0xf0, 0x19, 0x62, 0x46, 0x5e,
+	0x5d, 0xcc, 0xe8, 0xd9, 0x62, 0xce, 0x4d, 0x8a, 0xf9, 0x31, 0x2c, 0x5a, 0xa2, 0xdb, 0xad, 0x3e,
+	0x6b, 0xb7, 0x1c, 0x63, 0x42, 0x2c, 0x9d, 0x1a, 0x9b, 0x92, 0x33, 0x2c, 0x4f, 0x19, 0x0f, 0x2d,
+	0x6d, 0x4d, 0x4e, 0xe8, 0x75, 0x48, 0x5b, 0xd8, 0xb5, 0x0f, 0xd8, 0x8c, 0xb5, 0xee, 0xe3, 0xa1,
+	0x1c, 0x67, 0xe9, 0x2c, 0x1c, 0x59, 0x3f, 0xc2, 0xc3, 0x1b, 0x89, 0x87, 0x8f, 0x57, 0x43, 0x7f,
+	0x3d, 0x5e, 0x95, 0xf2, 0x3f, 0xa4, 0x20, 0xb1, 0xeb, 0x92, 0x3e, 0xf1, 0x8c, 0x2e, 0x5a, 0x85,
+	0x54, 0x5f, 0xfc, 0x3e, 0x6a, 0x17, 0x04, 0xa6, 0xaa, 0x75, 0x5c, 0xe6, 0xf0, 0xcb, 0xca, 0x3c,
+	0x6b, 0xd0, 0xde, 0x85, 0x24, 0xdf, 0xdd, 0x3f, 0x3a, 0xa2, 0x4a, 0x64, 0xe6, 0x8e, 0x47, 0x50,
+	0x74, 0x1b, 0xe6, 0xbd, 0x41, 0xbb, 0x67, 0x53, 0x8a, 0xad, 0x96, 0xc1, 0xc7, 0x30, 0xb5, 0x99,
+	0x3d, 0x25, 0x5b, 0x23, 0x38, 0x76, 0xf9, 0xe7, 0xf6, 0xc8, 0xff, 0xdc, 0x52, 0x87, 0xcc, 0x12,
+	0x45, 0xd7, 0x60, 0x81, 0xcf, 0x40, 0xd0, 0xa2, 0x18, 0xab, 0x79, 0x9e, 0x19, 0xf7, 0x44, 0x9f,
+	0x36, 0xe1, 0x02, 0x07, 0x19, 0x7c, 0xe2, 0x0e, 0xc1, 0x71, 0x06, 0x7e, 0xad, 0x73, 0x6c, 0x1a,
+	0x03, 0xce, 0x4d, 0x88, 0x79, 0xd4, 0xa0, 0x03, 0x4f, 0x4e, 0x28, 0xd2, 0x5a, 0x7a, 0xf3, 0xfa,
+	0xf4, 0xd9, 0x0e, 0xa4, 0x2f, 0xe8, 0x0c, 0xac, 0x09, 0x92, 0x4f, 0x77, 0xb1, 0x37, 0xe8, 0x52,
+	0x39, 0xf9, 0x52, 0x74, 0x8d, 0x81, 0x35, 0x41, 0x42, 0xef, 0x03, 0x1c, 0x10, 0x8a, 0x5b, 0xfe,
+	0x6e, 0x58, 0x06, 0xa6, 0xce, 0xca, 0xf4, 0x2d, 0x1a, 0x46, 0xb7, 0x3b, 0x14, 0x47, 0x72, 0xd2,
+	0x27, 0xf9, 0x99, 0x60, 0x74, 0xeb, 0xe8, 0x28, 0x4b, 0xbd, 0x82, 0xb8, 0x01, 0x09, 0xed, 0xc1,
+	0x22, 0x7e, 0x80, 0xcd, 0x01, 0x25, 0x6e, 0x4b, 0x54, 0x32, 0xcf, 0x2a, 0x59, 0x3f, 0xa7, 0x12,
+	0x55, 0xb0, 0x44, 0x45, 0x69, 0x3c, 0xb1, 0x46, 0x6b, 0x10, 0xed, 0x79, 0x1d, 0x4f, 0x5e, 0x50,
+	0x22, 0x67, 0x7d, 0x28, 0x1a, 0x43, 0xe4, 0x9f, 0x49, 0x10, 0xe3, 0xaa, 0xa2, 0x0d, 0x40, 0x7a,
+	0xa3, 0xd4, 0x68, 0xea, 0xad, 0x66, 0x4d, 0xdf, 0x55, 0x2b, 0xd5, 0xed, 0xaa, 0xba, 0x95, 0x09,
+	0x65, 0x97, 0x47, 0x63, 0xe5, 0x42, 0x10, 0x99, 0x63, 0xab, 0xce, 0x81, 0xd1, 0xb5, 0x2d, 0xb4,
+	0x01, 0x19, 0x41, 0xd1, 0x9b, 0xe5, 0x3b, 0xd5, 0x46, 0x43, 0xdd, 0xca, 0x48, 0xd9, 0x95, 0xd1,
+	0x58, 0xb9, 0x34, 0x49, 0xd0, 0x83, 0x69, 0x42, 0x6f, 0xc2, 0x82, 0xa0, 0x54, 0x76, 0xea, 0xba,
+	0xba, 0x95, 0x09, 0x67, 0xe5, 0xd1, 0x58, 0x59, 0x9a, 0xc4, 0x57, 0xba, 0xc4, 0xc3, 0x16, 0x5a,
+	0x87, 0xb4, 0x00, 0x97, 0xca, 0x75, 0xcd, 0xdf, 0x3d, 0x32, 0x2d, 0x9d, 0x52, 0x9b, 0xb8, 0x14,
+	0x5b, 0xd9, 0xe8, 0xc3, 0xef, 0x73, 0xa1, 0xfc, 0x6f, 0x12, 0xc4, 0x84, 0x0e, 0x1b, 0x80, 0x34,
+	0x55, 0x6f, 0xee, 0x34, 0x66, 0x95, 0xc4, 0xb1, 0x41, 0x49, 0xef, 0x1c, 0xa3, 0x6c, 0x57, 0x6b,
+	0xa5, 0x9d, 0xea, 0x5d, 0x56, 0xd4, 0x95, 0xd1, 0x58, 0x59, 0x9e, 0xa4, 0x34, 0x9d, 0x7b, 0xb6,
+	0x63, 0x74, 0xed, 0xaf, 0xb0, 0x85, 0x8a, 0xb0, 0x28, 0x68, 0xa5, 0x4a, 0x45, 0xdd, 0x6d, 0xb0,
+	0xc2, 0xb2, 0xa3, 0xb1, 0x72, 0x71, 0x92, 0x53, 0x32, 0x4d, 0xdc, 0xa7, 0x13, 0x04, 0x4d, 0xfd,
+	0x50, 0xad, 0xf0, 0xda, 0xa6, 0x10, 0x34, 0xfc, 0x05, 0x36, 0x8f, 0x8a, 0xfb, 0x2e, 0x0c, 0xe9,
+	0xc9, 0xe6, 0xa3, 0x32, 0xac, 0xa8, 0x9f, 0xaa, 0x95, 0x66, 0xa3, 0xae, 0xb5, 0xa6, 0x56, 0x7b,
+	0x75, 0x34, 0x56, 0xae, 0x04, 0xbb, 0x4e, 0x92, 0x83, 0xaa, 0x6f, 0xc2, 0xa5, 0x93, 0x7b, 0xd4,
+	0xea, 0x8d, 0x96, 0xd6, 0xac, 0x65, 0xa4, 0xac, 0x32, 0x1a, 0x2b, 0x97, 0xa7, 0xf3, 0x6b, 0x84,
+	0x6a, 0x03, 0x07, 0xdd, 0x3a, 0x4d, 0xd7, 0x9b, 0x95, 0x8a, 0xaa, 0xeb, 0x99, 0xf0, 0xac, 0xf0,
+	0xfa, 0xc0, 0x34, 0xfd, 0xd3, 0x6f, 0x0a, 0x7f, 0xbb, 0x54, 0xdd, 0x69, 0x6a, 0x6a, 0x26, 0x32,
+	0x8b, 0xbf, 0x6d, 0xd8, 0xdd, 0x81, 0x8b, 0xb9, 0x36, 0x37, 0xa2, 0xfe, 0x79, 0x9d, 0xff, 0x5a,
+	0x82, 0x39, 0xf6, 0xb9, 0xa2, 0x15, 0x48, 0x0e, 0xb1, 0xd7, 0x62, 0x27, 0x8e, 0x78, 0x87, 0x24,
+	0x86, 0xd8, 0xab, 0xf8, 0x6b, 0xff, 0x5e, 0x73, 0x88, 0xf0, 0xf1, 0xe7, 0x5c, 0xdc, 0x21, 0xdc,
+	0x75, 0x0d, 0x16, 0x8c, 0xb6, 0x47, 0x0d, 0xdb, 0x11, 0x7e, 0x76, 0xbf, 0x69, 0xf3, 0xc2, 0xc8,
+	0x41, 0x57, 0x00, 0x0e, 0x30, 0x0d, 0x76, 0x88, 0xf2, 0x47, 0x97, 0x6f, 0x61, 0x6e, 0x91, 0xcb,
+	0xdf, 0x12, 0x44, 0xf7, 0x08, 0xc5, 0xe7, 0xdf, 0x19, 0x05, 0x98, 0xf3, 0x8f, 0x15, 0xf7, 0xfc,
+	0x87, 0x09, 0x83, 0xf9, 0xaf, 0x02, 0x73, 0x9f, 0xd8, 0x26, 0x66, 0xc9, 0xa5, 0xcf, 0x7a, 0x15,
+	0x54, 0x18, 0x46, 0x13, 0xd8, 0x99, 0x37, 0xf0, 0x7f, 0x75, 0x5b, 0xbc, 0x61, 0x41, 0x8c, 0x87,
+	0x45, 0x17, 0x01, 0x55, 0x3e, 0xa8, 0x57, 0x2b, 0xea, 0xe4, 0x40, 0xa2, 0x05, 0x48, 0x0a, 0x7b,
+	0xad, 0x9e, 0x91, 0x50, 0x1a, 0x40, 0x2c, 0x3f, 0x53, 0xf5, 0x4c, 0x18, 0x21, 0x48, 0x8b, 0x75,
+	0xa9, 0xac, 0x37, 0x4a, 0xd5, 0x5a, 0x26, 0x82, 0x16, 0x21, 0x25, 0x6c, 0x7b, 0x6a, 0xa3, 0x9e,
+	0x89, 0x96, 0x6f, 0x3d, 0x7d, 0x9e, 0x93, 0x9e, 0x3d, 0xcf, 0x49, 0x7f, 0x3e, 0xcf, 0x49, 0x8f,
+	0x5e, 0xe4, 0x42, 0xcf, 0x5e, 0xe4, 0x42, 0xbf, 0xbe, 0xc8, 0x85, 0xee, 0xbe, 0xde, 0xb1, 0xe9,
+	0xfe, 0xa0, 0x5d, 0x30, 0x49, 0x4f, 0xbc, 0xfe, 0xc5, 0x9f, 0x75, 0xcf, 0xba, 0x5f, 0x7c, 0xc0,
+	0xff, 0xb9, 0x69, 0xc7, 0x58, 0x41, 0x6f, 0xfd, 0x33, 0x00, 0xc5, 0x68, 0xbb, 0x1f, 0xf3, 0x0c,
+	0x00, 0x00,
 }
 
 func (this *GroupAccountInfo) Equal(that interface{}) bool {
@@ -1002,6 +1061,44 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PercentageDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentageDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1208,12 +1305,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x60
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x5a
 	{
@@ -1246,12 +1343,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1356,12 +1453,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1453,6 +1550,21 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *PercentageDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *GroupInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1987,6 +2099,121 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
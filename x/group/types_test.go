@@ -0,0 +1,209 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestThresholdDecisionPolicyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		"valid": {
+			policy: group.NewThresholdDecisionPolicy("3", time.Second),
+		},
+		"fractional threshold": {
+			policy: group.NewThresholdDecisionPolicy("1.5", time.Second),
+		},
+		"zero threshold": {
+			policy: group.NewThresholdDecisionPolicy("0", time.Second),
+			expErr: true,
+		},
+		"negative threshold": {
+			policy: group.NewThresholdDecisionPolicy("-1", time.Second),
+			expErr: true,
+		},
+		"invalid threshold": {
+			policy: group.NewThresholdDecisionPolicy("abc", time.Second),
+			expErr: true,
+		},
+		"zero timeout": {
+			policy: group.NewThresholdDecisionPolicy("3", 0),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.policy.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestThresholdDecisionPolicyValidate(t *testing.T) {
+	g := group.GroupInfo{TotalWeight: "5"}
+
+	require.NoError(t, group.NewThresholdDecisionPolicy("3", time.Second).Validate(g))
+	require.NoError(t, group.NewThresholdDecisionPolicy("5", time.Second).Validate(g))
+	require.Error(t, group.NewThresholdDecisionPolicy("5.1", time.Second).Validate(g))
+}
+
+func TestThresholdDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewThresholdDecisionPolicy("3", time.Second*10)
+
+	specs := map[string]struct {
+		tally          group.Tally
+		totalPower     string
+		votingDuration time.Duration
+		expResult      group.DecisionPolicyResult
+	}{
+		"yes votes reach threshold": {
+			tally:          group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "5",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"yes votes exceed threshold": {
+			tally:          group.Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "5",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"threshold still reachable": {
+			tally:          group.Tally{YesCount: "1", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "5",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"threshold not reachable anymore": {
+			tally:          group.Tally{YesCount: "1", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "5",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"timeout reached": {
+			tally:          group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "5",
+			votingDuration: time.Second * 10,
+			expResult:      group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := policy.Allow(spec.tally, spec.totalPower, spec.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		"valid": {
+			policy: group.NewPercentageDecisionPolicy("0.66", time.Second),
+		},
+		"one": {
+			policy: group.NewPercentageDecisionPolicy("1", time.Second),
+		},
+		"zero percentage": {
+			policy: group.NewPercentageDecisionPolicy("0", time.Second),
+			expErr: true,
+		},
+		"percentage greater than one": {
+			policy: group.NewPercentageDecisionPolicy("1.1", time.Second),
+			expErr: true,
+		},
+		"negative percentage": {
+			policy: group.NewPercentageDecisionPolicy("-0.5", time.Second),
+			expErr: true,
+		},
+		"zero timeout": {
+			policy: group.NewPercentageDecisionPolicy("0.5", 0),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.policy.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPercentageDecisionPolicyValidate(t *testing.T) {
+	policy := group.NewPercentageDecisionPolicy("0.5", time.Second)
+
+	require.NoError(t, policy.Validate(group.GroupInfo{TotalWeight: "1"}))
+	require.Error(t, policy.Validate(group.GroupInfo{TotalWeight: "0"}))
+}
+
+func TestPercentageDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewPercentageDecisionPolicy("0.66", time.Second*10)
+
+	specs := map[string]struct {
+		tally          group.Tally
+		totalPower     string
+		votingDuration time.Duration
+		expResult      group.DecisionPolicyResult
+	}{
+		"yes votes reach percentage": {
+			tally:          group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "3",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"yes votes below percentage": {
+			tally:          group.Tally{YesCount: "6", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "10",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"percentage not reachable anymore": {
+			tally:          group.Tally{YesCount: "6", NoCount: "3", AbstainCount: "1", VetoCount: "0"},
+			totalPower:     "10",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"fractional weights": {
+			tally:          group.Tally{YesCount: "0.66", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "1",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"zero total power": {
+			tally:          group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "0",
+			votingDuration: time.Second,
+			expResult:      group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"timeout reached": {
+			tally:          group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			totalPower:     "3",
+			votingDuration: time.Second * 10,
+			expResult:      group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := policy.Allow(spec.tally, spec.totalPower, spec.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, spec.expResult, res)
+		})
+	}
+}
@@ -64,13 +64,17 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			testProposal(proposal.ParamChange{
 				Subspace: govtypes.ModuleName,
 				Key:      string(govtypes.ParamStoreKeyDepositParams),
-				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}]}`,
+				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "expedited_min_deposit": [{"denom": "uatom","amount": "320000000"}]}`,
 			}),
 			func() {
 				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
 				suite.Require().Equal(govtypes.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: govtypes.DefaultPeriod,
+					MinDeposit:          sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
+					MaxDepositPeriod:    govtypes.DefaultPeriod,
+					ExpeditedMinDeposit: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(320000000))),
+					BurnVoteQuorum:      true,
+					BurnVoteVeto:        true,
+					BurnRatio:           govtypes.DefaultBurnRatio,
 				}, depositParams)
 			},
 			false,
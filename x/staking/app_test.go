@@ -39,6 +39,21 @@ func checkDelegation(
 	require.False(t, found)
 }
 
+// endEpoch commits empty blocks until the end of the current epoch, where the
+// staking msgs queued by the epoching module are executed.
+func endEpoch(app *simapp.SimApp) {
+	for {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		end := app.EpochingKeeper.IsEpochEnd(app.BaseApp.NewContext(false, header))
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+		if end {
+			return
+		}
+	}
+}
+
 func TestStakingMsgs(t *testing.T) {
 	genTokens := sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction)
 	bondTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
@@ -106,6 +121,10 @@ func TestStakingMsgs(t *testing.T) {
 	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{delegateMsg}, "", []uint64{1}, []uint64{0}, true, true, priv2)
 	require.NoError(t, err)
 
+	// the delegation is queued until the end of the epoch, with the delegated coins escrowed
+	simapp.CheckBalance(t, app, addr2, sdk.Coins{genCoin.Sub(bondCoin)})
+	checkDelegation(t, app, addr2, sdk.ValAddress(addr1), false, sdk.Dec{})
+	endEpoch(app)
 	simapp.CheckBalance(t, app, addr2, sdk.Coins{genCoin.Sub(bondCoin)})
 	checkDelegation(t, app, addr2, sdk.ValAddress(addr1), true, bondTokens.ToDec())
 
@@ -116,6 +135,7 @@ func TestStakingMsgs(t *testing.T) {
 	require.NoError(t, err)
 
 	// delegation should exist anymore
+	endEpoch(app)
 	checkDelegation(t, app, addr2, sdk.ValAddress(addr1), false, sdk.Dec{})
 
 	// balance should be the same because bonding not yet complete
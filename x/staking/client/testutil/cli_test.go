@@ -6,12 +6,22 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
 func TestIntegrationTestSuite(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 2
+
+	// execute the queued staking msgs at the end of every block
+	epochingGenesis := epochingtypes.DefaultGenesisState()
+	epochingGenesis.Params = epochingtypes.NewParams(1)
+	bz, err := cfg.Codec.MarshalJSON(epochingGenesis)
+	require.NoError(t, err)
+	cfg.GenesisState[epochingtypes.ModuleName] = bz
+
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 }